@@ -128,6 +128,10 @@ type BaseApp struct { // nolint: maligned
 	// abciListeners for hooking into the ABCI message processing of the BaseApp
 	// and exposing the requests and responses to external consumers
 	abciListeners []ABCIListener
+
+	// changeSetListeners collect the state changes of the stores exposed to the abciListener
+	// at the same index, which are passed to its ListenCommit hook
+	changeSetListeners []*storetypes.MemoryListener
 }
 
 // NewBaseApp returns a reference to an initialized BaseApp. It accepts a
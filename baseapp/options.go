@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/tx"
 )
@@ -237,11 +238,14 @@ func (app *BaseApp) SetInterfaceRegistry(registry types.InterfaceRegistry) {
 
 // SetStreamingService is used to set a streaming service into the BaseApp hooks and load the listeners into the multistore
 func (app *BaseApp) SetStreamingService(s StreamingService) {
-	// add the listeners for each StoreKey
+	// add the listeners for each StoreKey, along with a listener collecting the change set of each block
+	changeSetListener := storetypes.NewMemoryListener()
 	for key, lis := range s.Listeners() {
 		app.cms.AddListeners(key, lis)
+		app.cms.AddListeners(key, []storetypes.WriteListener{changeSetListener})
 	}
 	// register the StreamingService within the BaseApp
-	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock and Commit requests and responses to the streaming services to update their ABCI context
 	app.abciListeners = append(app.abciListeners, s)
+	app.changeSetListeners = append(app.changeSetListeners, changeSetListener)
 }
@@ -18,6 +18,10 @@ type ABCIListener interface {
 	ListenEndBlock(ctx types.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
 	// ListenDeliverTx updates the steaming service with the latest DeliverTx messages
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit updates the streaming service with the latest Commit messages, once the block's writes are final.
+	// changeSet contains the state changes of the block written to the stores exposed to the listener,
+	// i.e. the last value set or deleted for each updated key
+	ListenCommit(ctx types.Context, res abci.ResponseCommit, changeSet []*store.StoreKVPair) error
 }
 
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
@@ -0,0 +1,80 @@
+package baseapp_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &mockStreamingService{}
+
+// mockStreamingService exposes a single store and records the Commit hooks it receives
+type mockStreamingService struct {
+	storeKey   storetypes.StoreKey
+	commits    []abci.ResponseCommit
+	changeSets [][]*storetypes.StoreKVPair
+}
+
+func (m *mockStreamingService) Stream(wg *sync.WaitGroup) error { return nil }
+func (m *mockStreamingService) Close() error                    { return nil }
+func (m *mockStreamingService) Listeners() map[storetypes.StoreKey][]storetypes.WriteListener {
+	return map[storetypes.StoreKey][]storetypes.WriteListener{m.storeKey: {storetypes.NewMemoryListener()}}
+}
+func (m *mockStreamingService) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return nil
+}
+func (m *mockStreamingService) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return nil
+}
+func (m *mockStreamingService) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return nil
+}
+func (m *mockStreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	m.commits = append(m.commits, res)
+	m.changeSets = append(m.changeSets, changeSet)
+	return nil
+}
+
+func TestListenCommit(t *testing.T) {
+	blockers := func(bapp *baseapp.BaseApp) {
+		bapp.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+			ctx.KVStore(capKey1).Set([]byte("a"), []byte("1"))
+			ctx.KVStore(capKey1).Set([]byte("b"), []byte("1"))
+			ctx.KVStore(capKey2).Set([]byte("a"), []byte("1"))
+			return abci.ResponseBeginBlock{}
+		})
+		bapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+			ctx.KVStore(capKey1).Set([]byte("a"), []byte("2"))
+			ctx.KVStore(capKey1).Delete([]byte("b"))
+			return abci.ResponseEndBlock{}
+		})
+	}
+	app := setupBaseApp(t, blockers)
+	streamingService := &mockStreamingService{storeKey: capKey1}
+	app.SetStreamingService(streamingService)
+	app.InitChain(abci.RequestInitChain{})
+
+	for height := int64(1); height <= 2; height++ {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: height}})
+		app.EndBlock(abci.RequestEndBlock{Height: height})
+		res := app.Commit()
+		require.Len(t, streamingService.commits, int(height))
+		require.Equal(t, res, streamingService.commits[height-1])
+		require.Equal(t, app.LastCommitID().Hash, res.Data)
+	}
+
+	// the change set only contains the final writes of each block to the exposed store
+	expected := []*storetypes.StoreKVPair{
+		{StoreKey: capKey1.Name(), Key: []byte("a"), Value: []byte("2")},
+		{StoreKey: capKey1.Name(), Key: []byte("b"), Delete: true},
+	}
+	require.Equal(t, expected, streamingService.changeSets[0])
+	require.Equal(t, expected, streamingService.changeSets[1])
+}
@@ -0,0 +1,122 @@
+package server
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/badgerdb"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	multi "github.com/cosmos/cosmos-sdk/store/v2/multi"
+)
+
+const (
+	FlagBackend   = "backend"
+	FlagTargetDir = "target-dir"
+
+	BackendMemDB    = "memdb"
+	BackendBadgerDB = "badgerdb"
+	BackendRocksDB  = "rocksdb"
+)
+
+// MigrateStoreCmd migrates the application state stored by a rootmulti (IAVL) store into a
+// store/v2 MultiStore (flat state DB + SMT).
+func MigrateStoreCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-store",
+		Short: "Migrate the application state to a store/v2 MultiStore database",
+		Long: `Migrate the application state at a given height from the IAVL based multistore to a
+store/v2 MultiStore backed by the chosen database backend. Every IAVL substore recorded in the
+commit info of that height is copied, and the migrated contents are verified key by key.
+
+The memdb backend does not persist anything, and can be used to verify that the state can be migrated.
+The rocksdb backend is only available in binaries built with the rocksdb_build tag.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := GetServerContextFromCmd(cmd)
+			config := serverCtx.Config
+
+			homeDir, _ := cmd.Flags().GetString(flags.FlagHome)
+			config.SetRoot(homeDir)
+
+			backend, _ := cmd.Flags().GetString(FlagBackend)
+			targetDir, _ := cmd.Flags().GetString(FlagTargetDir)
+			if targetDir == "" {
+				targetDir = filepath.Join(config.RootDir, "data", "application.v2.db")
+			}
+			height, _ := cmd.Flags().GetInt64(FlagHeight)
+
+			db, err := openDB(config.RootDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			rootStore := rootmulti.NewStore(db)
+			if height <= 0 {
+				height = rootStore.GetLatestVersion()
+			}
+			cInfo, err := rootStore.GetCommitInfo(height)
+			if err != nil {
+				return fmt.Errorf("failed to load commit info at height %d: %w", height, err)
+			}
+			for _, storeInfo := range cInfo.StoreInfos {
+				// Memory stores are recorded in the commit info with an empty commit ID.
+				if storeInfo.CommitId.Version == 0 {
+					rootStore.MountStoreWithDB(storetypes.NewMemoryStoreKey(storeInfo.Name), storetypes.StoreTypeMemory, nil)
+					continue
+				}
+				rootStore.MountStoreWithDB(storetypes.NewKVStoreKey(storeInfo.Name), storetypes.StoreTypeIAVL, nil)
+			}
+			if err := rootStore.LoadVersion(height); err != nil {
+				return err
+			}
+
+			targetDB, err := openV2DB(backend, targetDir)
+			if err != nil {
+				return err
+			}
+			defer targetDB.Close()
+
+			store, err := multi.MigrateFromV1(rootStore, height, targetDB, multi.DefaultStoreConfig())
+			if err != nil {
+				return fmt.Errorf("failed to migrate state at height %d: %w", height, err)
+			}
+			commitID := store.LastCommitID()
+			if err := store.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("migrated %d stores at height %d, app hash: %X\n", len(cInfo.StoreInfos), commitID.Version, commitID.Hash)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().Int64(FlagHeight, -1, "Migrate state from a particular height (-1 means latest height)")
+	cmd.Flags().String(FlagBackend, BackendBadgerDB,
+		fmt.Sprintf("The target database backend (%s|%s|%s)", BackendBadgerDB, BackendRocksDB, BackendMemDB))
+	cmd.Flags().String(FlagTargetDir, "", "The target database directory (defaults to <home>/data/application.v2.db)")
+
+	return cmd
+}
+
+func openV2DB(backend, dir string) (dbm.DBConnection, error) {
+	switch backend {
+	case BackendMemDB:
+		return memdb.NewDB(), nil
+	case BackendBadgerDB:
+		return badgerdb.NewDB(dir)
+	case BackendRocksDB:
+		return openRocksDB(dir)
+	default:
+		return nil, fmt.Errorf("unknown database backend: %s", backend)
+	}
+}
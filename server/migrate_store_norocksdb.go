@@ -0,0 +1,13 @@
+//go:build !rocksdb_build
+
+package server
+
+import (
+	"errors"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+)
+
+func openRocksDB(string) (dbm.DBConnection, error) {
+	return nil, errors.New("rocksdb support is not compiled in; rebuild with the rocksdb_build tag")
+}
@@ -0,0 +1,12 @@
+//go:build rocksdb_build
+
+package server
+
+import (
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/rocksdb"
+)
+
+func openRocksDB(dir string) (dbm.DBConnection, error) {
+	return rocksdb.NewDB(dir)
+}
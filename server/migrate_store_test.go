@@ -0,0 +1,78 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMigrateStoreCmd(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// Initialize an application database with some committed state
+	db, err := sdk.NewLevelDB("application", filepath.Join(tempDir, "data"))
+	require.NoError(t, err)
+	encCfg := simapp.MakeTestEncodingConfig()
+	app := simapp.NewSimApp(log.NewNopLogger(), db, nil, true, map[int64]bool{}, tempDir, 0, encCfg, simapp.EmptyAppOptions{})
+	genesisState := simapp.GenesisStateWithSingleValidator(t, app)
+	stateBytes, err := tmjson.MarshalIndent(genesisState, "", " ")
+	require.NoError(t, err)
+	app.InitChain(abci.RequestInitChain{
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	app.Commit()
+	require.NoError(t, db.Close())
+
+	serverCtx := server.NewDefaultContext()
+	serverCtx.Config.RootDir = tempDir
+	ctx := context.WithValue(context.Background(), server.ServerContextKey, serverCtx)
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{"memdb backend", []string{fmt.Sprintf("--%s=%s", server.FlagBackend, server.BackendMemDB)}, false},
+		{"badgerdb backend", []string{
+			fmt.Sprintf("--%s=%s", server.FlagBackend, server.BackendBadgerDB),
+			fmt.Sprintf("--%s=%s", server.FlagTargetDir, filepath.Join(tempDir, "v2")),
+		}, false},
+		{"unknown backend", []string{fmt.Sprintf("--%s=%s", server.FlagBackend, "foo")}, true},
+		{"unknown height", []string{
+			fmt.Sprintf("--%s=%s", server.FlagBackend, server.BackendMemDB),
+			fmt.Sprintf("--%s=%d", server.FlagHeight, 5),
+		}, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := server.MigrateStoreCmd(tempDir)
+			output := &bytes.Buffer{}
+			cmd.SetOut(output)
+			cmd.SetArgs(append([]string{fmt.Sprintf("--%s=%s", flags.FlagHome, tempDir)}, tc.args...))
+
+			err := cmd.ExecuteContext(ctx)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Contains(t, output.String(), "at height 1")
+		})
+	}
+}
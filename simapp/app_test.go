@@ -22,10 +22,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/capability"
+	circuitmodule "github.com/cosmos/cosmos-sdk/x/circuit/module"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	epochingmodule "github.com/cosmos/cosmos-sdk/x/epoching/module"
 	"github.com/cosmos/cosmos-sdk/x/evidence"
 	feegrantmodule "github.com/cosmos/cosmos-sdk/x/feegrant/module"
+	feemarketmodule "github.com/cosmos/cosmos-sdk/x/feemarket/module"
 	"github.com/cosmos/cosmos-sdk/x/genutil"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	group "github.com/cosmos/cosmos-sdk/x/group/module"
@@ -33,6 +37,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/cosmos/cosmos-sdk/x/upgrade"
 )
 
@@ -67,6 +72,41 @@ func TestSimAppExportAndBlockedAddrs(t *testing.T) {
 	require.NoError(t, err, "ExportAppStateAndValidators should not have an error")
 }
 
+// appOptionsMap is a map implementing AppOptions
+type appOptionsMap map[string]interface{}
+
+func (m appOptionsMap) Get(key string) interface{} { return m[key] }
+
+func TestRejectUnwrappedStakingMsgsOption(t *testing.T) {
+	delegateMsg := stakingtypes.NewMsgDelegate(sdk.AccAddress("delegator___________"), sdk.ValAddress("validator___________"), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+
+	for _, reject := range []bool{false, true} {
+		encCfg := MakeTestEncodingConfig()
+		app := NewSimappWithCustomOptions(t, false, SetupOptions{
+			Logger:             log.NewNopLogger(),
+			DB:                 dbm.NewMemDB(),
+			EncConfig:          encCfg,
+			HomePath:           DefaultNodeHome,
+			SkipUpgradeHeights: map[int64]bool{},
+			AppOpts:            appOptionsMap{epochingmodule.FlagRejectUnwrappedStakingMsgs: reject},
+		})
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: app.LastBlockHeight() + 1}})
+		app.EndBlock(abci.RequestEndBlock{})
+		app.Commit()
+
+		txBuilder := encCfg.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(delegateMsg))
+		txBytes, err := encCfg.TxConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+
+		// the unsigned tx always fails, but only with the option because of the unwrapped message
+		res := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.False(t, res.IsOK())
+		rejected := res.Codespace == epoching.ErrUnwrappedMsg.Codespace() && res.Code == epoching.ErrUnwrappedMsg.ABCICode()
+		require.Equal(t, reject, rejected, res.Log)
+	}
+}
+
 func TestGetMaccPerms(t *testing.T) {
 	dup := GetMaccPerms()
 	require.Equal(t, maccPerms, dup, "duplicated module account permissions differed from actual module account permissions")
@@ -129,8 +169,18 @@ func TestRunMigrations(t *testing.T) {
 			false, "", true, "no migration found for module bank from version 2 to version 3: not found", 0,
 		},
 		{
-			"can register 2->3 migration handler for x/bank, can run migration",
+			"can register 2->3 migration handler for x/bank, cannot run migration",
 			"bank", 2,
+			false, "", true, "no migration found for module bank from version 3 to version 4: not found", 0,
+		},
+		{
+			"can register 3->4 migration handler for x/bank, cannot run migration",
+			"bank", 3,
+			false, "", true, "no migration found for module bank from version 4 to version 5: not found", 0,
+		},
+		{
+			"can register 4->5 migration handler for x/bank, can run migration",
+			"bank", 4,
 			false, "", false, "", 1,
 		},
 		{
@@ -181,6 +231,9 @@ func TestRunMigrations(t *testing.T) {
 					"slashing":     slashing.AppModule{}.ConsensusVersion(),
 					"gov":          gov.AppModule{}.ConsensusVersion(),
 					"group":        group.AppModule{}.ConsensusVersion(),
+					"epoching":     epochingmodule.AppModule{}.ConsensusVersion(),
+					"feemarket":    feemarketmodule.AppModule{}.ConsensusVersion(),
+					"circuit":      circuitmodule.AppModule{}.ConsensusVersion(),
 					"params":       params.AppModule{}.ConsensusVersion(),
 					"upgrade":      upgrade.AppModule{}.ConsensusVersion(),
 					"vesting":      vesting.AppModule{}.ConsensusVersion(),
@@ -20,6 +20,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/server"
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
@@ -28,10 +29,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis"
+	epochingmodule "github.com/cosmos/cosmos-sdk/x/epoching/module"
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 )
 
@@ -67,6 +72,17 @@ func NewRootCmd() (*cobra.Command, params.EncodingConfig) {
 				return err
 			}
 
+			// SIGN_MODE_TEXTUAL renders the coins with their denom metadata, which
+			// is queried from the node, it is therefore only enabled online.
+			if offline, _ := cmd.Flags().GetBool(flags.FlagOffline); !offline {
+				if protoCodec, ok := encodingConfig.Codec.(codec.ProtoCodecMarshaler); ok {
+					signModes := append(append([]signing.SignMode{}, authtx.DefaultSignModes...), signing.SignMode_SIGN_MODE_TEXTUAL)
+					initClientCtx = initClientCtx.WithTxConfig(authtx.NewTxConfigWithTextual(
+						protoCodec, signModes, textual.NewGRPCCoinMetadataQueryFn(initClientCtx),
+					))
+				}
+			}
+
 			if err := client.SetCmdClientContextHandler(initClientCtx, cmd); err != nil {
 				return err
 			}
@@ -185,6 +201,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	epochingmodule.AddModuleInitFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
@@ -568,6 +568,22 @@ func (rs *Store) GetKVStore(key types.StoreKey) types.KVStore {
 	return store
 }
 
+// StoreKeysByName returns the mapping of store names to the StoreKeys mounted on the Store.
+func (rs *Store) StoreKeysByName() map[string]types.StoreKey {
+	return rs.keysByName
+}
+
+// GetCommitInfo returns the commit info persisted for the given version.
+func (rs *Store) GetCommitInfo(ver int64) (*types.CommitInfo, error) {
+	return getCommitInfo(rs.db, ver)
+}
+
+// GetLatestVersion returns the latest version persisted in the underlying DB, or 0 if
+// nothing has been committed. It does not require the stores to be loaded.
+func (rs *Store) GetLatestVersion() int64 {
+	return getLatestVersion(rs.db)
+}
+
 // getStoreByName performs a lookup of a StoreKey given a store name typically
 // provided in a path. The StoreKey is then used to perform a lookup and return
 // a Store. If the Store is wrapped in an inter-block cache, it will be unwrapped
@@ -0,0 +1,70 @@
+// Package main is a reference ABCI listener plugin, which writes the messages it receives to files.
+//
+// For each event a file is created in the directory given with the -dir flag, named block-{N}-begin,
+// block-{N}-tx-{M}, block-{N}-end and block-{N}-commit, containing the length-prefixed protobuf encoded
+// request received over gRPC (i.e. the ABCI messages and the state changes of the event).
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	tmabci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming/abci"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ abci.ABCIListener = &FilePlugin{}
+
+// FilePlugin is an abci.ABCIListener writing each event out to a file
+type FilePlugin struct {
+	dir     string
+	txIndex int64
+}
+
+// ListenBeginBlock satisfies the abci.ABCIListener interface
+func (p *FilePlugin) ListenBeginBlock(_ context.Context, req tmabci.RequestBeginBlock, res tmabci.ResponseBeginBlock, changeSet []*types.StoreKVPair) error {
+	p.txIndex = 0
+	return p.write(fmt.Sprintf("block-%d-begin", req.Header.Height), &abci.ListenBeginBlockRequest{Req: req, Res: res, ChangeSet: changeSet})
+}
+
+// ListenEndBlock satisfies the abci.ABCIListener interface
+func (p *FilePlugin) ListenEndBlock(_ context.Context, req tmabci.RequestEndBlock, res tmabci.ResponseEndBlock, changeSet []*types.StoreKVPair) error {
+	return p.write(fmt.Sprintf("block-%d-end", req.Height), &abci.ListenEndBlockRequest{Req: req, Res: res, ChangeSet: changeSet})
+}
+
+// ListenDeliverTx satisfies the abci.ABCIListener interface
+func (p *FilePlugin) ListenDeliverTx(_ context.Context, blockHeight int64, req tmabci.RequestDeliverTx, res tmabci.ResponseDeliverTx, changeSet []*types.StoreKVPair) error {
+	name := fmt.Sprintf("block-%d-tx-%d", blockHeight, p.txIndex)
+	p.txIndex++
+	return p.write(name, &abci.ListenDeliverTxRequest{BlockHeight: blockHeight, Req: req, Res: res, ChangeSet: changeSet})
+}
+
+// ListenCommit satisfies the abci.ABCIListener interface
+func (p *FilePlugin) ListenCommit(_ context.Context, blockHeight int64, res tmabci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	return p.write(fmt.Sprintf("block-%d-commit", blockHeight), &abci.ListenCommitRequest{BlockHeight: blockHeight, Res: res, ChangeSet: changeSet})
+}
+
+func (p *FilePlugin) write(name string, msg proto.Message) error {
+	f, err := os.OpenFile(filepath.Join(p.dir, name), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if err := protoio.NewDelimitedWriter(f).WriteMsg(msg); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func main() {
+	dir := flag.String("dir", ".", "directory to write the files to")
+	flag.Parse()
+	abci.Serve(&FilePlugin{dir: *dir})
+}
@@ -0,0 +1,80 @@
+package abci
+
+import (
+	"context"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+var _ ABCIListener = &GRPCClient{}
+
+// GRPCClient is the ABCIListener used by the node, which forwards all calls to a plugin over gRPC
+type GRPCClient struct {
+	client ABCIListenerServiceClient
+}
+
+// ListenBeginBlock satisfies the ABCIListener interface
+func (m *GRPCClient) ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock, changeSet []*types.StoreKVPair) error {
+	_, err := m.client.ListenBeginBlock(ctx, &ListenBeginBlockRequest{Req: req, Res: res, ChangeSet: changeSet})
+	return err
+}
+
+// ListenEndBlock satisfies the ABCIListener interface
+func (m *GRPCClient) ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock, changeSet []*types.StoreKVPair) error {
+	_, err := m.client.ListenEndBlock(ctx, &ListenEndBlockRequest{Req: req, Res: res, ChangeSet: changeSet})
+	return err
+}
+
+// ListenDeliverTx satisfies the ABCIListener interface
+func (m *GRPCClient) ListenDeliverTx(ctx context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, changeSet []*types.StoreKVPair) error {
+	_, err := m.client.ListenDeliverTx(ctx, &ListenDeliverTxRequest{BlockHeight: blockHeight, Req: req, Res: res, ChangeSet: changeSet})
+	return err
+}
+
+// ListenCommit satisfies the ABCIListener interface
+func (m *GRPCClient) ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	_, err := m.client.ListenCommit(ctx, &ListenCommitRequest{BlockHeight: blockHeight, Res: res, ChangeSet: changeSet})
+	return err
+}
+
+var _ ABCIListenerServiceServer = &GRPCServer{}
+
+// GRPCServer is the gRPC server run by plugins, which forwards all calls to the plugin's ABCIListener
+type GRPCServer struct {
+	// Impl is the concrete ABCIListener implementation of the plugin
+	Impl ABCIListener
+}
+
+// ListenBeginBlock satisfies the ABCIListenerServiceServer interface
+func (m *GRPCServer) ListenBeginBlock(ctx context.Context, req *ListenBeginBlockRequest) (*ListenBeginBlockResponse, error) {
+	if err := m.Impl.ListenBeginBlock(ctx, req.Req, req.Res, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenBeginBlockResponse{}, nil
+}
+
+// ListenEndBlock satisfies the ABCIListenerServiceServer interface
+func (m *GRPCServer) ListenEndBlock(ctx context.Context, req *ListenEndBlockRequest) (*ListenEndBlockResponse, error) {
+	if err := m.Impl.ListenEndBlock(ctx, req.Req, req.Res, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenEndBlockResponse{}, nil
+}
+
+// ListenDeliverTx satisfies the ABCIListenerServiceServer interface
+func (m *GRPCServer) ListenDeliverTx(ctx context.Context, req *ListenDeliverTxRequest) (*ListenDeliverTxResponse, error) {
+	if err := m.Impl.ListenDeliverTx(ctx, req.BlockHeight, req.Req, req.Res, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenDeliverTxResponse{}, nil
+}
+
+// ListenCommit satisfies the ABCIListenerServiceServer interface
+func (m *GRPCServer) ListenCommit(ctx context.Context, req *ListenCommitRequest) (*ListenCommitResponse, error) {
+	if err := m.Impl.ListenCommit(ctx, req.BlockHeight, req.Res, req.ChangeSet); err != nil {
+		return nil, err
+	}
+	return &ListenCommitResponse{}, nil
+}
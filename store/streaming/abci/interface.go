@@ -0,0 +1,74 @@
+// Package abci implements out-of-process ABCI listeners: plugins running in separate processes,
+// launched and supervised by the node, which receive the ABCI messages and state changes of every
+// block over gRPC. Plugins can be written in any language implementing the ABCIListenerService
+// defined in cosmos/base/store/streaming/v1beta1/abci.proto and the go-plugin handshake;
+// Go plugins only need to implement ABCIListener and call Serve.
+package abci
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-plugin"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// PluginName is the name under which the ABCIListener is served by plugins
+const PluginName = "abci_listener"
+
+// Handshake is the go-plugin handshake configuration shared by the node and the plugins.
+// It is a UX feature rather than a security one: it prevents running a plugin binary directly,
+// and makes sure the node and the plugin speak the same protocol version.
+var Handshake = plugin.HandshakeConfig{
+	ProtocolVersion:  1,
+	MagicCookieKey:   "COSMOS_SDK_ABCI_LISTENER",
+	MagicCookieValue: "ef78114d-7bdf-411c-868f-347c99a78345",
+}
+
+// ABCIListener is the interface implemented by ABCI listener plugins.
+// Each method receives the ABCI messages of an event along with the state changes it produced,
+// in the order they occurred. Returning an error reports a failure to the node.
+type ABCIListener interface {
+	// ListenBeginBlock is called with the BeginBlock messages of each block
+	ListenBeginBlock(ctx context.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock, changeSet []*types.StoreKVPair) error
+	// ListenEndBlock is called with the EndBlock messages of each block
+	ListenEndBlock(ctx context.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock, changeSet []*types.StoreKVPair) error
+	// ListenDeliverTx is called with the DeliverTx messages of each tx
+	ListenDeliverTx(ctx context.Context, blockHeight int64, req abci.RequestDeliverTx, res abci.ResponseDeliverTx, changeSet []*types.StoreKVPair) error
+	// ListenCommit is called once a block has been committed, with all the state changes of the block
+	ListenCommit(ctx context.Context, blockHeight int64, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error
+}
+
+var _ plugin.GRPCPlugin = &ListenerGRPCPlugin{}
+
+// ListenerGRPCPlugin is the go-plugin implementation serving and dispensing an ABCIListener over gRPC
+type ListenerGRPCPlugin struct {
+	// GRPCPlugin must still implement the Plugin interface
+	plugin.Plugin
+	// Impl is the concrete implementation, only set by plugins
+	Impl ABCIListener
+}
+
+// GRPCServer satisfies the plugin.GRPCPlugin interface
+func (p *ListenerGRPCPlugin) GRPCServer(_ *plugin.GRPCBroker, s *grpc.Server) error {
+	RegisterABCIListenerServiceServer(s, &GRPCServer{Impl: p.Impl})
+	return nil
+}
+
+// GRPCClient satisfies the plugin.GRPCPlugin interface
+func (p *ListenerGRPCPlugin) GRPCClient(_ context.Context, _ *plugin.GRPCBroker, c *grpc.ClientConn) (interface{}, error) {
+	return &GRPCClient{client: NewABCIListenerServiceClient(c)}, nil
+}
+
+// Serve serves the provided ABCIListener, it must be called from the main function of a plugin
+func Serve(impl ABCIListener) {
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig: Handshake,
+		Plugins: map[string]plugin.Plugin{
+			PluginName: &ListenerGRPCPlugin{Impl: impl},
+		},
+		GRPCServer: plugin.DefaultGRPCServer,
+	})
+}
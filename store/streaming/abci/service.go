@@ -0,0 +1,240 @@
+package abci
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/go-plugin"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that forwards the ABCI messages and
+// resulting state changes to an ABCIListener plugin running in a separate process.
+//
+// The plugin process is launched when the service is created and supervised by the service: if it exits,
+// it is launched again on the next call. The events received while the plugin was down are lost.
+// If a call to the plugin fails, the error is either returned or, if haltOnError is set, the service panics,
+// halting the node.
+type StreamingService struct {
+	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	pluginPath         string                                   // path of the plugin executable
+	pluginArgs         []string                                 // arguments the plugin is launched with
+	haltOnError        bool                                     // whether to panic when the plugin fails
+	logger             hclog.Logger                             // logger for the plugin client and the plugin's output
+	client             *plugin.Client                           // client managing the plugin process
+	listener           ABCIListener                             // the plugin's listener
+	stateCache         []*types.StoreKVPair                     // cache the StoreKVPairs of the current event in the order they are received
+	stateCacheLock     *sync.Mutex                              // mutex for the state cache
+	currentBlockNumber int64                                    // the current block number
+	quitChan           chan struct{}                            // channel to synchronize closure
+}
+
+// NewStreamingService creates a new StreamingService which exposes the provided storeKeys to the plugin at
+// pluginPath, and launches the plugin with pluginArgs
+func NewStreamingService(pluginPath string, pluginArgs []string, storeKeys []types.StoreKey, haltOnError bool) (*StreamingService, error) {
+	if pluginPath == "" {
+		return nil, errors.New("plugin path cannot be empty")
+	}
+	pss := &StreamingService{
+		pluginPath:  pluginPath,
+		pluginArgs:  pluginArgs,
+		haltOnError: haltOnError,
+		logger: hclog.New(&hclog.LoggerOptions{
+			Name:   "abci-listener",
+			Output: os.Stderr,
+			Level:  hclog.Info,
+		}),
+		stateCacheLock: new(sync.Mutex),
+	}
+	listener := &stateListener{pss}
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		listeners[key] = append(listeners[key], listener)
+	}
+	pss.listeners = listeners
+	// launch the plugin right away, so that a misconfiguration is caught at initialization
+	if err := pss.start(); err != nil {
+		return nil, err
+	}
+	return pss, nil
+}
+
+// start launches the plugin process and connects to it
+func (pss *StreamingService) start() error {
+	client := plugin.NewClient(&plugin.ClientConfig{
+		HandshakeConfig:  Handshake,
+		Plugins:          map[string]plugin.Plugin{PluginName: &ListenerGRPCPlugin{}},
+		Cmd:              exec.Command(pss.pluginPath, pss.pluginArgs...),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		Logger:           pss.logger,
+	})
+	rpcClient, err := client.Client()
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("failed to launch ABCI listener plugin %s: %w", pss.pluginPath, err)
+	}
+	raw, err := rpcClient.Dispense(PluginName)
+	if err != nil {
+		client.Kill()
+		return fmt.Errorf("failed to dispense ABCI listener from plugin %s: %w", pss.pluginPath, err)
+	}
+	listener, ok := raw.(ABCIListener)
+	if !ok {
+		client.Kill()
+		return fmt.Errorf("plugin %s dispensed unexpected type %T", pss.pluginPath, raw)
+	}
+	pss.client = client
+	pss.listener = listener
+	return nil
+}
+
+// forward calls fn with the plugin's listener, relaunching the plugin first if it has exited
+func (pss *StreamingService) forward(fn func(listener ABCIListener) error) error {
+	var err error
+	if pss.client == nil || pss.client.Exited() {
+		if pss.client != nil {
+			pss.logger.Warn("ABCI listener plugin exited, relaunching it", "path", pss.pluginPath)
+			pss.client.Kill()
+			pss.client = nil
+		}
+		err = pss.start()
+	}
+	if err == nil {
+		err = fn(pss.listener)
+	}
+	if err != nil {
+		err = fmt.Errorf("ABCI listener plugin %s failed at height %d: %w", pss.pluginPath, pss.currentBlockNumber, err)
+		if pss.haltOnError {
+			panic(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// stateListener is the WriteListener registered with the stores, it caches the state changes in the StreamingService
+type stateListener struct {
+	pss *StreamingService
+}
+
+// OnWrite satisfies the types.WriteListener interface
+func (sl *stateListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Key:      append([]byte(nil), key...),
+		Value:    append([]byte(nil), value...),
+		Delete:   delete,
+	}
+	sl.pss.stateCacheLock.Lock()
+	sl.pss.stateCache = append(sl.pss.stateCache, kvPair)
+	sl.pss.stateCacheLock.Unlock()
+	return nil
+}
+
+// flushStateCache returns the state changes cached for the current event and resets the cache
+func (pss *StreamingService) flushStateCache() []*types.StoreKVPair {
+	pss.stateCacheLock.Lock()
+	defer pss.stateCacheLock.Unlock()
+	changeSet := pss.stateCache
+	pss.stateCache = nil
+	return changeSet
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (pss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return pss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It forwards the received BeginBlock request and response and the state changes reported since the previous event
+// to the plugin. With the BaseApp, the change set is empty since the writes of BeginBlock are only reported at Commit.
+func (pss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	pss.currentBlockNumber = req.GetHeader().Height
+	changeSet := pss.flushStateCache()
+	return pss.forward(func(listener ABCIListener) error {
+		return listener.ListenBeginBlock(goContext(ctx), req, res, changeSet)
+	})
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It forwards the received DeliverTx request and response and the resulting state changes to the plugin
+func (pss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	changeSet := pss.flushStateCache()
+	return pss.forward(func(listener ABCIListener) error {
+		return listener.ListenDeliverTx(goContext(ctx), pss.currentBlockNumber, req, res, changeSet)
+	})
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It forwards the received EndBlock request and response and the state changes reported since the previous event
+// to the plugin. With the BaseApp, the change set is empty since the writes of EndBlock are only reported at Commit.
+func (pss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	changeSet := pss.flushStateCache()
+	return pss.forward(func(listener ABCIListener) error {
+		return listener.ListenEndBlock(goContext(ctx), req, res, changeSet)
+	})
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It forwards the received Commit response and the change set of the block to the plugin
+func (pss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	// the writes cached while committing are already part of the change set
+	pss.flushStateCache()
+	return pss.forward(func(listener ABCIListener) error {
+		return listener.ListenCommit(goContext(ctx), pss.currentBlockNumber, res, changeSet)
+	})
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// The plugin is called synchronously from the ABCI hooks, so it only registers the service with the WaitGroup
+// until the service is closed
+// returns an error if it is called twice
+func (pss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if pss.quitChan != nil {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	pss.quitChan = make(chan struct{})
+	quitChan := pss.quitChan
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-quitChan
+	}()
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It stops the plugin process
+func (pss *StreamingService) Close() error {
+	if pss.quitChan != nil {
+		close(pss.quitChan)
+		pss.quitChan = nil
+	}
+	if pss.client != nil {
+		pss.client.Kill()
+		pss.client = nil
+	}
+	return nil
+}
+
+// goContext returns the context.Context carried by ctx, or an empty one if there is none
+func goContext(ctx sdk.Context) context.Context {
+	if goCtx := ctx.Context(); goCtx != nil {
+		return goCtx
+	}
+	return context.Background()
+}
@@ -0,0 +1,193 @@
+package abci
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	emptyContext = sdk.Context{}
+
+	// test abci message types
+	testBeginBlockReq = abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height: 1,
+		},
+		Hash: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+	}
+	testBeginBlockRes = abci.ResponseBeginBlock{
+		Events: []abci.Event{{Type: "testEventType1"}},
+	}
+	testDeliverTxReq = abci.RequestDeliverTx{
+		Tx: []byte{9, 8, 7, 6, 5, 4, 3, 2, 1},
+	}
+	testEndBlockReq = abci.RequestEndBlock{
+		Height: 1,
+	}
+	testEndBlockRes = abci.ResponseEndBlock{
+		Events: []abci.Event{{Type: "testEventType2"}},
+	}
+
+	// mock store keys
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+
+	// mock state changes
+	kvPair1 = &types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: []byte{1, 2, 3}, Value: []byte{3, 2, 1}}
+	kvPair2 = &types.StoreKVPair{StoreKey: mockStoreKey2.Name(), Key: []byte{2, 3, 4}, Value: []byte{4, 3, 2}}
+	kvPair3 = &types.StoreKVPair{StoreKey: mockStoreKey1.Name(), Key: []byte{1, 2, 3}, Delete: true}
+	kvPair4 = &types.StoreKVPair{StoreKey: mockStoreKey2.Name(), Key: []byte{5, 6, 7}, Value: []byte{7, 6, 5}}
+)
+
+// writeTxHandler is a tx.Handler writing kvPair2 and kvPair3 in a branch of the DeliverTx state, which is written
+// back like the changes of a successful tx
+type writeTxHandler struct{}
+
+func (writeTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	return tx.Response{}, tx.ResponseCheckTx{}, nil
+}
+
+func (writeTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	msCache := sdk.UnwrapSDKContext(ctx).MultiStore().CacheMultiStore()
+	msCache.GetKVStore(mockStoreKey2).Set(kvPair2.Key, kvPair2.Value)
+	msCache.GetKVStore(mockStoreKey1).Delete(kvPair3.Key)
+	msCache.Write()
+	return tx.Response{}, nil
+}
+
+func (writeTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	return tx.Response{}, nil
+}
+
+// buildFilePlugin compiles the reference file plugin and returns the path of the binary
+func buildFilePlugin(t *testing.T) string {
+	if testing.Short() {
+		t.Skip("skipping plugin test in short mode")
+	}
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not available to build the plugin")
+	}
+	bin := filepath.Join(t.TempDir(), "file-plugin")
+	out, err := exec.Command(goBin, "build", "-o", bin, "./examples/file").CombinedOutput()
+	require.NoError(t, err, string(out))
+	return bin
+}
+
+func readFile(t *testing.T, dir, name string, msg proto.Message) {
+	f, err := os.Open(filepath.Join(dir, name))
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, protoio.NewDelimitedReader(f, 1<<20).ReadMsg(msg))
+}
+
+func TestPluginStreamingService(t *testing.T) {
+	bin := buildFilePlugin(t)
+	outDir := t.TempDir()
+
+	_, err := NewStreamingService("", nil, nil, false)
+	require.Error(t, err)
+	_, err = NewStreamingService(filepath.Join(outDir, "missing"), nil, nil, false)
+	require.Error(t, err)
+
+	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
+	pss, err := NewStreamingService(bin, []string{"-dir", outDir}, testKeys, false)
+	require.NoError(t, err)
+	wg := new(sync.WaitGroup)
+	require.NoError(t, pss.Stream(wg))
+
+	// drive a block through baseapp: only the writes of the txs reach the listeners before the block is committed,
+	// the ones of BeginBlock and EndBlock are only part of the change set of the block
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB())
+	app.MountStores(mockStoreKey1, mockStoreKey2)
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.KVStore(mockStoreKey1).Set(kvPair1.Key, kvPair1.Value)
+		return testBeginBlockRes
+	})
+	app.SetTxHandler(writeTxHandler{})
+	app.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+		ctx.KVStore(mockStoreKey2).Set(kvPair4.Key, kvPair4.Value)
+		return testEndBlockRes
+	})
+	app.SetStreamingService(pss)
+	require.NoError(t, app.LoadLatestVersion())
+	app.InitChain(abci.RequestInitChain{})
+
+	app.BeginBlock(testBeginBlockReq)
+	deliverTxRes := app.DeliverTx(testDeliverTxReq)
+	app.EndBlock(testEndBlockReq)
+	commitRes := app.Commit()
+
+	var beginBlock ListenBeginBlockRequest
+	readFile(t, outDir, "block-1-begin", &beginBlock)
+	require.Equal(t, testBeginBlockReq, beginBlock.Req)
+	require.Equal(t, testBeginBlockRes, beginBlock.Res)
+	require.Empty(t, beginBlock.ChangeSet)
+
+	var deliverTx ListenDeliverTxRequest
+	readFile(t, outDir, "block-1-tx-0", &deliverTx)
+	require.Equal(t, int64(1), deliverTx.BlockHeight)
+	require.Equal(t, testDeliverTxReq, deliverTx.Req)
+	require.Equal(t, deliverTxRes.Code, deliverTx.Res.Code)
+	require.ElementsMatch(t, []*types.StoreKVPair{kvPair2, kvPair3}, deliverTx.ChangeSet)
+
+	var endBlock ListenEndBlockRequest
+	readFile(t, outDir, "block-1-end", &endBlock)
+	require.Equal(t, testEndBlockReq, endBlock.Req)
+	require.Equal(t, testEndBlockRes.Events, endBlock.Res.Events)
+	require.Empty(t, endBlock.ChangeSet)
+
+	var commit ListenCommitRequest
+	readFile(t, outDir, "block-1-commit", &commit)
+	require.Equal(t, int64(1), commit.BlockHeight)
+	require.Equal(t, commitRes, commit.Res)
+	require.ElementsMatch(t, []*types.StoreKVPair{kvPair2, kvPair3, kvPair4}, commit.ChangeSet)
+
+	// the plugin is relaunched if it exits
+	pss.client.Kill()
+	require.True(t, pss.client.Exited())
+	beginBlockReq := testBeginBlockReq
+	beginBlockReq.Header.Height = 2
+	app.BeginBlock(beginBlockReq)
+	readFile(t, outDir, "block-2-begin", &beginBlock)
+	require.Equal(t, int64(2), beginBlock.Req.Header.Height)
+
+	require.NoError(t, pss.Close())
+	wg.Wait()
+}
+
+func TestPluginStreamingService_HaltOnError(t *testing.T) {
+	bin := buildFilePlugin(t)
+	// the plugin fails to write to a directory which doesn't exist
+	missingDir := filepath.Join(t.TempDir(), "missing")
+	testKeys := []types.StoreKey{mockStoreKey1}
+
+	pss, err := NewStreamingService(bin, []string{"-dir", missingDir}, testKeys, false)
+	require.NoError(t, err)
+	require.Error(t, pss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, pss.Close())
+
+	pss, err = NewStreamingService(bin, []string{"-dir", missingDir}, testKeys, true)
+	require.NoError(t, err)
+	require.Panics(t, func() {
+		_ = pss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes)
+	})
+	require.NoError(t, pss.Close())
+}
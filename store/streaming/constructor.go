@@ -8,7 +8,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/abci"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/queue"
 	"github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/spf13/cast"
@@ -23,6 +25,8 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	Queue
+	Plugin
 	// add more in the future
 )
 
@@ -31,6 +35,10 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "queue", "q":
+		return Queue
+	case "plugin", "p":
+		return Plugin
 	default:
 		return Unknown
 	}
@@ -41,6 +49,10 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case Queue:
+		return "queue"
+	case Plugin:
+		return "plugin"
 	default:
 		return "unknown"
 	}
@@ -48,7 +60,9 @@ func (sst ServiceType) String() string {
 
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:   NewFileStreamingService,
+	Queue:  NewQueueStreamingService,
+	Plugin: NewPluginStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -70,6 +84,35 @@ func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey,
 	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
 }
 
+// NewQueueStreamingService is the streaming.ServiceConstructor function for creating a QueueStreamingService
+func NewQueueStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	producerName := cast.ToString(opts.Get("streamers.queue.producer"))
+	if producerName == "" {
+		producerName = "tcp"
+	}
+	topicPrefix := cast.ToString(opts.Get("streamers.queue.topic_prefix"))
+	maxRetries := cast.ToInt(opts.Get("streamers.queue.max_retries"))
+	haltOnError := cast.ToBool(opts.Get("streamers.queue.halt_on_publish_error"))
+	producer, err := queue.NewProducer(producerName, opts)
+	if err != nil {
+		return nil, err
+	}
+	qss, err := queue.NewStreamingService(producer, topicPrefix, keys, marshaller, maxRetries, haltOnError)
+	if err != nil {
+		producer.Close()
+		return nil, err
+	}
+	return qss, nil
+}
+
+// NewPluginStreamingService is the streaming.ServiceConstructor function for creating a PluginStreamingService
+func NewPluginStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	pluginPath := cast.ToString(opts.Get("streamers.plugin.path"))
+	pluginArgs := cast.ToStringSlice(opts.Get("streamers.plugin.args"))
+	haltOnError := cast.ToBool(opts.Get("streamers.plugin.halt_on_error"))
+	return abci.NewStreamingService(pluginPath, pluginArgs, keys, haltOnError)
+}
+
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
 // It returns the WaitGroup and quit channel used to synchronize with the streaming services and any error that occurs during the setup
 func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions, appCodec codec.BinaryCodec, keys map[string]*types.KVStoreKey) ([]baseapp.StreamingService, *sync.WaitGroup, error) {
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/queue"
 	"github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,6 +17,10 @@ type fakeOptions struct{}
 
 func (f *fakeOptions) Get(string) interface{} { return nil }
 
+type mapOptions map[string]interface{}
+
+func (m mapOptions) Get(key string) interface{} { return m[key] }
+
 var (
 	mockOptions       = new(fakeOptions)
 	mockKeys          = []types.StoreKey{sdk.NewKVStoreKey("mockKey1"), sdk.NewKVStoreKey("mockKey2")}
@@ -41,3 +46,47 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		require.True(t, ok)
 	}
 }
+
+func TestQueueStreamingServiceConstructor(t *testing.T) {
+	require.Equal(t, Queue, ServiceTypeFromString("queue"))
+	require.Equal(t, "queue", Queue.String())
+
+	constructor, err := NewServiceConstructor("queue")
+	require.Nil(t, err)
+
+	// the default tcp producer requires an address
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+
+	_, err = constructor(mapOptions{"streamers.queue.producer": "unknown"}, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+
+	serv, err := constructor(mapOptions{
+		"streamers.queue.address":      "127.0.0.1:9092",
+		"streamers.queue.topic_prefix": "prefix",
+		"streamers.queue.max_retries":  3,
+	}, mockKeys, testMarshaller)
+	require.Nil(t, err)
+	require.IsType(t, &queue.StreamingService{}, serv)
+	listeners := serv.Listeners()
+	for _, key := range mockKeys {
+		_, ok := listeners[key]
+		require.True(t, ok)
+	}
+	require.Nil(t, serv.Close())
+}
+
+func TestPluginStreamingServiceConstructor(t *testing.T) {
+	require.Equal(t, Plugin, ServiceTypeFromString("plugin"))
+	require.Equal(t, "plugin", Plugin.String())
+
+	constructor, err := NewServiceConstructor("plugin")
+	require.Nil(t, err)
+
+	// the plugin path is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+
+	_, err = constructor(mapOptions{"streamers.plugin.path": "/non/existent/plugin"}, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+}
@@ -235,6 +235,49 @@ func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
 	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0600)
 }
 
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It writes the received Commit response and the change set of the block out to a file
+// as described in the above the naming schema
+func (fss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	// the state changes cached while committing are part of the change set
+	fss.stateCacheLock.Lock()
+	fss.stateCache = nil
+	fss.stateCacheLock.Unlock()
+	// generate the new file
+	dstFile, err := fss.openCommitFile()
+	if err != nil {
+		return err
+	}
+	// write res to file
+	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	if err != nil {
+		return err
+	}
+	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
+		return err
+	}
+	// write the change set to file
+	for _, kvPair := range changeSet {
+		lengthPrefixedKVPairBytes, err := fss.codec.MarshalLengthPrefixed(kvPair)
+		if err != nil {
+			return err
+		}
+		if _, err = dstFile.Write(lengthPrefixedKVPairBytes); err != nil {
+			return err
+		}
+	}
+	// close file
+	return dstFile.Close()
+}
+
+func (fss *StreamingService) openCommitFile() (*os.File, error) {
+	fileName := fmt.Sprintf("block-%d-commit", fss.currentBlockNumber)
+	if fss.filePrefix != "" {
+		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	}
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0600)
+}
+
 // Stream satisfies the baseapp.StreamingService interface
 // It spins up a goroutine select loop which awaits length-prefixed binary encoded KV pairs
 // and caches them in the order they were received
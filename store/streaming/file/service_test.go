@@ -57,6 +57,10 @@ var (
 		ConsensusParamUpdates: &types1.ConsensusParams{},
 		ValidatorUpdates:      []abci.ValidatorUpdate{},
 	}
+	testCommitRes = abci.ResponseCommit{
+		Data:         []byte{1, 1, 2, 3, 5, 8},
+		RetainHeight: 1,
+	}
 	mockTxBytes1      = []byte{9, 8, 7, 6, 5, 4, 3, 2, 1}
 	testDeliverTxReq1 = abci.RequestDeliverTx{
 		Tx: mockTxBytes1,
@@ -147,6 +151,7 @@ func TestFileStreamingService(t *testing.T) {
 	testListenDeliverTx1(t)
 	testListenDeliverTx2(t)
 	testListenEndBlock(t)
+	testListenCommit(t)
 	testStreamingService.Close()
 	wg.Wait()
 }
@@ -367,6 +372,41 @@ func testListenEndBlock(t *testing.T) {
 	require.Equal(t, expectedEndBlockResBytes, segments[4])
 }
 
+func testListenCommit(t *testing.T) {
+	expectedCommitResBytes, err := testMarshaller.Marshal(&testCommitRes)
+	require.Nil(t, err)
+
+	// write state changes, which are part of the change set
+	testListener1.OnWrite(mockStoreKey1, mockKey1, mockValue1, false)
+
+	// expected KV pairs
+	changeSet := []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Delete: true},
+	}
+	expectedKVPair1, err := testMarshaller.Marshal(changeSet[0])
+	require.Nil(t, err)
+	expectedKVPair2, err := testMarshaller.Marshal(changeSet[1])
+	require.Nil(t, err)
+
+	// send the ABCI messages
+	err = testStreamingService.ListenCommit(emptyContext, testCommitRes, changeSet)
+	require.Nil(t, err)
+
+	// load the file, checking that it was created with the expected name
+	fileName := fmt.Sprintf("%s-block-%d-commit", testPrefix, testEndBlockReq.Height)
+	fileBytes, err := readInFile(fileName)
+	require.Nil(t, err)
+
+	// segment the file into the separate gRPC messages and check the correctness of each
+	segments, err := segmentBytes(fileBytes)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(segments))
+	require.Equal(t, expectedCommitResBytes, segments[0])
+	require.Equal(t, expectedKVPair1, segments[1])
+	require.Equal(t, expectedKVPair2, segments[2])
+}
+
 func readInFile(name string) ([]byte, error) {
 	path := filepath.Join(testDir, name)
 	return ioutil.ReadFile(path)
@@ -0,0 +1,133 @@
+package queue
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/spf13/cast"
+
+	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// Message is a single record published to the message queue
+type Message struct {
+	Topic string // topic the message is published to
+	Key   []byte // JSON encoded MessageKey, used by the queue for partitioning and ordering
+	Value []byte // protobuf binary encoded ABCI request, response, or StoreKVPair
+}
+
+// Producer is the interface a message queue client must implement to be used by the StreamingService
+type Producer interface {
+	// Publish delivers all the messages of a block to the queue, in order.
+	// It must only return nil once every message has been acknowledged by the queue.
+	Publish(msgs []Message) error
+	// Close releases the resources held by the producer
+	Close() error
+}
+
+// ProducerConstructor is used to construct a Producer from the AppOptions of the queue streaming service
+type ProducerConstructor func(opts serverTypes.AppOptions) (Producer, error)
+
+var (
+	producersLock sync.RWMutex
+	producers     = map[string]ProducerConstructor{
+		"tcp": NewTCPProducerFromOptions,
+	}
+)
+
+// RegisterProducer makes a Producer implementation available under the provided name,
+// so that it can be selected with the `streamers.queue.producer` option.
+// It panics if a producer with the same name has already been registered.
+func RegisterProducer(name string, constructor ProducerConstructor) {
+	producersLock.Lock()
+	defer producersLock.Unlock()
+	if _, ok := producers[name]; ok {
+		panic(fmt.Sprintf("queue producer %s already registered", name))
+	}
+	producers[name] = constructor
+}
+
+// NewProducer returns a Producer using the constructor registered under the provided name
+func NewProducer(name string, opts serverTypes.AppOptions) (Producer, error) {
+	producersLock.RLock()
+	constructor, ok := producers[name]
+	producersLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unrecognized queue producer %s, registered producers: %v", name, registeredProducers())
+	}
+	return constructor(opts)
+}
+
+func registeredProducers() []string {
+	producersLock.RLock()
+	defer producersLock.RUnlock()
+	names := make([]string, 0, len(producers))
+	for name := range producers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewTCPProducerFromOptions is the ProducerConstructor for the TCPProducer.
+// It reads the `streamers.queue.address` and `streamers.queue.timeout` options.
+func NewTCPProducerFromOptions(opts serverTypes.AppOptions) (Producer, error) {
+	address := cast.ToString(opts.Get("streamers.queue.address"))
+	if address == "" {
+		return nil, fmt.Errorf("streamers.queue.address must be set for the tcp producer")
+	}
+	timeout := cast.ToDuration(opts.Get("streamers.queue.timeout"))
+	if timeout <= 0 {
+		timeout = DefaultTCPTimeout
+	}
+	return NewTCPProducer(address, timeout), nil
+}
+
+var _ Producer = &MemoryProducer{}
+
+// MemoryProducer is an in-process Producer which keeps all published messages in memory.
+// It is intended for testing and for consumers embedded in the same process as the App.
+type MemoryProducer struct {
+	mtx      sync.Mutex
+	messages []Message
+	failures int
+	err      error
+}
+
+// NewMemoryProducer creates a new, empty MemoryProducer
+func NewMemoryProducer() *MemoryProducer {
+	return &MemoryProducer{}
+}
+
+// Publish satisfies the Producer interface
+func (mp *MemoryProducer) Publish(msgs []Message) error {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	if mp.failures > 0 {
+		mp.failures--
+		return mp.err
+	}
+	mp.messages = append(mp.messages, msgs...)
+	return nil
+}
+
+// FailNext makes the next n calls to Publish fail with the provided error
+func (mp *MemoryProducer) FailNext(n int, err error) {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	mp.failures = n
+	mp.err = err
+}
+
+// Messages returns a copy of all the messages published so far
+func (mp *MemoryProducer) Messages() []Message {
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+	return append([]Message(nil), mp.messages...)
+}
+
+// Close satisfies the Producer interface
+func (mp *MemoryProducer) Close() error {
+	return nil
+}
@@ -0,0 +1,315 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Topics the messages are published to, each prefixed with the configured topic prefix (if any)
+const (
+	TopicBeginBlock  = "begin-block"  // BeginBlock requests and responses
+	TopicDeliverTx   = "deliver-tx"   // DeliverTx requests and responses
+	TopicEndBlock    = "end-block"    // EndBlock requests and responses
+	TopicCommit      = "commit"       // Commit responses
+	TopicStateChange = "state-change" // StoreKVPairs written while processing any of the above
+)
+
+// Values of MessageKey.Event
+const (
+	EventBeginBlock = "begin_block"
+	EventDeliverTx  = "deliver_tx"
+	EventEndBlock   = "end_block"
+	EventCommit     = "commit"
+)
+
+// Values of MessageKey.Type
+const (
+	TypeRequest     = "request"
+	TypeResponse    = "response"
+	TypeStateChange = "state_change"
+)
+
+// MessageKey uniquely identifies a message published by the StreamingService.
+// Since a block can be published more than once (see StreamingService), consumers
+// can use it to de-duplicate the messages they receive.
+type MessageKey struct {
+	BlockHeight int64  `json:"block_height"`
+	Event       string `json:"event"`
+	EventID     int64  `json:"event_id"` // index of the tx within the block for deliver_tx events, 0 otherwise
+	Type        string `json:"type"`
+	Index       int64  `json:"index"` // position of the state change within the event, 0 otherwise
+}
+
+// retryBackoff is the base delay between successive attempts to publish a block;
+// the n-th retry waits n times this delay
+var retryBackoff = 100 * time.Millisecond
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// StreamingService is a concrete implementation of StreamingService that publishes the ABCI messages
+// and resulting state changes of every block to a message queue through a Producer.
+//
+// All the messages of a block are buffered and published as a single batch at EndBlock, in the order in which
+// they were produced. If the batch cannot be published after the configured number of retries, the error is
+// either returned (and the block's messages are dropped) or, if haltOnError is set, the service panics.
+// Since the panic happens before the block is committed, the node halts and the block is processed and
+// published again when the node restarts, so that every committed block is delivered at least once.
+//
+// The writes of BeginBlock and EndBlock only reach the stores' listeners when the block is committed, so they
+// can't be published along with the ABCI messages which produced them. Once the block is committed, the change set
+// of the block is published along with the Commit response, as a marker that the block is final. The same retry
+// policy applies, but since the block has already been committed, it is not published again if the node halts.
+type StreamingService struct {
+	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	producer           Producer                                 // the producer used to publish the messages
+	topicPrefix        string                                   // optional prefix for each of the topics
+	codec              codec.BinaryCodec                        // marshaller used for re-marshalling the ABCI messages
+	maxRetries         int                                      // number of times publishing a block is retried before giving up
+	haltOnError        bool                                     // whether to panic when a block can't be published
+	stateCache         [][]byte                                 // cache the protobuf binary encoded StoreKVPairs in the order they are received
+	stateCacheLock     *sync.Mutex                              // mutex for the state cache
+	batch              []Message                                // messages of the current block, waiting to be published
+	currentBlockNumber int64                                    // the current block number
+	currentTxIndex     int64                                    // the index of the current tx
+	quitChan           chan struct{}                            // channel to synchronize closure
+}
+
+// NewStreamingService creates a new StreamingService publishing the state changes of the provided storeKeys
+// to producer, under topics with the (optional) topicPrefix
+func NewStreamingService(
+	producer Producer, topicPrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec, maxRetries int, haltOnError bool,
+) (*StreamingService, error) {
+	if producer == nil {
+		return nil, errors.New("producer cannot be nil")
+	}
+	if maxRetries < 0 {
+		return nil, fmt.Errorf("max retries cannot be negative, got %d", maxRetries)
+	}
+	qss := &StreamingService{
+		producer:       producer,
+		topicPrefix:    topicPrefix,
+		codec:          c,
+		maxRetries:     maxRetries,
+		haltOnError:    haltOnError,
+		stateCache:     make([][]byte, 0),
+		stateCacheLock: new(sync.Mutex),
+	}
+	listener := &stateListener{qss}
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		listeners[key] = append(listeners[key], listener)
+	}
+	qss.listeners = listeners
+	return qss, nil
+}
+
+// stateListener is the WriteListener registered with the stores. It caches the state changes
+// directly in the StreamingService, so that they are available as soon as the ABCI hooks are called.
+type stateListener struct {
+	qss *StreamingService
+}
+
+// OnWrite satisfies the types.WriteListener interface
+func (sl *stateListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Key:      key,
+		Value:    value,
+		Delete:   delete,
+	}
+	by, err := sl.qss.codec.Marshal(kvPair)
+	if err != nil {
+		return err
+	}
+	sl.qss.stateCacheLock.Lock()
+	sl.qss.stateCache = append(sl.qss.stateCache, by)
+	sl.qss.stateCacheLock.Unlock()
+	return nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (qss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return qss.listeners
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It starts a new batch with the received BeginBlock request and response and the resulting state changes
+func (qss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	qss.currentBlockNumber = req.GetHeader().Height
+	qss.currentTxIndex = 0
+	// drop anything left over from a block which didn't reach EndBlock
+	qss.batch = nil
+	return qss.addEvent(TopicBeginBlock, EventBeginBlock, 0, &req, &res)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It adds the received DeliverTx request and response and the resulting state changes to the current batch
+func (qss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	txIndex := qss.currentTxIndex
+	qss.currentTxIndex++
+	return qss.addEvent(TopicDeliverTx, EventDeliverTx, txIndex, &req, &res)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It adds the received EndBlock request and response and the resulting state changes to the current batch,
+// and publishes the batch
+func (qss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	if err := qss.addEvent(TopicEndBlock, EventEndBlock, 0, &req, &res); err != nil {
+		qss.batch = nil
+		return err
+	}
+	return qss.publish()
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It publishes the change set of the block, which includes the state changes of BeginBlock and EndBlock, followed by
+// the received Commit response
+func (qss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	// the writes reported while committing are the ones of the change set
+	qss.stateCacheLock.Lock()
+	qss.stateCache = nil
+	qss.stateCacheLock.Unlock()
+
+	qss.batch = nil
+	key := MessageKey{
+		BlockHeight: qss.currentBlockNumber,
+		Event:       EventCommit,
+	}
+	for i, kvPair := range changeSet {
+		by, err := qss.codec.Marshal(kvPair)
+		if err != nil {
+			qss.batch = nil
+			return err
+		}
+		if err := qss.addMessage(TopicStateChange, key, TypeStateChange, int64(i), by); err != nil {
+			qss.batch = nil
+			return err
+		}
+	}
+	resBytes, err := qss.codec.Marshal(&res)
+	if err != nil {
+		qss.batch = nil
+		return err
+	}
+	if err := qss.addMessage(TopicCommit, key, TypeResponse, 0, resBytes); err != nil {
+		qss.batch = nil
+		return err
+	}
+	return qss.publish()
+}
+
+// addEvent appends the messages for an ABCI request and response pair, and the state changes cached
+// while processing it, to the current batch
+func (qss *StreamingService) addEvent(topic, event string, eventID int64, req, res codec.ProtoMarshaler) error {
+	// take the cached state changes first, so that they are reset even if marshalling fails
+	qss.stateCacheLock.Lock()
+	stateChanges := qss.stateCache
+	qss.stateCache = nil
+	qss.stateCacheLock.Unlock()
+
+	key := MessageKey{
+		BlockHeight: qss.currentBlockNumber,
+		Event:       event,
+		EventID:     eventID,
+	}
+	reqBytes, err := qss.codec.Marshal(req)
+	if err != nil {
+		return err
+	}
+	if err := qss.addMessage(topic, key, TypeRequest, 0, reqBytes); err != nil {
+		return err
+	}
+	for i, stateChange := range stateChanges {
+		if err := qss.addMessage(TopicStateChange, key, TypeStateChange, int64(i), stateChange); err != nil {
+			return err
+		}
+	}
+	resBytes, err := qss.codec.Marshal(res)
+	if err != nil {
+		return err
+	}
+	return qss.addMessage(topic, key, TypeResponse, 0, resBytes)
+}
+
+func (qss *StreamingService) addMessage(topic string, key MessageKey, typ string, index int64, value []byte) error {
+	key.Type = typ
+	key.Index = index
+	keyBytes, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+	qss.batch = append(qss.batch, Message{
+		Topic: qss.topic(topic),
+		Key:   keyBytes,
+		Value: value,
+	})
+	return nil
+}
+
+func (qss *StreamingService) topic(topic string) string {
+	if qss.topicPrefix == "" {
+		return topic
+	}
+	return fmt.Sprintf("%s-%s", qss.topicPrefix, topic)
+}
+
+// publish hands the current batch to the producer, retrying up to maxRetries times
+func (qss *StreamingService) publish() error {
+	batch := qss.batch
+	qss.batch = nil
+	var err error
+	for attempt := 0; attempt <= qss.maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * retryBackoff)
+		}
+		if err = qss.producer.Publish(batch); err == nil {
+			return nil
+		}
+	}
+	err = fmt.Errorf("failed to publish block %d after %d attempts: %w", qss.currentBlockNumber, qss.maxRetries+1, err)
+	if qss.haltOnError {
+		panic(err)
+	}
+	return err
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// Messages are published synchronously from the ABCI hooks, so it only registers the service with the WaitGroup
+// until the service is closed
+// returns an error if it is called twice
+func (qss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if qss.quitChan != nil {
+		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
+	}
+	qss.quitChan = make(chan struct{})
+	quitChan := qss.quitChan
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		<-quitChan
+	}()
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It closes the underlying Producer
+func (qss *StreamingService) Close() error {
+	if qss.quitChan != nil {
+		close(qss.quitChan)
+		qss.quitChan = nil
+	}
+	return qss.producer.Close()
+}
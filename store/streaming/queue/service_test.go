@@ -0,0 +1,276 @@
+package queue
+
+import (
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	interfaceRegistry = codecTypes.NewInterfaceRegistry()
+	testMarshaller    = codec.NewProtoCodec(interfaceRegistry)
+	emptyContext      = sdk.Context{}
+
+	// test abci message types
+	testBeginBlockReq = abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height: 1,
+		},
+		ByzantineValidators: []abci.Evidence{},
+		Hash:                []byte{1, 2, 3, 4, 5, 6, 7, 8, 9},
+		LastCommitInfo: abci.LastCommitInfo{
+			Round: 1,
+			Votes: []abci.VoteInfo{},
+		},
+	}
+	testBeginBlockRes = abci.ResponseBeginBlock{
+		Events: []abci.Event{
+			{
+				Type: "testEventType1",
+			},
+		},
+	}
+	testEndBlockReq = abci.RequestEndBlock{
+		Height: 1,
+	}
+	testEndBlockRes = abci.ResponseEndBlock{
+		Events:                []abci.Event{},
+		ConsensusParamUpdates: &tmproto.ConsensusParams{},
+		ValidatorUpdates:      []abci.ValidatorUpdate{},
+	}
+	testCommitRes = abci.ResponseCommit{
+		Data: []byte{1, 1, 2, 3, 5, 8},
+	}
+	testDeliverTxReq = abci.RequestDeliverTx{
+		Tx: []byte{9, 8, 7, 6, 5, 4, 3, 2, 1},
+	}
+	testDeliverTxRes = abci.ResponseDeliverTx{
+		Events:    []abci.Event{},
+		Code:      1,
+		Codespace: "mockCodeSpace",
+		Data:      []byte{1, 3, 5, 7, 9},
+		GasUsed:   2,
+		GasWanted: 3,
+		Info:      "mockInfo",
+		Log:       "mockLog",
+	}
+
+	// mock store keys
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+
+	// mock state changes
+	mockKey1   = []byte{1, 2, 3}
+	mockValue1 = []byte{3, 2, 1}
+	mockKey2   = []byte{2, 3, 4}
+	mockValue2 = []byte{4, 3, 2}
+
+	testPrefix = "testPrefix"
+)
+
+func init() {
+	retryBackoff = 0
+}
+
+// writes the messages of a block with a single tx; as in baseapp, only the writes of the tx are reported before
+// the block is committed
+func streamBlock(t *testing.T, qss *StreamingService) error {
+	listener1 := qss.Listeners()[mockStoreKey1][0]
+	listener2 := qss.Listeners()[mockStoreKey2][0]
+
+	require.NoError(t, qss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, listener2.OnWrite(mockStoreKey2, mockKey2, mockValue2, false))
+	require.NoError(t, listener1.OnWrite(mockStoreKey1, mockKey1, nil, true))
+	require.NoError(t, qss.ListenDeliverTx(emptyContext, testDeliverTxReq, testDeliverTxRes))
+	return qss.ListenEndBlock(emptyContext, testEndBlockReq, testEndBlockRes)
+}
+
+func marshal(t *testing.T, msg codec.ProtoMarshaler) []byte {
+	bz, err := testMarshaller.Marshal(msg)
+	require.NoError(t, err)
+	return bz
+}
+
+func messageKey(t *testing.T, event string, eventID int64, typ string, index int64) []byte {
+	bz, err := json.Marshal(MessageKey{
+		BlockHeight: 1,
+		Event:       event,
+		EventID:     eventID,
+		Type:        typ,
+		Index:       index,
+	})
+	require.NoError(t, err)
+	return bz
+}
+
+func expectedMessages(t *testing.T) []Message {
+	return []Message{
+		{testPrefix + "-" + TopicBeginBlock, messageKey(t, EventBeginBlock, 0, TypeRequest, 0), marshal(t, &testBeginBlockReq)},
+		{testPrefix + "-" + TopicBeginBlock, messageKey(t, EventBeginBlock, 0, TypeResponse, 0), marshal(t, &testBeginBlockRes)},
+		{testPrefix + "-" + TopicDeliverTx, messageKey(t, EventDeliverTx, 0, TypeRequest, 0), marshal(t, &testDeliverTxReq)},
+		{testPrefix + "-" + TopicStateChange, messageKey(t, EventDeliverTx, 0, TypeStateChange, 0), marshal(t, &types.StoreKVPair{
+			StoreKey: mockStoreKey2.Name(),
+			Key:      mockKey2,
+			Value:    mockValue2,
+		})},
+		{testPrefix + "-" + TopicStateChange, messageKey(t, EventDeliverTx, 0, TypeStateChange, 1), marshal(t, &types.StoreKVPair{
+			StoreKey: mockStoreKey1.Name(),
+			Key:      mockKey1,
+			Delete:   true,
+		})},
+		{testPrefix + "-" + TopicDeliverTx, messageKey(t, EventDeliverTx, 0, TypeResponse, 0), marshal(t, &testDeliverTxRes)},
+		{testPrefix + "-" + TopicEndBlock, messageKey(t, EventEndBlock, 0, TypeRequest, 0), marshal(t, &testEndBlockReq)},
+		{testPrefix + "-" + TopicEndBlock, messageKey(t, EventEndBlock, 0, TypeResponse, 0), marshal(t, &testEndBlockRes)},
+	}
+}
+
+func TestQueueStreamingService(t *testing.T) {
+	producer := NewMemoryProducer()
+	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
+	qss, err := NewStreamingService(producer, testPrefix, testKeys, testMarshaller, 0, false)
+	require.NoError(t, err)
+	require.Equal(t, testPrefix, qss.topicPrefix)
+	require.Equal(t, testMarshaller, qss.codec)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, qss.Stream(wg))
+	require.Error(t, qss.Stream(wg))
+
+	require.NoError(t, streamBlock(t, qss))
+	// nothing is published before EndBlock, and everything is published at once
+	require.Equal(t, expectedMessages(t), producer.Messages())
+
+	// the change set and the commit response are published once the block is committed, the writes reported while
+	// committing are dropped
+	changeSet := []*types.StoreKVPair{
+		{StoreKey: mockStoreKey1.Name(), Key: mockKey1, Value: mockValue1},
+		{StoreKey: mockStoreKey2.Name(), Key: mockKey2, Value: mockValue2},
+	}
+	require.NoError(t, qss.Listeners()[mockStoreKey1][0].OnWrite(mockStoreKey1, mockKey1, mockValue1, false))
+	require.NoError(t, qss.ListenCommit(emptyContext, testCommitRes, changeSet))
+	require.Equal(t, append(expectedMessages(t),
+		Message{testPrefix + "-" + TopicStateChange, messageKey(t, EventCommit, 0, TypeStateChange, 0), marshal(t, changeSet[0])},
+		Message{testPrefix + "-" + TopicStateChange, messageKey(t, EventCommit, 0, TypeStateChange, 1), marshal(t, changeSet[1])},
+		Message{testPrefix + "-" + TopicCommit, messageKey(t, EventCommit, 0, TypeResponse, 0), marshal(t, &testCommitRes)},
+	), producer.Messages())
+	require.Empty(t, qss.stateCache)
+
+	require.NoError(t, qss.Close())
+	wg.Wait()
+}
+
+func TestQueueStreamingService_Retries(t *testing.T) {
+	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
+	publishErr := errors.New("broker unavailable")
+
+	_, err := NewStreamingService(nil, testPrefix, testKeys, testMarshaller, 0, false)
+	require.Error(t, err)
+	_, err = NewStreamingService(NewMemoryProducer(), testPrefix, testKeys, testMarshaller, -1, false)
+	require.Error(t, err)
+
+	// the batch is delivered once a retry succeeds
+	producer := NewMemoryProducer()
+	qss, err := NewStreamingService(producer, testPrefix, testKeys, testMarshaller, 2, false)
+	require.NoError(t, err)
+	producer.FailNext(2, publishErr)
+	require.NoError(t, streamBlock(t, qss))
+	require.Equal(t, expectedMessages(t), producer.Messages())
+
+	// the error is returned and the block is dropped once the retries are exhausted
+	producer = NewMemoryProducer()
+	qss, err = NewStreamingService(producer, testPrefix, testKeys, testMarshaller, 1, false)
+	require.NoError(t, err)
+	producer.FailNext(2, publishErr)
+	err = streamBlock(t, qss)
+	require.ErrorIs(t, err, publishErr)
+	require.Empty(t, producer.Messages())
+	// the next block is published on its own
+	require.NoError(t, streamBlock(t, qss))
+	require.Equal(t, expectedMessages(t), producer.Messages())
+
+	// halting on errors panics, and the same block can be published again after the "restart"
+	producer = NewMemoryProducer()
+	qss, err = NewStreamingService(producer, testPrefix, testKeys, testMarshaller, 1, true)
+	require.NoError(t, err)
+	producer.FailNext(2, publishErr)
+	require.Panics(t, func() { _ = streamBlock(t, qss) })
+	require.Empty(t, producer.Messages())
+	require.NoError(t, streamBlock(t, qss))
+	require.Equal(t, expectedMessages(t), producer.Messages())
+}
+
+func TestQueueStreamingService_TCP(t *testing.T) {
+	sink, err := NewTCPSink("127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { sink.Close() })
+
+	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
+	qss, err := NewStreamingService(NewTCPProducer(sink.Addr(), DefaultTCPTimeout), testPrefix, testKeys, testMarshaller, 0, false)
+	require.NoError(t, err)
+	require.NoError(t, streamBlock(t, qss))
+	require.NoError(t, streamBlock(t, qss))
+	require.NoError(t, qss.Close())
+
+	expected := expectedMessages(t)
+	require.Equal(t, append(expected, expected...), sink.Messages())
+}
+
+func TestQueueStreamingService_BaseApp(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey("store")
+	app := baseapp.NewBaseApp(t.Name(), log.NewNopLogger(), dbm.NewMemDB())
+	app.MountStores(storeKey)
+	app.SetBeginBlocker(func(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		ctx.KVStore(storeKey).Set(mockKey1, mockValue1)
+		ctx.KVStore(storeKey).Set(mockKey2, mockValue1)
+		return abci.ResponseBeginBlock{}
+	})
+	app.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
+		ctx.KVStore(storeKey).Set(mockKey2, mockValue2)
+		return abci.ResponseEndBlock{}
+	})
+
+	producer := NewMemoryProducer()
+	qss, err := NewStreamingService(producer, "", []types.StoreKey{storeKey}, testMarshaller, 0, false)
+	require.NoError(t, err)
+	app.SetStreamingService(qss)
+	require.NoError(t, app.LoadLatestVersion())
+	app.InitChain(abci.RequestInitChain{})
+
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	res := app.Commit()
+
+	// the writes of BeginBlock and EndBlock are published with the commit response
+	var stateChanges []*types.StoreKVPair
+	for _, msg := range producer.Messages() {
+		if msg.Topic != TopicStateChange {
+			continue
+		}
+		var key MessageKey
+		require.NoError(t, json.Unmarshal(msg.Key, &key))
+		require.Equal(t, MessageKey{BlockHeight: 1, Event: EventCommit, Type: TypeStateChange, Index: int64(len(stateChanges))}, key)
+		kvPair := new(types.StoreKVPair)
+		require.NoError(t, testMarshaller.Unmarshal(msg.Value, kvPair))
+		stateChanges = append(stateChanges, kvPair)
+	}
+	require.Equal(t, []*types.StoreKVPair{
+		{StoreKey: storeKey.Name(), Key: mockKey1, Value: mockValue1},
+		{StoreKey: storeKey.Name(), Key: mockKey2, Value: mockValue2},
+	}, stateChanges)
+
+	messages := producer.Messages()
+	require.Equal(t, Message{TopicCommit, messageKey(t, EventCommit, 0, TypeResponse, 0), marshal(t, &res)}, messages[len(messages)-1])
+}
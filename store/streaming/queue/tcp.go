@@ -0,0 +1,238 @@
+package queue
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+// The TCP producer and sink exchange batches of messages using a simple framing:
+//
+//	batch   := uvarint(number of messages) message*
+//	message := uvarint(len(topic)) topic uvarint(len(key)) key uvarint(len(value)) value
+//
+// The receiving end acknowledges each batch, once it has been stored, by writing back a single ackByte.
+const ackByte byte = 1
+
+const (
+	// DefaultTCPTimeout is the default time the TCPProducer waits for a batch to be sent and acknowledged
+	DefaultTCPTimeout = 10 * time.Second
+	// maxFieldSize bounds the size of a single framed field read by the TCPSink
+	maxFieldSize = 64 << 20
+)
+
+var _ Producer = &TCPProducer{}
+
+// TCPProducer is a Producer which sends each batch of messages over a TCP connection
+// and waits for the batch to be acknowledged by the remote end.
+// The connection is established lazily and re-established on the next Publish after any failure.
+type TCPProducer struct {
+	mtx     sync.Mutex
+	address string
+	timeout time.Duration
+	conn    net.Conn
+}
+
+// NewTCPProducer creates a new TCPProducer for the provided address
+func NewTCPProducer(address string, timeout time.Duration) *TCPProducer {
+	return &TCPProducer{
+		address: address,
+		timeout: timeout,
+	}
+}
+
+// Publish satisfies the Producer interface
+func (tp *TCPProducer) Publish(msgs []Message) error {
+	tp.mtx.Lock()
+	defer tp.mtx.Unlock()
+	if tp.conn == nil {
+		conn, err := net.DialTimeout("tcp", tp.address, tp.timeout)
+		if err != nil {
+			return err
+		}
+		tp.conn = conn
+	}
+	if err := tp.publish(msgs); err != nil {
+		// the state of the connection is unknown, start over with a new one
+		tp.conn.Close()
+		tp.conn = nil
+		return err
+	}
+	return nil
+}
+
+func (tp *TCPProducer) publish(msgs []Message) error {
+	if err := tp.conn.SetDeadline(time.Now().Add(tp.timeout)); err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tp.conn)
+	if err := writeBatch(w, msgs); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	ack := make([]byte, 1)
+	if _, err := io.ReadFull(tp.conn, ack); err != nil {
+		return fmt.Errorf("failed to read acknowledgement: %w", err)
+	}
+	if ack[0] != ackByte {
+		return fmt.Errorf("unexpected acknowledgement %X", ack[0])
+	}
+	return nil
+}
+
+// Close satisfies the Producer interface
+func (tp *TCPProducer) Close() error {
+	tp.mtx.Lock()
+	defer tp.mtx.Unlock()
+	if tp.conn == nil {
+		return nil
+	}
+	err := tp.conn.Close()
+	tp.conn = nil
+	return err
+}
+
+// TCPSink is a minimal stand-in for a message queue broker, which accepts batches from TCPProducers,
+// keeps the received messages in memory and acknowledges each batch.
+// It is intended for local testing of the queue streaming service.
+type TCPSink struct {
+	listener net.Listener
+	wg       sync.WaitGroup
+	mtx      sync.Mutex
+	conns    map[net.Conn]struct{}
+	messages []Message
+}
+
+// NewTCPSink creates a TCPSink listening on the provided address and starts accepting connections
+func NewTCPSink(address string) (*TCPSink, error) {
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	sink := &TCPSink{
+		listener: listener,
+		conns:    make(map[net.Conn]struct{}),
+	}
+	sink.wg.Add(1)
+	go sink.serve()
+	return sink, nil
+}
+
+// Addr returns the address the sink is listening on
+func (s *TCPSink) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Messages returns a copy of all the messages received so far
+func (s *TCPSink) Messages() []Message {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	return append([]Message(nil), s.messages...)
+}
+
+// Close stops the sink and closes all open connections
+func (s *TCPSink) Close() error {
+	err := s.listener.Close()
+	s.mtx.Lock()
+	for conn := range s.conns {
+		conn.Close()
+	}
+	s.mtx.Unlock()
+	s.wg.Wait()
+	return err
+}
+
+func (s *TCPSink) serve() {
+	defer s.wg.Done()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		s.mtx.Lock()
+		s.conns[conn] = struct{}{}
+		s.mtx.Unlock()
+		s.wg.Add(1)
+		go s.handle(conn)
+	}
+}
+
+func (s *TCPSink) handle(conn net.Conn) {
+	defer s.wg.Done()
+	defer func() {
+		s.mtx.Lock()
+		delete(s.conns, conn)
+		s.mtx.Unlock()
+		conn.Close()
+	}()
+	r := bufio.NewReader(conn)
+	for {
+		msgs, err := readBatch(r)
+		if err != nil {
+			return
+		}
+		s.mtx.Lock()
+		s.messages = append(s.messages, msgs...)
+		s.mtx.Unlock()
+		if _, err := conn.Write([]byte{ackByte}); err != nil {
+			return
+		}
+	}
+}
+
+func writeBatch(w io.Writer, msgs []Message) error {
+	if err := writeUvarint(w, uint64(len(msgs))); err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		for _, field := range [][]byte{[]byte(msg.Topic), msg.Key, msg.Value} {
+			if err := writeUvarint(w, uint64(len(field))); err != nil {
+				return err
+			}
+			if _, err := w.Write(field); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func readBatch(r *bufio.Reader) ([]Message, error) {
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]Message, 0)
+	for i := uint64(0); i < count; i++ {
+		var fields [3][]byte
+		for j := range fields {
+			size, err := binary.ReadUvarint(r)
+			if err != nil {
+				return nil, err
+			}
+			if size > maxFieldSize {
+				return nil, errors.New("message field exceeds maximum size")
+			}
+			fields[j] = make([]byte, size)
+			if _, err := io.ReadFull(r, fields[j]); err != nil {
+				return nil, err
+			}
+		}
+		msgs = append(msgs, Message{Topic: string(fields[0]), Key: fields[1], Value: fields[2]})
+	}
+	return msgs, nil
+}
+
+func writeUvarint(w io.Writer, x uint64) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	n := binary.PutUvarint(buf, x)
+	_, err := w.Write(buf[:n])
+	return err
+}
@@ -0,0 +1,43 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTCPProducer(t *testing.T) {
+	msgs := []Message{
+		{Topic: "topic1", Key: []byte("key1"), Value: []byte("value1")},
+		{Topic: "topic2", Key: []byte{}, Value: []byte{}},
+	}
+
+	// nothing is listening yet
+	sink, err := NewTCPSink("127.0.0.1:0")
+	require.NoError(t, err)
+	addr := sink.Addr()
+	require.NoError(t, sink.Close())
+	producer := NewTCPProducer(addr, time.Second)
+	require.Error(t, producer.Publish(msgs))
+
+	// the producer reconnects once the sink is available
+	sink, err = NewTCPSink(addr)
+	require.NoError(t, err)
+	require.NoError(t, producer.Publish(msgs))
+	require.NoError(t, producer.Publish(msgs[:1]))
+	require.NoError(t, producer.Publish(nil))
+	require.Equal(t, append(msgs, msgs[0]), sink.Messages())
+
+	// a connection dropped by the sink is re-established on the next attempt
+	require.NoError(t, sink.Close())
+	require.Error(t, producer.Publish(msgs))
+	sink, err = NewTCPSink(addr)
+	require.NoError(t, err)
+	t.Cleanup(func() { sink.Close() })
+	require.NoError(t, producer.Publish(msgs))
+	require.Equal(t, msgs, sink.Messages())
+
+	require.NoError(t, producer.Close())
+	require.NoError(t, producer.Close())
+}
@@ -45,3 +45,32 @@ func (wl *StoreKVPairWriteListener) OnWrite(storeKey StoreKey, key []byte, value
 	}
 	return nil
 }
+
+// MemoryListener is a WriteListener which accumulates the StoreKVPairs it receives in memory,
+// in the order they are written, until they are popped
+type MemoryListener struct {
+	stateCache []*StoreKVPair
+}
+
+// NewMemoryListener creates a new, empty MemoryListener
+func NewMemoryListener() *MemoryListener {
+	return &MemoryListener{}
+}
+
+// OnWrite satisfies the WriteListener interface by caching the write as a StoreKVPair
+func (ml *MemoryListener) OnWrite(storeKey StoreKey, key []byte, value []byte, delete bool) error {
+	ml.stateCache = append(ml.stateCache, &StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      key,
+		Value:    value,
+	})
+	return nil
+}
+
+// PopStateCache returns the StoreKVPairs cached since the last call and resets the cache
+func (ml *MemoryListener) PopStateCache() []*StoreKVPair {
+	res := ml.stateCache
+	ml.stateCache = nil
+	return res
+}
@@ -64,3 +64,18 @@ func TestOnWrite(t *testing.T) {
 	testMarshaller.UnmarshalLengthPrefixed(outputBytes, outputKVPair)
 	require.EqualValues(t, expectedOutputKVPair, outputKVPair)
 }
+
+func TestMemoryListener(t *testing.T) {
+	ml := NewMemoryListener()
+	require.Empty(t, ml.PopStateCache())
+
+	testStoreKey := NewKVStoreKey("test_key")
+	require.Nil(t, ml.OnWrite(testStoreKey, []byte("key1"), []byte("value1"), false))
+	require.Nil(t, ml.OnWrite(testStoreKey, []byte("key2"), nil, true))
+
+	require.Equal(t, []*StoreKVPair{
+		{StoreKey: testStoreKey.Name(), Key: []byte("key1"), Value: []byte("value1")},
+		{StoreKey: testStoreKey.Name(), Key: []byte("key2"), Delete: true},
+	}, ml.PopStateCache())
+	require.Empty(t, ml.PopStateCache())
+}
@@ -28,6 +28,11 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []k
 	defer iterB.Close()
 
 	for {
+		// Skip the keys matching a prefix on each side independently, so that a
+		// different number of skipped entries doesn't misalign the comparison.
+		skipPrefixed(iterA, prefixesToSkip)
+		skipPrefixed(iterB, prefixesToSkip)
+
 		if !iterA.Valid() && !iterB.Valid() {
 			return kvAs, kvBs
 		}
@@ -45,23 +50,31 @@ func DiffKVStores(a KVStore, b KVStore, prefixesToSkip [][]byte) (kvAs, kvBs []k
 			iterB.Next()
 		}
 
-		compareValue := true
-
-		for _, prefix := range prefixesToSkip {
-			// Skip value comparison if we matched a prefix
-			if bytes.HasPrefix(kvA.Key, prefix) || bytes.HasPrefix(kvB.Key, prefix) {
-				compareValue = false
-				break
-			}
-		}
-
-		if compareValue && (!bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value)) {
+		if !bytes.Equal(kvA.Key, kvB.Key) || !bytes.Equal(kvA.Value, kvB.Value) {
 			kvAs = append(kvAs, kvA)
 			kvBs = append(kvBs, kvB)
 		}
 	}
 }
 
+// skipPrefixed advances the iterator past all the keys matching one of the
+// provided prefixes.
+func skipPrefixed(iter Iterator, prefixes [][]byte) {
+	for iter.Valid() && hasAnyPrefix(iter.Key(), prefixes) {
+		iter.Next()
+	}
+}
+
+func hasAnyPrefix(key []byte, prefixes [][]byte) bool {
+	for _, prefix := range prefixes {
+		if bytes.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // PrefixEndBytes returns the []byte that would end a
 // range query for all []byte with a certain prefix
 // Deals with last byte of prefix being FF without overflowing
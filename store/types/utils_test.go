@@ -68,6 +68,16 @@ func TestDiffKVStores(t *testing.T) {
 	kvAs, kvBs = types.DiffKVStores(store1, store2, [][]byte{prefix})
 	require.Equal(t, 0, len(kvAs))
 	require.Equal(t, len(kvAs), len(kvBs))
+
+	// Different number of prefixed keys followed by an equal key. The skipped
+	// keys must not misalign the comparison of the following ones.
+	store1.Set(append(prefix, k2...), v1)
+	k3 := []byte("z3")
+	store1.Set(k3, v1)
+	store2.Set(k3, v1)
+	kvAs, kvBs = types.DiffKVStores(store1, store2, [][]byte{prefix})
+	require.Equal(t, 0, len(kvAs))
+	require.Equal(t, len(kvAs), len(kvBs))
 }
 
 func TestPrefixEndBytes(t *testing.T) {
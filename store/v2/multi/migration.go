@@ -0,0 +1,118 @@
+package root
+
+import (
+	"bytes"
+	"fmt"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	util "github.com/cosmos/cosmos-sdk/internal"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	v1Store "github.com/cosmos/cosmos-sdk/store/rootmulti"
+	v1types "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2"
+)
+
+// MigrateFromV1 copies the state of every IAVL substore mounted in a v1 rootmulti.Store, as of the
+// given version, into a new v2 MultiStore backed by store2db. The substores are registered in
+// storeConfig's schema as persistent stores (memory and transient stores are registered with their
+// own types but not copied), and the migrated state is committed as the same version.
+// After committing, the contents of each substore are verified key-by-key against the source.
+//
+// The target DB must be empty. The returned Store should be closed by the caller.
+func MigrateFromV1(rootStore *v1Store.Store, version int64, store2db dbm.DBConnection, storeConfig StoreConfig) (*Store, error) {
+	if version <= 0 {
+		return nil, fmt.Errorf("cannot migrate version %v", version)
+	}
+	versions, err := store2db.Versions()
+	if err != nil {
+		return nil, err
+	}
+	if versions.Count() != 0 {
+		return nil, fmt.Errorf("target DB is not empty, latest version: %v", versions.Last())
+	}
+
+	// Collect the IAVL stores to migrate, and register all mounted stores in the schema
+	sources := map[string]*iavl.Store{}
+	for name, key := range rootStore.StoreKeysByName() {
+		var typ types.StoreType
+		switch store := rootStore.GetCommitKVStore(key).(type) {
+		case *iavl.Store:
+			if !store.VersionExists(version) {
+				return nil, fmt.Errorf("version %v of store %q does not exist", version, name)
+			}
+			immutable, err := store.GetImmutable(version)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load store %q at version %v: %w", name, version, err)
+			}
+			sources[name] = immutable
+			typ = types.StoreTypePersistent
+		default:
+			switch sst := store.GetStoreType(); sst {
+			case v1types.StoreTypeMemory, v1types.StoreTypeTransient:
+				typ = sst
+			default:
+				return nil, fmt.Errorf("don't know how to migrate store %q of type %T", name, store)
+			}
+		}
+		if existing, has := storeConfig.StoreSchema[name]; has {
+			if existing != typ {
+				return nil, fmt.Errorf("store %q is registered with type %v, expected %v", name, existing, typ)
+			}
+			continue
+		}
+		if err := storeConfig.RegisterSubstore(name, typ); err != nil {
+			return nil, err
+		}
+	}
+
+	storeConfig.InitialVersion = uint64(version)
+	store, err := NewStore(store2db, storeConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, source := range sources {
+		target := store.GetKVStore(types.NewKVStoreKey(name))
+		it := source.Iterator(nil, nil)
+		for ; it.Valid(); it.Next() {
+			target.Set(it.Key(), it.Value())
+		}
+		if err := it.Close(); err != nil {
+			return nil, util.CombineErrors(err, store.Close(), "store.Close also failed")
+		}
+	}
+	store.Commit()
+
+	for name, source := range sources {
+		if err := verifyMigratedStore(source, store.GetKVStore(types.NewKVStoreKey(name))); err != nil {
+			return nil, util.CombineErrors(
+				fmt.Errorf("verification of store %q failed: %w", name, err), store.Close(), "store.Close also failed")
+		}
+	}
+	return store, nil
+}
+
+// Checks that both stores contain exactly the same key-value pairs.
+func verifyMigratedStore(source, target types.KVStore) error {
+	sourceIt := source.Iterator(nil, nil)
+	defer sourceIt.Close()
+	targetIt := target.Iterator(nil, nil)
+	defer targetIt.Close()
+
+	for ; sourceIt.Valid(); sourceIt.Next() {
+		if !targetIt.Valid() {
+			return fmt.Errorf("missing key %X", sourceIt.Key())
+		}
+		if !bytes.Equal(sourceIt.Key(), targetIt.Key()) {
+			return fmt.Errorf("key mismatch: expected %X, got %X", sourceIt.Key(), targetIt.Key())
+		}
+		if !bytes.Equal(sourceIt.Value(), targetIt.Value()) {
+			return fmt.Errorf("value mismatch for key %X", sourceIt.Key())
+		}
+		targetIt.Next()
+	}
+	if targetIt.Valid() {
+		return fmt.Errorf("unexpected key %X", targetIt.Key())
+	}
+	return nil
+}
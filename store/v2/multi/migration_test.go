@@ -0,0 +1,89 @@
+package root
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmdb "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	v1Store "github.com/cosmos/cosmos-sdk/store/rootmulti"
+	v1types "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2"
+)
+
+func newV1MultiStoreWithData(t *testing.T, stores int, storeKeys int) (*v1Store.Store, []types.StoreKey) {
+	rootStore := v1Store.NewStore(tmdb.NewMemDB())
+	var keys []types.StoreKey
+	for i := 0; i < stores; i++ {
+		key := types.NewKVStoreKey(fmt.Sprintf("store%d", i))
+		rootStore.MountStoreWithDB(key, v1types.StoreTypeIAVL, nil)
+		keys = append(keys, key)
+	}
+	rootStore.MountStoreWithDB(v1types.NewMemoryStoreKey("memstore"), v1types.StoreTypeMemory, nil)
+	rootStore.MountStoreWithDB(v1types.NewTransientStoreKey("transtore"), v1types.StoreTypeTransient, nil)
+	require.NoError(t, rootStore.LoadLatestVersion())
+
+	for _, key := range keys {
+		store := rootStore.GetKVStore(key)
+		for j := 0; j < storeKeys; j++ {
+			store.Set([]byte(fmt.Sprintf("key-%d", j)), []byte(fmt.Sprintf("value-%s-%d", key.Name(), j)))
+		}
+	}
+	rootStore.Commit()
+	return rootStore, keys
+}
+
+func TestMigrateFromV1(t *testing.T) {
+	rootStore, keys := newV1MultiStoreWithData(t, 3, 50)
+	// changes in a later version must not be migrated
+	rootStore.GetKVStore(keys[0]).Set([]byte("key-0"), []byte("changed"))
+	rootStore.GetKVStore(keys[0]).Delete([]byte("key-1"))
+	rootStore.Commit()
+
+	store, err := MigrateFromV1(rootStore, 1, memdb.NewDB(), DefaultStoreConfig())
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, store.Close()) })
+
+	require.Equal(t, int64(1), store.LastCommitID().Version)
+	require.Equal(t, types.StoreTypePersistent, store.schema["store0"])
+	require.Equal(t, types.StoreTypeMemory, store.schema["memstore"])
+	require.Equal(t, types.StoreTypeTransient, store.schema["transtore"])
+
+	for _, key := range keys {
+		migrated := store.GetKVStore(key)
+		for j := 0; j < 50; j++ {
+			require.Equal(t, []byte(fmt.Sprintf("value-%s-%d", key.Name(), j)),
+				migrated.Get([]byte(fmt.Sprintf("key-%d", j))))
+		}
+	}
+
+	// the migrated store can continue from the migrated version
+	store.GetKVStore(keys[0]).Set([]byte("key-0"), []byte("changed"))
+	require.Equal(t, int64(2), store.Commit().Version)
+}
+
+func TestMigrateFromV1_Errors(t *testing.T) {
+	rootStore, _ := newV1MultiStoreWithData(t, 2, 5)
+
+	_, err := MigrateFromV1(rootStore, 0, memdb.NewDB(), DefaultStoreConfig())
+	require.Error(t, err)
+
+	_, err = MigrateFromV1(rootStore, 2, memdb.NewDB(), DefaultStoreConfig())
+	require.Error(t, err, "version does not exist")
+
+	// schema conflicts with the mounted store types
+	opts := DefaultStoreConfig()
+	require.NoError(t, opts.RegisterSubstore("store0", types.StoreTypeMemory))
+	_, err = MigrateFromV1(rootStore, 1, memdb.NewDB(), opts)
+	require.Error(t, err)
+
+	// target DB must be empty
+	db := memdb.NewDB()
+	store, err := MigrateFromV1(rootStore, 1, db, DefaultStoreConfig())
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+	_, err = MigrateFromV1(rootStore, 1, db, DefaultStoreConfig())
+	require.Error(t, err)
+}
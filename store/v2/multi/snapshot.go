@@ -0,0 +1,229 @@
+package root
+
+import (
+	"bufio"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+
+	protoio "github.com/gogo/protobuf/io"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	snapshotChunkSize   = uint64(10e6)
+	snapshotBufferSize  = int(snapshotChunkSize)
+	snapshotMaxItemSize = int(64e6) // SDK has no key/value size limit, so we set an arbitrary limit
+)
+
+// Snapshot implements snapshottypes.Snapshotter. The snapshot output for a given format must be
+// identical across nodes such that chunks from different sources fit together.
+//
+// Persistent substores are exported in lexical order of their names. Each substore is serialized
+// as a SnapshotStoreItem followed by a SnapshotKVItem for every key-value pair of its flat state,
+// in key order. The SMT is not exported; it is rebuilt from the flat state on restore.
+func (rs *Store) Snapshot(height uint64, format uint32) (<-chan io.ReadCloser, error) {
+	if format != snapshottypes.CurrentFormat {
+		return nil, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot snapshot height 0")
+	}
+	if height > uint64(rs.LastCommitID().Version) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot snapshot future height %v", height)
+	}
+	view, err := rs.getView(int64(height))
+	if err != nil {
+		return nil, err
+	}
+
+	// Collect substores to snapshot (only persistent stores are supported)
+	names := []string{}
+	for key, typ := range view.schema {
+		if typ == types.StoreTypePersistent {
+			names = append(names, key)
+		}
+	}
+	sort.Strings(names)
+
+	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
+	ch := make(chan io.ReadCloser)
+	go func() {
+		// Set up a stream pipeline to serialize snapshot items:
+		// KV pair -> delimited Protobuf -> zlib -> buffer -> chunkWriter -> chan io.ReadCloser
+		chunkWriter := snapshots.NewChunkWriter(ch, snapshotChunkSize)
+		defer chunkWriter.Close()
+		defer func() {
+			if err := view.discard(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+		bufWriter := bufio.NewWriterSize(chunkWriter, snapshotBufferSize)
+		defer func() {
+			if err := bufWriter.Flush(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+		zWriter, err := zlib.NewWriterLevel(bufWriter, 7)
+		if err != nil {
+			chunkWriter.CloseWithError(sdkerrors.Wrap(err, "zlib failure"))
+			return
+		}
+		defer func() {
+			if err := zWriter.Close(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+		protoWriter := protoio.NewDelimitedWriter(zWriter)
+		defer func() {
+			if err := protoWriter.Close(); err != nil {
+				chunkWriter.CloseWithError(err)
+			}
+		}()
+
+		for _, name := range names {
+			if err := snapshotSubstore(protoWriter, view, name); err != nil {
+				chunkWriter.CloseWithError(err)
+				return
+			}
+		}
+	}()
+
+	return ch, nil
+}
+
+// Writes the store item and all key-value pairs of a single substore to the snapshot stream.
+func snapshotSubstore(protoWriter protoio.WriteCloser, view *viewStore, name string) error {
+	sub, err := view.getSubstore(name)
+	if err != nil {
+		return err
+	}
+	err = protoWriter.WriteMsg(&storetypes.SnapshotItem{
+		Item: &storetypes.SnapshotItem_Store{
+			Store: &storetypes.SnapshotStoreItem{Name: name},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	it, err := sub.dataBucket.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	for it.Next() {
+		err = protoWriter.WriteMsg(&storetypes.SnapshotItem{
+			Item: &storetypes.SnapshotItem_KV{
+				KV: &storetypes.SnapshotKVItem{
+					Key:   it.Key(),
+					Value: it.Value(),
+				},
+			},
+		})
+		if err != nil {
+			it.Close()
+			return err
+		}
+	}
+	return it.Close()
+}
+
+// Restore implements snapshottypes.Snapshotter. The store must be empty (have no saved versions),
+// and its schema must contain every substore present in the snapshot. The restored state is
+// committed as the given height.
+func (rs *Store) Restore(
+	height uint64, format uint32, chunks <-chan io.ReadCloser, ready chan<- struct{},
+) error {
+	if format != snapshottypes.CurrentFormat {
+		return sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+	if height == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrLogic, "cannot restore snapshot at height 0")
+	}
+	if height > uint64(math.MaxInt64) {
+		return sdkerrors.Wrapf(snapshottypes.ErrInvalidMetadata,
+			"snapshot height %v cannot exceed %v", height, int64(math.MaxInt64))
+	}
+	versions, err := rs.stateDB.Versions()
+	if err != nil {
+		return err
+	}
+	if versions.Count() != 0 {
+		return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot restore snapshot to non-empty store at version %v",
+			versions.Last())
+	}
+
+	// Signal readiness. Must be done before the readers below are set up, since the zlib
+	// reader reads from the stream on initialization, potentially causing deadlocks.
+	if ready != nil {
+		close(ready)
+	}
+
+	// Set up a restore stream pipeline
+	// chan io.ReadCloser -> chunkReader -> zlib -> delimited Protobuf -> KV pair
+	chunkReader := snapshots.NewChunkReader(chunks)
+	defer chunkReader.Close()
+	zReader, err := zlib.NewReader(chunkReader)
+	if err != nil {
+		return sdkerrors.Wrap(err, "zlib failure")
+	}
+	defer zReader.Close()
+	protoReader := protoio.NewDelimitedReader(zReader, snapshotMaxItemSize)
+	defer protoReader.Close()
+
+	// Import key-value pairs into substores. The first item is expected to be a SnapshotItem
+	// containing a SnapshotStoreItem, telling us which substore to import into. The following items
+	// will contain SnapshotKVItem until we reach the next SnapshotStoreItem or EOF.
+	var sub *substore
+	for {
+		item := &storetypes.SnapshotItem{}
+		err := protoReader.ReadMsg(item)
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+
+		switch item := item.Item.(type) {
+		case *storetypes.SnapshotItem_Store:
+			name := item.Store.Name
+			if typ, has := rs.schema[name]; !has || typ != types.StoreTypePersistent {
+				return sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-persistent substore %q", name)
+			}
+			sub, err = rs.getSubstore(name)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to access substore %q", name)
+			}
+			rs.substoreCache[name] = sub
+
+		case *storetypes.SnapshotItem_KV:
+			if sub == nil {
+				return sdkerrors.Wrap(sdkerrors.ErrLogic, "received KV item before store item")
+			}
+			// Protobuf does not differentiate between []byte{} and nil, but the substore
+			// does not allow nil values, so we set them to empty.
+			value := item.KV.Value
+			if value == nil {
+				value = []byte{}
+			}
+			sub.Set(item.KV.Key, value)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrLogic, "unknown snapshot item %T", item)
+		}
+	}
+
+	rs.mtx.Lock()
+	defer rs.mtx.Unlock()
+	_, err = rs.commit(height)
+	if err != nil {
+		return fmt.Errorf("failed to commit restored state: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmdb "github.com/tendermint/tm-db"
+
+	dbm "github.com/cosmos/cosmos-sdk/db"
+	"github.com/cosmos/cosmos-sdk/db/memdb"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	types "github.com/cosmos/cosmos-sdk/store/v2"
+)
+
+func multiStoreConfig(t *testing.T, stores int) StoreConfig {
+	opts := DefaultStoreConfig()
+	opts.Pruning = types.PruneNothing
+
+	for i := 0; i < stores; i++ {
+		sKey := types.NewKVStoreKey(fmt.Sprintf("store%d", i))
+		require.NoError(t, opts.RegisterSubstore(sKey.Name(), types.StoreTypePersistent))
+	}
+	require.NoError(t, opts.RegisterSubstore("memstore", types.StoreTypeMemory))
+	require.NoError(t, opts.RegisterSubstore("transtore", types.StoreTypeTransient))
+
+	return opts
+}
+
+func newMultiStoreWithGeneratedData(t *testing.T, db dbm.DBConnection, stores int, storeKeys uint64) *Store {
+	cfg := multiStoreConfig(t, stores)
+	store, err := NewStore(db, cfg)
+	require.NoError(t, err)
+
+	var sKeys []string
+	for sKey := range store.schema {
+		sKeys = append(sKeys, sKey)
+	}
+
+	for _, sKey := range sKeys {
+		sStore := store.GetKVStore(types.NewKVStoreKey(sKey))
+		for j := uint64(0); j < storeKeys; j++ {
+			k := []byte(fmt.Sprintf("key-%v", j))
+			v := []byte(fmt.Sprintf("value-%v-%v", sKey, j))
+			sStore.Set(k, v)
+		}
+		// overwrite and delete some keys to exercise the index and SMT updates
+		sStore.Set([]byte("key-0"), []byte("updated"))
+		sStore.Delete([]byte("key-1"))
+	}
+	store.Commit()
+	return store
+}
+
+func assertStoresEqual(t *testing.T, source, target *Store, height int64) {
+	sourceView, err := source.getView(height)
+	require.NoError(t, err)
+	targetView, err := target.getView(height)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, sourceView.discard())
+		require.NoError(t, targetView.discard())
+	}()
+
+	for key, typ := range source.schema {
+		if typ != types.StoreTypePersistent {
+			continue
+		}
+		sourceSub, err := sourceView.getSubstore(key)
+		require.NoError(t, err)
+		targetSub, err := targetView.getSubstore(key)
+		require.NoError(t, err)
+		require.Equal(t, sourceSub.stateCommitmentStore.Root(), targetSub.stateCommitmentStore.Root(),
+			"SMT root of store %q not equal", key)
+
+		sourceIter := sourceSub.Iterator(nil, nil)
+		targetIter := targetSub.Iterator(nil, nil)
+		for ; sourceIter.Valid(); sourceIter.Next() {
+			require.True(t, targetIter.Valid(), "store %q is missing key %X", key, sourceIter.Key())
+			require.Equal(t, sourceIter.Key(), targetIter.Key())
+			require.Equal(t, sourceIter.Value(), targetIter.Value())
+			targetIter.Next()
+		}
+		require.False(t, targetIter.Valid(), "store %q has extra keys", key)
+		require.NoError(t, sourceIter.Close())
+		require.NoError(t, targetIter.Close())
+	}
+}
+
+func TestMultistoreSnapshot_Errors(t *testing.T) {
+	store := newMultiStoreWithGeneratedData(t, memdb.NewDB(), 4, 4)
+
+	testcases := map[string]struct {
+		height     uint64
+		format     uint32
+		expectType error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, nil},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown height": {9, snapshottypes.CurrentFormat, nil},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := store.Snapshot(tc.height, tc.format)
+			require.Error(t, err)
+			if tc.expectType != nil {
+				assert.True(t, errors.Is(err, tc.expectType))
+			}
+		})
+	}
+}
+
+func TestMultistoreRestore_Errors(t *testing.T) {
+	store, err := NewStore(memdb.NewDB(), multiStoreConfig(t, 4))
+	require.NoError(t, err)
+
+	testcases := map[string]struct {
+		height     uint64
+		format     uint32
+		expectType error
+	}{
+		"0 height":       {0, snapshottypes.CurrentFormat, nil},
+		"0 format":       {1, 0, snapshottypes.ErrUnknownFormat},
+		"unknown format": {1, 9, snapshottypes.ErrUnknownFormat},
+	}
+	for name, tc := range testcases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := store.Restore(tc.height, tc.format, nil, nil)
+			require.Error(t, err)
+			if tc.expectType != nil {
+				assert.True(t, errors.Is(err, tc.expectType))
+			}
+		})
+	}
+
+	// restoring into a store with saved versions is not allowed
+	nonEmpty := newMultiStoreWithGeneratedData(t, memdb.NewDB(), 4, 4)
+	require.Error(t, nonEmpty.Restore(1, snapshottypes.CurrentFormat, nil, nil))
+}
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(t, memdb.NewDB(), 3, 4)
+	target, err := NewStore(memdb.NewDB(), multiStoreConfig(t, 3))
+	require.NoError(t, err)
+	// write a second version to the source, so that the snapshot is taken at a past height
+	source.GetKVStore(types.NewKVStoreKey("store0")).Set([]byte("key-new"), []byte("value-new"))
+	source.Commit()
+
+	version := uint64(1)
+	chunks, err := source.Snapshot(version, snapshottypes.CurrentFormat)
+	require.NoError(t, err)
+	ready := make(chan struct{})
+	err = target.Restore(version, snapshottypes.CurrentFormat, chunks, ready)
+	require.NoError(t, err)
+	assert.EqualValues(t, struct{}{}, <-ready)
+
+	sourceView, err := source.getView(int64(version))
+	require.NoError(t, err)
+	sourceRoot, err := sourceView.stateView.Get(merkleRootKey)
+	require.NoError(t, err)
+	require.NoError(t, sourceView.discard())
+
+	assert.Equal(t, types.CommitID{Version: int64(version), Hash: sourceRoot}, target.LastCommitID())
+	assertStoresEqual(t, source, target, int64(version))
+
+	// the restored store can keep committing
+	target.GetKVStore(types.NewKVStoreKey("store0")).Set([]byte("key-new"), []byte("value-new"))
+	assert.Equal(t, source.LastCommitID(), target.Commit())
+}
+
+func TestMultistoreSnapshotRestore_Manager(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(t, memdb.NewDB(), 3, 100)
+	target, err := NewStore(memdb.NewDB(), multiStoreConfig(t, 3))
+	require.NoError(t, err)
+	version := uint64(source.LastCommitID().Version)
+
+	// os.MkdirTemp() is used instead of testing.T.TempDir()
+	// see https://github.com/cosmos/cosmos-sdk/pull/8475 for
+	// this change's rationale.
+	tempdir, err := os.MkdirTemp("", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = os.RemoveAll(tempdir) })
+	snapshotStore, err := snapshots.NewStore(tmdb.NewMemDB(), tempdir)
+	require.NoError(t, err)
+
+	sourceManager := snapshots.NewManager(snapshotStore, source)
+	snapshot, err := sourceManager.Create(version)
+	require.NoError(t, err)
+	require.EqualValues(t, version, snapshot.Height)
+
+	targetManager := snapshots.NewManager(snapshotStore, target)
+	require.NoError(t, targetManager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := sourceManager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := targetManager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+
+	assert.Equal(t, source.LastCommitID(), target.LastCommitID())
+	assertStoresEqual(t, source, target, int64(version))
+}
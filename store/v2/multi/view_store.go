@@ -128,6 +128,15 @@ func (store *Store) getView(version int64) (ret *viewStore, err error) {
 	return
 }
 
+// Releases the underlying DB readers of a view.
+func (vs *viewStore) discard() error {
+	err := vs.stateView.Discard()
+	if vs.stateCommitmentView != vs.stateView {
+		err = util.CombineErrors(err, vs.stateCommitmentView.Discard(), "stateCommitmentView.Discard also failed")
+	}
+	return err
+}
+
 func (vs *viewStore) GetKVStore(skey types.StoreKey) types.KVStore {
 	key := skey.Name()
 	if _, has := vs.schema[key]; !has {
@@ -480,6 +480,29 @@ func (s *coinTestSuite) TestSubCoins() {
 	}
 }
 
+func (s *coinTestSuite) TestMinCoins() {
+	testCases := []struct {
+		inputOne sdk.Coins
+		inputTwo sdk.Coins
+		expected sdk.Coins
+	}{
+		{sdk.Coins{}, sdk.Coins{}, sdk.Coins{}},
+		{sdk.Coins{s.ca1}, sdk.Coins{}, sdk.Coins{}},
+		{sdk.Coins{}, sdk.Coins{s.ca1}, sdk.Coins{}},
+		{sdk.Coins{s.ca1}, sdk.Coins{s.ca2}, sdk.Coins{s.ca1}},
+		{sdk.Coins{s.ca2}, sdk.Coins{s.ca1}, sdk.Coins{s.ca1}},
+		{sdk.Coins{s.ca2}, sdk.Coins{s.cm1}, sdk.Coins{}},
+		{sdk.Coins{s.ca1, s.cm2}, sdk.Coins{s.ca2, s.cm1}, sdk.Coins{s.ca1, s.cm1}},
+		{sdk.Coins{s.ca2, s.cm1}, sdk.Coins{s.cm2}, sdk.Coins{s.cm1}},
+	}
+
+	for i, tc := range testCases {
+		res := tc.inputOne.Min(tc.inputTwo)
+		s.Require().True(res.IsValid())
+		s.Require().Equal(tc.expected, res, "minimum of coins is incorrect, tc #%d", i)
+	}
+}
+
 func (s *coinTestSuite) TestCoins_Validate() {
 	testCases := []struct {
 		name    string
@@ -0,0 +1,591 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cosmos/tx/signing/v1beta1/textual.proto
+
+package signing
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TextualData is the data signed over in SIGN_MODE_TEXTUAL, it is the textual
+// rendering of a transaction into the screens displayed by the signing device.
+//
+// Since: cosmos-sdk 0.46
+type TextualData struct {
+	// screens are the screens of the rendering, in display order.
+	Screens []*Screen `protobuf:"bytes,1,rep,name=screens,proto3" json:"screens,omitempty"`
+}
+
+func (m *TextualData) Reset()         { *m = TextualData{} }
+func (m *TextualData) String() string { return proto.CompactTextString(m) }
+func (*TextualData) ProtoMessage()    {}
+func (*TextualData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6df0a8c931f9f8, []int{0}
+}
+func (m *TextualData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TextualData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TextualData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TextualData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TextualData.Merge(m, src)
+}
+func (m *TextualData) XXX_Size() int {
+	return m.Size()
+}
+func (m *TextualData) XXX_DiscardUnknown() {
+	xxx_messageInfo_TextualData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TextualData proto.InternalMessageInfo
+
+func (m *TextualData) GetScreens() []*Screen {
+	if m != nil {
+		return m.Screens
+	}
+	return nil
+}
+
+// Screen is a single screen of the textual rendering of a transaction.
+//
+// Since: cosmos-sdk 0.46
+type Screen struct {
+	// text is the text displayed on the screen.
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// indent is the indentation level of the screen, used to display nested
+	// values.
+	Indent uint32 `protobuf:"varint,2,opt,name=indent,proto3" json:"indent,omitempty"`
+	// expert is set when the screen should only be displayed in the expert mode
+	// of the signing device.
+	Expert bool `protobuf:"varint,3,opt,name=expert,proto3" json:"expert,omitempty"`
+}
+
+func (m *Screen) Reset()         { *m = Screen{} }
+func (m *Screen) String() string { return proto.CompactTextString(m) }
+func (*Screen) ProtoMessage()    {}
+func (*Screen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c6df0a8c931f9f8, []int{1}
+}
+func (m *Screen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Screen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Screen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Screen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Screen.Merge(m, src)
+}
+func (m *Screen) XXX_Size() int {
+	return m.Size()
+}
+func (m *Screen) XXX_DiscardUnknown() {
+	xxx_messageInfo_Screen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Screen proto.InternalMessageInfo
+
+func (m *Screen) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *Screen) GetIndent() uint32 {
+	if m != nil {
+		return m.Indent
+	}
+	return 0
+}
+
+func (m *Screen) GetExpert() bool {
+	if m != nil {
+		return m.Expert
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*TextualData)(nil), "cosmos.tx.signing.v1beta1.TextualData")
+	proto.RegisterType((*Screen)(nil), "cosmos.tx.signing.v1beta1.Screen")
+}
+
+func init() {
+	proto.RegisterFile("cosmos/tx/signing/v1beta1/textual.proto", fileDescriptor_5c6df0a8c931f9f8)
+}
+
+var fileDescriptor_5c6df0a8c931f9f8 = []byte{
+	// 233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xce, 0x2f, 0xce,
+	0xcd, 0x2f, 0xd6, 0x2f, 0xa9, 0xd0, 0x2f, 0xce, 0x4c, 0xcf, 0xcb, 0xcc, 0x4b, 0xd7, 0x2f, 0x33,
+	0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x49, 0xad, 0x28, 0x29, 0x4d, 0xcc, 0xd1, 0x2b, 0x28,
+	0xca, 0x2f, 0xc9, 0x17, 0x92, 0x84, 0x28, 0xd4, 0x2b, 0xa9, 0xd0, 0x83, 0x2a, 0xd4, 0x83, 0x2a,
+	0x54, 0xf2, 0xe2, 0xe2, 0x0e, 0x81, 0xa8, 0x75, 0x49, 0x2c, 0x49, 0x14, 0xb2, 0xe6, 0x62, 0x2f,
+	0x4e, 0x2e, 0x4a, 0x4d, 0xcd, 0x2b, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x36, 0x52, 0xd4, 0xc3,
+	0xa9, 0x57, 0x2f, 0x18, 0xac, 0x32, 0x08, 0xa6, 0x43, 0xc9, 0x87, 0x8b, 0x0d, 0x22, 0x24, 0x24,
+	0xc4, 0xc5, 0x02, 0x72, 0x81, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x98, 0x2d, 0x24, 0xc6,
+	0xc5, 0x96, 0x99, 0x97, 0x92, 0x9a, 0x57, 0x22, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x1b, 0x04, 0xe5,
+	0x81, 0xc4, 0x53, 0x2b, 0x0a, 0x52, 0x8b, 0x4a, 0x24, 0x98, 0x15, 0x18, 0x35, 0x38, 0x82, 0xa0,
+	0x3c, 0x27, 0xf7, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
+	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4d, 0xcf,
+	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x06, 0x01, 0x84, 0xd2, 0x2d, 0x4e,
+	0xc9, 0xd6, 0x2f, 0xa9, 0x2c, 0x48, 0x45, 0x0e, 0x93, 0x24, 0x36, 0x70, 0x20, 0x18, 0x03, 0x06,
+	0x00, 0xa7, 0xc7, 0xd8, 0x9f, 0x2f, 0x01, 0x00, 0x00,
+}
+
+func (m *TextualData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TextualData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TextualData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Screens) > 0 {
+		for iNdEx := len(m.Screens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Screens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTextual(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Screen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Screen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Screen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expert {
+		i--
+		if m.Expert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Indent != 0 {
+		i = encodeVarintTextual(dAtA, i, uint64(m.Indent))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintTextual(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTextual(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTextual(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TextualData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Screens) > 0 {
+		for _, e := range m.Screens {
+			l = e.Size()
+			n += 1 + l + sovTextual(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Screen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovTextual(uint64(l))
+	}
+	if m.Indent != 0 {
+		n += 1 + sovTextual(uint64(m.Indent))
+	}
+	if m.Expert {
+		n += 2
+	}
+	return n
+}
+
+func sovTextual(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTextual(x uint64) (n int) {
+	return sovTextual(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TextualData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTextual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TextualData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TextualData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Screens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Screens = append(m.Screens, &Screen{})
+			if err := m.Screens[len(m.Screens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTextual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Screen) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTextual
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Screen: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Screen: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTextual
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indent", wireType)
+			}
+			m.Indent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Indent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTextual(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTextual
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTextual(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTextual
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTextual
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTextual
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTextual
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTextual
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTextual        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTextual          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTextual = fmt.Errorf("proto: unexpected end of group")
+)
@@ -62,6 +62,9 @@ type AccountKeeper struct {
 	// The prototypical AccountI constructor.
 	proto      func() types.AccountI
 	addressCdc address.Codec
+
+	// the address allowed to update the module parameters
+	authority string
 }
 
 var _ AccountKeeperI = &AccountKeeper{}
@@ -72,9 +75,11 @@ var _ AccountKeeperI = &AccountKeeper{}
 // types.PermissionsForAddress and is used in keeper.ValidatePermissions. Permissions are plain strings,
 // and don't have to fit into any predefined structure. This auth module does not use account permissions internally, though other modules
 // may use auth.Keeper to access the accounts permissions map.
+// `paramstore` is the legacy params subspace the module parameters are migrated from, and `authority` the address of
+// the account, usually the governance module account, allowed to update them.
 func NewAccountKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramstore paramtypes.Subspace, proto func() types.AccountI,
-	maccPerms map[string][]string, bech32Prefix string,
+	maccPerms map[string][]string, bech32Prefix string, authority string,
 ) AccountKeeper {
 
 	// set KeyTable if it has not already been set
@@ -89,6 +94,10 @@ func NewAccountKeeper(
 
 	bech32Codec := newBech32Codec(bech32Prefix)
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid auth authority address: %s", err))
+	}
+
 	return AccountKeeper{
 		key:           key,
 		proto:         proto,
@@ -96,6 +105,7 @@ func NewAccountKeeper(
 		paramSubspace: paramstore,
 		permAddrs:     permAddrs,
 		addressCdc:    bech32Codec,
+		authority:     authority,
 	}
 }
 
@@ -104,6 +114,11 @@ func (ak AccountKeeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// GetAuthority returns the address allowed to update the auth parameters.
+func (ak AccountKeeper) GetAuthority() string {
+	return ak.authority
+}
+
 // GetPubKey Returns the PubKey of the account at address
 func (ak AccountKeeper) GetPubKey(ctx sdk.Context, addr sdk.AccAddress) (cryptotypes.PubKey, error) {
 	acc := ak.GetAccount(ctx, addr)
@@ -132,7 +132,7 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	cdc := simapp.MakeTestEncodingConfig().Codec
 	keeper := keeper.NewAccountKeeper(
 		cdc, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		types.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		types.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, app.AccountKeeper.GetAuthority(),
 	)
 
 	err := keeper.ValidatePermissions(multiPermAcc)
@@ -146,3 +146,27 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	err = app.AccountKeeper.ValidatePermissions(otherAcc)
 	require.Error(t, err)
 }
+
+func TestUnorderedTxs(t *testing.T) {
+	app, ctx := createTestApp(t, true)
+	ctx = ctx.WithBlockHeight(10)
+	txHash1, txHash2 := []byte("tx1"), []byte("tx2")
+
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash1))
+	app.AccountKeeper.AddUnorderedTx(ctx, txHash1, 11)
+	app.AccountKeeper.AddUnorderedTx(ctx, txHash2, 12)
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash2))
+
+	// the txs are kept until the block of their timeout height
+	app.AccountKeeper.PruneUnorderedTxs(ctx)
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash2))
+
+	app.AccountKeeper.PruneUnorderedTxs(ctx.WithBlockHeight(11))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash1))
+	require.True(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash2))
+
+	app.AccountKeeper.PruneUnorderedTxs(ctx.WithBlockHeight(12))
+	require.False(t, app.AccountKeeper.ContainsUnorderedTx(ctx, txHash2))
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// ContainsUnorderedTx returns true if the unordered tx with the given hash has
+// been executed and has not timed out yet.
+func (ak AccountKeeper) ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool {
+	return ctx.KVStore(ak.key).Has(types.UnorderedTxHashKey(txHash))
+}
+
+// AddUnorderedTx records the unordered tx with the given hash until its timeout
+// height, so that it cannot be replayed.
+func (ak AccountKeeper) AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedTxHashKey(txHash), sdk.Uint64ToBigEndian(timeoutHeight))
+	store.Set(types.UnorderedTxTimeoutKey(timeoutHeight, txHash), []byte{})
+}
+
+// PruneUnorderedTxs removes the unordered txs which can no longer be included
+// in a block, i.e. the ones whose timeout height is lower or equal to the
+// current block height.
+func (ak AccountKeeper) PruneUnorderedTxs(ctx sdk.Context) {
+	store := ctx.KVStore(ak.key)
+
+	end := types.UnorderedTxTimeoutKeyPrefix(uint64(ctx.BlockHeight()) + 1)
+	iterator := store.Iterator(types.UnorderedTxTimeoutPrefix, end)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		// skip the prefix and the big endian timeout height
+		txHash := key[len(types.UnorderedTxTimeoutPrefix)+8:]
+		store.Delete(types.UnorderedTxHashKey(txHash))
+		store.Delete(key)
+	}
+}
@@ -107,7 +107,7 @@ func (s *MWTestSuite) TestBranchStore() {
 				s.Require().Equal([]byte("ok"), okValue)
 			}
 			// block gas is always consumed
-			baseGas := uint64(24564) // baseGas is the gas consumed by middlewares
+			baseGas := uint64(27564) // baseGas is the gas consumed by middlewares
 			expGasConsumed := addUint64Saturating(tc.gasToConsume, baseGas)
 			s.Require().Equal(expGasConsumed, ctx.BlockGasMeter().GasConsumed())
 			// tx fee is always deducted
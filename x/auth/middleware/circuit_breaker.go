@@ -0,0 +1,113 @@
+package middleware
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+// nestedMsgsExecutor is implemented by the messages executing other messages on
+// behalf of their signer, e.g. the authz MsgExec.
+type nestedMsgsExecutor interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// nestedMsgsProposal is implemented by the messages proposing other messages for
+// execution, e.g. the group MsgCreateProposal.
+type nestedMsgsProposal interface {
+	GetMsgs() []sdk.Msg
+}
+
+// nestedMsgsGovProposal is implemented by the governance MsgSubmitProposal, whose
+// messages are unpacked with an error.
+type nestedMsgsGovProposal interface {
+	GetMsgs() ([]sdk.Msg, error)
+}
+
+var _ tx.Handler = circuitBreakerTxHandler{}
+
+type circuitBreakerTxHandler struct {
+	circuitKeeper CircuitKeeper
+	next          tx.Handler
+}
+
+// CircuitBreakerMiddleware rejects the transactions containing messages whose circuit
+// breaker is tripped, including the messages nested in an authz MsgExec or proposed
+// in a group or governance proposal. It keeps these transactions out of the mempool,
+// while the MsgServiceRouter, given the same keeper with SetCircuitKeeper, enforces
+// the circuit breakers whenever a message is executed.
+func CircuitBreakerMiddleware(ck CircuitKeeper) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return circuitBreakerTxHandler{
+			circuitKeeper: ck,
+			next:          txh,
+		}
+	}
+}
+
+// checkTx returns an error if sdkTx contains a disabled message. The circuit breakers
+// are read without consuming gas, so that they don't change the gas used by the
+// transactions.
+func (txh circuitBreakerTxHandler) checkTx(ctx context.Context, sdkTx sdk.Tx) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(sdk.NewInfiniteGasMeter())
+	return txh.checkMsgs(sdkCtx, sdkTx.GetMsgs())
+}
+
+// checkMsgs returns an error if one of msgs, or of the messages they nest, is disabled
+func (txh circuitBreakerTxHandler) checkMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if typeURL := sdk.MsgTypeURL(msg); !txh.circuitKeeper.IsAllowed(ctx, typeURL) {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is disabled by the circuit breaker", typeURL)
+		}
+
+		var nested []sdk.Msg
+		switch msg := msg.(type) {
+		case nestedMsgsExecutor:
+			var err error
+			if nested, err = msg.GetMessages(); err != nil {
+				return err
+			}
+		case nestedMsgsProposal:
+			nested = msg.GetMsgs()
+		case nestedMsgsGovProposal:
+			var err error
+			if nested, err = msg.GetMsgs(); err != nil {
+				return err
+			}
+		}
+		if err := txh.checkMsgs(ctx, nested); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh circuitBreakerTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	if err := txh.checkTx(ctx, req.Tx); err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh circuitBreakerTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkTx(ctx, req.Tx); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh circuitBreakerTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkTx(ctx, req.Tx); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, req)
+}
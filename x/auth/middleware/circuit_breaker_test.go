@@ -0,0 +1,104 @@
+package middleware_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1beta2 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func (s *MWTestSuite) TestCircuitBreaker() {
+	ctx := s.SetupTest(false) // setup
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.CircuitBreakerMiddleware(s.app.CircuitKeeper),
+	)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 100))
+	send := banktypes.NewMsgSend(addr1, addr2, coins)
+	exec := authz.NewMsgExec(addr1, []sdk.Msg{banktypes.NewMsgSend(addr2, addr1, coins)})
+	proposal, err := group.NewMsgCreateProposalRequest(addr2.String(), []string{addr1.String()}, []sdk.Msg{send}, nil, group.Exec_EXEC_UNSPECIFIED)
+	s.Require().NoError(err)
+	govProposal, err := govv1beta2.NewMsgSubmitProposal([]sdk.Msg{send}, coins, addr1.String(), nil, false)
+	s.Require().NoError(err)
+	msgSendURL := sdk.MsgTypeURL(send)
+
+	testCases := []struct {
+		desc    string
+		msg     sdk.Msg
+		tripped bool
+		expErr  bool
+	}{
+		{"allowed message", send, false, false},
+		{"disabled message", send, true, true},
+		{"allowed nested message", &exec, false, false},
+		{"disabled nested message", &exec, true, true},
+		{"allowed proposed message", proposal, false, false},
+		{"disabled proposed message", proposal, true, true},
+		{"allowed governance proposal message", govProposal, false, false},
+		{"disabled governance proposal message", govProposal, true, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.desc, func() {
+			ctx, _ := ctx.CacheContext()
+			if tc.tripped {
+				s.app.CircuitKeeper.DisableMsg(ctx, msgSendURL)
+			}
+
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(tc.msg))
+			txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+			s.Require().NoError(err)
+
+			_, _, checkErr := txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+			_, deliverErr := txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+			_, simulateErr := txHandler.SimulateTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+			for _, err := range []error{checkErr, deliverErr, simulateErr} {
+				if tc.expErr {
+					s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+					s.Require().Contains(err.Error(), msgSendURL)
+				} else {
+					s.Require().NoError(err)
+				}
+			}
+		})
+	}
+}
+
+func (s *MWTestSuite) TestMsgServiceRouterCircuitBreaker() {
+	ctx := s.SetupTest(false) // setup
+	msr := middleware.NewMsgServiceRouter(s.app.InterfaceRegistry())
+	banktypes.RegisterMsgServer(msr, bankkeeper.NewMsgServerImpl(s.app.BankKeeper))
+	msr.SetCircuitKeeper(s.app.CircuitKeeper)
+
+	accounts := s.createTestAccounts(ctx, 2, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)))
+	send := banktypes.NewMsgSend(accounts[0].acc.GetAddress(), accounts[1].acc.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("atom", 100)))
+	handler := msr.Handler(send)
+	s.Require().NotNil(handler)
+
+	// The handlers check the circuit breakers, so that the messages executed
+	// outside of a transaction, e.g. by a group or governance proposal, are
+	// rejected too.
+	_, err := handler(ctx, send)
+	s.Require().NoError(err)
+
+	s.app.CircuitKeeper.DisableMsg(ctx, sdk.MsgTypeURL(send))
+	gasBefore := ctx.GasMeter().GasConsumed()
+	_, err = handler(ctx, send)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	s.Require().Equal(gasBefore, ctx.GasMeter().GasConsumed())
+	s.Require().Equal(sdk.NewInt64Coin("atom", 1100), s.app.BankKeeper.GetBalance(ctx, accounts[1].acc.GetAddress(), "atom"))
+}
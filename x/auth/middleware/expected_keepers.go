@@ -18,3 +18,23 @@ type AccountKeeper interface {
 type FeegrantKeeper interface {
 	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
 }
+
+// FeeMarketKeeper defines the expected fee market keeper, which sets the base gas
+// price that the transactions must pay.
+type FeeMarketKeeper interface {
+	GetBaseGasPrice(ctx sdk.Context) (price sdk.DecCoin, enabled bool)
+	HandleBaseFee(ctx sdk.Context, fee sdk.Coins) error
+}
+
+// CircuitKeeper defines the expected circuit breaker keeper, which disables the
+// execution of message types at runtime.
+type CircuitKeeper interface {
+	IsAllowed(ctx sdk.Context, msgTypeURL string) bool
+}
+
+// UnorderedTxKeeper defines the expected keeper recording the hashes of the
+// unordered txs until their timeout height.
+type UnorderedTxKeeper interface {
+	ContainsUnorderedTx(ctx sdk.Context, txHash []byte) bool
+	AddUnorderedTx(ctx sdk.Context, txHash []byte, timeoutHeight uint64)
+}
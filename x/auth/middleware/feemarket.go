@@ -0,0 +1,135 @@
+package middleware
+
+import (
+	"context"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var _ tx.Handler = feeMarketTxHandler{}
+
+type feeMarketTxHandler struct {
+	feeMarketKeeper FeeMarketKeeper
+	next            tx.Handler
+}
+
+// FeeMarketMiddleware requires the transactions to pay at least the base gas price
+// of the fee market for their gas limit. The base fee, i.e. the base gas price times
+// the gas limit, is then burned or routed by the fee market, and only the remaining
+// tip goes to the validators. When the fee market is disabled, the middleware does
+// nothing.
+//
+// The middleware must be placed after `DeductFeeMiddleware`, since the base fee is
+// taken from the fee collector, and before `TxPriorityMiddleware`, since it
+// overrides the priority of the transaction with its tip per gas.
+// CONTRACT: Tx must implement FeeTx to use FeeMarketMiddleware
+func FeeMarketMiddleware(fmk FeeMarketKeeper) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return feeMarketTxHandler{
+			feeMarketKeeper: fmk,
+			next:            txh,
+		}
+	}
+}
+
+// baseFee returns the base fee and the tip paid by sdkTx, or an error if sdkTx
+// pays less than the base fee and strict is set. enabled is false when the fee
+// market is disabled or when delivering the genesis transactions.
+func (txh feeMarketTxHandler) baseFee(ctx context.Context, sdkTx sdk.Tx, strict bool) (baseFee, tip sdk.Coin, enabled bool, err error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	feeTx, ok := sdkTx.(sdk.FeeTx)
+	if !ok {
+		return baseFee, tip, false, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	// the genesis transactions don't pay fees, and are delivered before the fee
+	// market genesis is initialized
+	if sdkCtx.BlockHeight() == 0 {
+		return baseFee, tip, false, nil
+	}
+
+	price, enabled := txh.feeMarketKeeper.GetBaseGasPrice(sdkCtx)
+	if !enabled {
+		return baseFee, tip, false, nil
+	}
+
+	// fee = ceil(baseGasPrice * gasLimit), as for the minimum gas prices
+	baseFee = sdk.NewCoin(price.Denom, price.Amount.MulInt64(int64(feeTx.GetGas())).Ceil().RoundInt())
+	paid := sdk.NewCoin(price.Denom, feeTx.GetFee().AmountOf(price.Denom))
+	if paid.IsLT(baseFee) {
+		if strict {
+			return baseFee, tip, true, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required base fee: %s", feeTx.GetFee(), baseFee)
+		}
+		baseFee = paid
+	}
+
+	return baseFee, paid.Sub(baseFee), true, nil
+}
+
+// CheckTx implements tx.Handler.CheckTx. It rejects the transactions paying less
+// than the base fee, and sets their priority to their tip per gas.
+func (txh feeMarketTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	_, tip, enabled, err := txh.baseFee(ctx, req.Tx, true)
+	if err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	res, checkRes, err := txh.next.CheckTx(ctx, req, checkReq)
+	if enabled {
+		checkRes.Priority = getTipPriority(tip, req.Tx.(sdk.FeeTx).GetGas())
+	}
+
+	return res, checkRes, err
+}
+
+// DeliverTx implements tx.Handler.DeliverTx. It rejects the transactions paying
+// less than the base fee, and burns or routes the base fee of the others.
+func (txh feeMarketTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.handleBaseFee(ctx, req.Tx, true); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx. The simulated transactions are not
+// required to pay the base fee, but the base fee they pay is still handled so that
+// the gas is estimated correctly.
+func (txh feeMarketTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.handleBaseFee(ctx, req.Tx, false); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, req)
+}
+
+func (txh feeMarketTxHandler) handleBaseFee(ctx context.Context, sdkTx sdk.Tx, strict bool) error {
+	baseFee, _, enabled, err := txh.baseFee(ctx, sdkTx, strict)
+	if err != nil || !enabled {
+		return err
+	}
+
+	return txh.feeMarketKeeper.HandleBaseFee(sdk.UnwrapSDKContext(ctx), sdk.NewCoins(baseFee))
+}
+
+// tipPriorityScale is the number of gas units for which the tip is used as priority,
+// so that tips lower than one unit of the fee denom per gas still order transactions.
+const tipPriorityScale = 1_000_000
+
+// getTipPriority returns the priority of a transaction paying tip for gas: its tip
+// per million gas units, capped at math.MaxInt64.
+func getTipPriority(tip sdk.Coin, gas uint64) int64 {
+	if gas == 0 || !tip.IsPositive() {
+		return 0
+	}
+
+	priority := tip.Amount.MulRaw(tipPriorityScale).Quo(sdk.NewIntFromUint64(gas))
+	if !priority.IsInt64() {
+		return math.MaxInt64
+	}
+
+	return priority.Int64()
+}
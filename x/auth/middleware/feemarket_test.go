@@ -0,0 +1,103 @@
+package middleware_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+func (s *MWTestSuite) TestFeeMarket() {
+	ctx := s.SetupTest(false) // setup
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.DeductFeeMiddleware(s.app.AccountKeeper, s.app.BankKeeper, s.app.FeeGrantKeeper),
+		middleware.FeeMarketMiddleware(s.app.FeeMarketKeeper),
+	)
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	acc := s.app.AccountKeeper.NewAccountWithAddress(ctx, addr1)
+	s.app.AccountKeeper.SetAccount(ctx, acc)
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, ctx, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000))))
+
+	// msg and signatures, paying 150atom for 200000 gas
+	msg := testdata.NewTestMsg(addr1)
+	s.Require().NoError(txBuilder.SetMsgs(msg))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	testTx, _, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	feeCollector := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	setParams := func(ctx sdk.Context, enabled bool, price sdk.Dec, recipient string) {
+		params := feemarket.DefaultParams()
+		params.Enabled = enabled
+		params.MinBaseGasPrice = sdk.NewDecCoinFromDec("atom", price)
+		params.BaseFeeRecipient = recipient
+		s.app.FeeMarketKeeper.SetParams(ctx, params)
+	}
+
+	testCases := []struct {
+		desc         string
+		enabled      bool
+		price        sdk.Dec
+		recipient    string
+		expErr       error
+		expBurned    int64
+		expRecipient int64
+		expPriority  int64
+	}{
+		{"disabled fee market", false, sdk.NewDecWithPrec(1, 3), "", nil, 0, 0, 0},
+		{"base fee higher than the fee", true, sdk.NewDecWithPrec(1, 3), "", sdkerrors.ErrInsufficientFee, 0, 0, 0},
+		{"base fee burned", true, sdk.NewDecWithPrec(5, 4), "", nil, 100, 0, 250},
+		{"base fee sent to the recipient", true, sdk.NewDecWithPrec(5, 4), distrtypes.ModuleName, nil, 0, 100, 250},
+		{"unknown recipient", true, sdk.NewDecWithPrec(5, 4), "foo", feemarket.ErrUnknownRecipient, 0, 0, 250},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.desc, func() {
+			cacheCtx, _ := ctx.CacheContext()
+			ctx := cacheCtx
+			setParams(ctx, tc.enabled, tc.price, tc.recipient)
+
+			_, checkRes, err := txHandler.CheckTx(sdk.WrapSDKContext(ctx.WithIsCheckTx(true)), tx.Request{Tx: testTx}, tx.RequestCheckTx{})
+			if tc.expErr == sdkerrors.ErrInsufficientFee {
+				s.Require().ErrorIs(err, tc.expErr)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expPriority, checkRes.Priority)
+			}
+
+			supply := s.app.BankKeeper.GetSupply(ctx, "atom")
+			recipientBalance := s.app.BankKeeper.GetBalance(ctx, s.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName), "atom")
+			collected := s.app.BankKeeper.GetBalance(ctx, feeCollector, "atom")
+
+			_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx})
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			s.Require().Equal(supply.SubAmount(sdk.NewInt(tc.expBurned)), s.app.BankKeeper.GetSupply(ctx, "atom"))
+			s.Require().Equal(recipientBalance.AddAmount(sdk.NewInt(tc.expRecipient)),
+				s.app.BankKeeper.GetBalance(ctx, s.app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName), "atom"))
+			s.Require().Equal(collected.AddAmount(sdk.NewInt(150-tc.expBurned-tc.expRecipient)), s.app.BankKeeper.GetBalance(ctx, feeCollector, "atom"))
+		})
+	}
+
+	// the genesis transactions don't pay the base fee
+	setParams(ctx, true, sdk.NewDecWithPrec(1, 3), "")
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx.WithBlockHeight(0)), tx.Request{Tx: testTx})
+	s.Require().NoError(err)
+}
@@ -48,6 +48,24 @@ type TxHandlerOptions struct {
 	FeegrantKeeper  FeegrantKeeper
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+
+	// FeeMarketKeeper is optional, the transactions must pay the base gas price
+	// of the fee market when set.
+	FeeMarketKeeper FeeMarketKeeper
+
+	// CircuitKeeper is optional, the transactions containing messages disabled by
+	// the circuit breaker are rejected when set. It should also be set on the
+	// MsgServiceRouter, which checks the messages executed outside of a
+	// transaction.
+	CircuitKeeper CircuitKeeper
+
+	// UnorderedTxKeeper is optional, the unordered txs are rejected when it is
+	// not set.
+	UnorderedTxKeeper UnorderedTxKeeper
+	// MaxUnorderedTxTimeoutDelta is the maximum number of blocks between the
+	// current block height and the timeout height of an unordered tx. Defaults
+	// to DefaultMaxUnorderedTxTimeoutDelta.
+	MaxUnorderedTxTimeoutDelta uint64
 }
 
 // NewDefaultTxHandler defines a TxHandler middleware stacks that should work
@@ -74,6 +92,21 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		sigGasConsumer = DefaultSigVerificationGasConsumer
 	}
 
+	var feeMarketMiddleware tx.Middleware = func(txh tx.Handler) tx.Handler { return txh }
+	if options.FeeMarketKeeper != nil {
+		feeMarketMiddleware = FeeMarketMiddleware(options.FeeMarketKeeper)
+	}
+
+	var circuitBreakerMiddleware tx.Middleware = func(txh tx.Handler) tx.Handler { return txh }
+	if options.CircuitKeeper != nil {
+		circuitBreakerMiddleware = CircuitBreakerMiddleware(options.CircuitKeeper)
+	}
+
+	var maxUnorderedTxTimeoutDelta = options.MaxUnorderedTxTimeoutDelta
+	if maxUnorderedTxTimeoutDelta == 0 {
+		maxUnorderedTxTimeoutDelta = DefaultMaxUnorderedTxTimeoutDelta
+	}
+
 	return ComposeMiddlewares(
 		NewRunMsgsTxHandler(options.MsgServiceRouter, options.LegacyRouter),
 		NewTxDecoderMiddleware(options.TxDecoder),
@@ -94,6 +127,9 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		RejectExtensionOptionsMiddleware,
 		MempoolFeeMiddleware,
 		ValidateBasicMiddleware,
+		// Reject the messages disabled by the circuit breaker, once their nested
+		// messages are known to be valid.
+		circuitBreakerMiddleware,
 		TxTimeoutHeightMiddleware,
 		ValidateMemoMiddleware(options.AccountKeeper),
 		ConsumeTxSizeGasMiddleware(options.AccountKeeper),
@@ -102,12 +138,17 @@ func NewDefaultTxHandler(options TxHandlerOptions) (tx.Handler, error) {
 		// `DeductFeeMiddleware` and `IncrementSequenceMiddleware` should be put outside of `WithBranchedStore` middleware,
 		// so their storage writes are not discarded when tx fails.
 		DeductFeeMiddleware(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		// Burn or route the base fee, and override the priority set by `TxPriorityMiddleware`
+		// when the fee market is enabled.
+		feeMarketMiddleware,
 		TxPriorityMiddleware,
 		SetPubKeyMiddleware(options.AccountKeeper),
 		ValidateSigCountMiddleware(options.AccountKeeper),
 		SigGasConsumeMiddleware(options.AccountKeeper, sigGasConsumer),
 		SigVerificationMiddleware(options.AccountKeeper, options.SignModeHandler),
 		IncrementSequenceMiddleware(options.AccountKeeper),
+		// Record the unordered txs, which skip the sequence checks above.
+		UnorderedTxMiddleware(options.UnorderedTxKeeper, maxUnorderedTxTimeoutDelta),
 		// Creates a new MultiStore branch, discards downstream writes if the downstream returns error.
 		// These kinds of middlewares should be put under this:
 		// - Could return error after messages executed succesfully.
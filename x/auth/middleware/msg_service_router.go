@@ -17,6 +17,7 @@ import (
 type MsgServiceRouter struct {
 	interfaceRegistry codectypes.InterfaceRegistry
 	routes            map[string]MsgServiceHandler
+	circuitKeeper     CircuitKeeper
 }
 
 var _ gogogrpc.Server = &MsgServiceRouter{}
@@ -43,6 +44,14 @@ func (msr *MsgServiceRouter) HandlerByTypeURL(typeURL string) MsgServiceHandler
 	return msr.routes[typeURL]
 }
 
+// SetCircuitKeeper sets the circuit breaker keeper checked by the handlers before
+// executing a message, so that the messages disabled by the circuit breaker are
+// rejected whatever executes them: a transaction, an authz MsgExec, or a group or
+// governance proposal.
+func (msr *MsgServiceRouter) SetCircuitKeeper(ck CircuitKeeper) {
+	msr.circuitKeeper = ck
+}
+
 // RegisterService implements the gRPC Server.RegisterService method. sd is a gRPC
 // service description, handler is an object which implements that gRPC service.
 //
@@ -108,6 +117,10 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 		}
 
 		msr.routes[requestTypeName] = func(ctx sdk.Context, req sdk.Msg) (*sdk.Result, error) {
+			if err := msr.checkCircuitBreaker(ctx, requestTypeName); err != nil {
+				return nil, err
+			}
+
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			interceptor := func(goCtx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 				goCtx = context.WithValue(goCtx, sdk.SdkContextKey, ctx)
@@ -130,6 +143,20 @@ func (msr *MsgServiceRouter) RegisterService(sd *grpc.ServiceDesc, handler inter
 	}
 }
 
+// checkCircuitBreaker returns an error if the circuit breaker of the message type
+// is tripped. The circuit breaker is read without consuming gas.
+func (msr *MsgServiceRouter) checkCircuitBreaker(ctx sdk.Context, msgTypeURL string) error {
+	if msr.circuitKeeper == nil {
+		return nil
+	}
+
+	if !msr.circuitKeeper.IsAllowed(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), msgTypeURL) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is disabled by the circuit breaker", msgTypeURL)
+	}
+
+	return nil
+}
+
 func noopDecoder(_ interface{}) error { return nil }
 func noopInterceptor(_ context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
 	return nil, nil
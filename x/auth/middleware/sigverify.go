@@ -454,6 +454,10 @@ func (svd sigVerificationTxHandler) sigVerify(ctx context.Context, req tx.Reques
 		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
 	}
 
+	// the sequences of the signers of an unordered tx are not checked, the
+	// UnorderedTxMiddleware protects it against replays instead
+	unordered := IsUnorderedTx(req.Tx)
+
 	for i, sig := range sigs {
 		acc, err := GetSignerAcc(sdkCtx, svd.ak, signerAddrs[i])
 		if err != nil {
@@ -467,10 +471,13 @@ func (svd sigVerificationTxHandler) sigVerify(ctx context.Context, req tx.Reques
 		}
 
 		// Check account sequence number.
-		if sig.Sequence != acc.GetSequence() {
+		sequence := acc.GetSequence()
+		if unordered {
+			sequence = sig.Sequence
+		} else if sig.Sequence != sequence {
 			return sdkerrors.Wrapf(
 				sdkerrors.ErrWrongSequence,
-				"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+				"account sequence mismatch, expected %d, got %d", sequence, sig.Sequence,
 			)
 		}
 
@@ -486,18 +493,18 @@ func (svd sigVerificationTxHandler) sigVerify(ctx context.Context, req tx.Reques
 			Address:       signerAddrs[i].String(),
 			ChainID:       chainID,
 			AccountNumber: accNum,
-			Sequence:      acc.GetSequence(),
+			Sequence:      sequence,
 			PubKey:        pubKey,
 		}
 
 		if !simulate {
-			err := authsigning.VerifySignature(pubKey, signerData, sig.Data, svd.signModeHandler, req.Tx)
+			err := authsigning.VerifySignature(ctx, pubKey, signerData, sig.Data, svd.signModeHandler, req.Tx)
 			if err != nil {
 				var errMsg string
 				if OnlyLegacyAminoSigners(sig.Data) {
 					// If all signers are using SIGN_MODE_LEGACY_AMINO, we rely on VerifySignature to check account sequence number,
 					// and therefore communicate sequence number as a potential cause of error.
-					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), chainID)
+					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, sequence, chainID)
 				} else {
 					errMsg = fmt.Sprintf("signature verification failed; please verify account number (%d) and chain-id (%s)", accNum, chainID)
 				}
@@ -552,7 +559,10 @@ type incrementSequenceTxHandler struct {
 // NOTE: Since CheckTx and DeliverTx state are managed separately, subsequent and
 // sequential txs orginating from the same account cannot be handled correctly in
 // a reliable way unless sequence numbers are managed and tracked manually by a
-// client. It is recommended to instead use multiple messages in a tx.
+// client. It is recommended to instead use multiple messages in a tx, or
+// unordered txs.
+//
+// NOTE: The sequences of the signers of an unordered tx are not incremented.
 func IncrementSequenceMiddleware(ak AccountKeeper) tx.Middleware {
 	return func(h tx.Handler) tx.Handler {
 		return incrementSequenceTxHandler{
@@ -569,6 +579,10 @@ func (isd incrementSequenceTxHandler) incrementSeq(ctx context.Context, req tx.R
 		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
 	}
 
+	if IsUnorderedTx(req.Tx) {
+		return nil
+	}
+
 	// increment sequence of all signers
 	for _, addr := range sigTx.GetSigners() {
 		acc := isd.ak.GetAccount(sdkCtx, addr)
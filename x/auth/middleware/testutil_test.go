@@ -81,15 +81,16 @@ func (s *MWTestSuite) SetupTest(isCheckTx bool) sdk.Context {
 		}, nil
 	}))
 	txHandler, err := middleware.NewDefaultTxHandler(middleware.TxHandlerOptions{
-		Debug:            s.app.Trace(),
-		MsgServiceRouter: msr,
-		LegacyRouter:     legacyRouter,
-		AccountKeeper:    s.app.AccountKeeper,
-		BankKeeper:       s.app.BankKeeper,
-		FeegrantKeeper:   s.app.FeeGrantKeeper,
-		SignModeHandler:  encodingConfig.TxConfig.SignModeHandler(),
-		SigGasConsumer:   middleware.DefaultSigVerificationGasConsumer,
-		TxDecoder:        s.clientCtx.TxConfig.TxDecoder(),
+		Debug:             s.app.Trace(),
+		MsgServiceRouter:  msr,
+		LegacyRouter:      legacyRouter,
+		AccountKeeper:     s.app.AccountKeeper,
+		BankKeeper:        s.app.BankKeeper,
+		FeegrantKeeper:    s.app.FeeGrantKeeper,
+		SignModeHandler:   encodingConfig.TxConfig.SignModeHandler(),
+		SigGasConsumer:    middleware.DefaultSigVerificationGasConsumer,
+		TxDecoder:         s.clientCtx.TxConfig.TxDecoder(),
+		UnorderedTxKeeper: s.app.AccountKeeper,
 	})
 	s.Require().NoError(err)
 	s.txHandler = txHandler
@@ -33,7 +33,7 @@ func (s *MWTestSuite) setupAcctsForTips(ctx sdk.Context) (sdk.Context, []testAcc
 	s.Require().NoError(err)
 
 	// Create dummy proposal for tipper to vote on.
-	prop, err := govtypes.NewProposal([]sdk.Msg{banktypes.NewMsgSend(accts[0].acc.GetAddress(), accts[0].acc.GetAddress(), initialRegens)}, 1, nil, time.Now(), time.Now().Add(time.Hour))
+	prop, err := govtypes.NewProposal([]sdk.Msg{banktypes.NewMsgSend(accts[0].acc.GetAddress(), accts[0].acc.GetAddress(), initialRegens)}, 1, nil, time.Now(), time.Now().Add(time.Hour), nil, false)
 	s.Require().NoError(err)
 	s.app.GovKeeper.SetProposal(ctx, prop)
 	s.app.GovKeeper.ActivateVotingPeriod(ctx, prop)
@@ -0,0 +1,166 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// DefaultMaxUnorderedTxTimeoutDelta defines the default maximum number of blocks
+// between the current block height and the timeout height of an unordered tx.
+const DefaultMaxUnorderedTxTimeoutDelta = 1024
+
+var _ tx.Handler = unorderedTxHandler{}
+
+type unorderedTxHandler struct {
+	utk             UnorderedTxKeeper
+	maxTimeoutDelta uint64
+	next            tx.Handler
+}
+
+// UnorderedTxMiddleware protects the unordered txs against replays, in place of
+// the sequence checks and increments skipped for them. An unordered tx must set
+// a timeout height at most maxTimeoutDelta blocks ahead of the current block
+// height, and the hash of its signed content is recorded until then so that it
+// cannot be included twice. All the signers of an unordered tx must sign in
+// SIGN_MODE_DIRECT, the only sign mode binding the signatures to the exact
+// bytes of the tx body and auth info. Note, the unordered txs are rejected when
+// utk is nil.
+//
+// CONTRACT: The recorded hashes are pruned in EndBlock once timed out.
+func UnorderedTxMiddleware(utk UnorderedTxKeeper, maxTimeoutDelta uint64) tx.Middleware {
+	return func(h tx.Handler) tx.Handler {
+		return unorderedTxHandler{
+			utk:             utk,
+			maxTimeoutDelta: maxTimeoutDelta,
+			next:            h,
+		}
+	}
+}
+
+// IsUnorderedTx returns true if the tx is unordered.
+func IsUnorderedTx(sdkTx sdk.Tx) bool {
+	unorderedTx, ok := sdkTx.(sdk.TxWithUnordered)
+	return ok && unorderedTx.GetUnordered()
+}
+
+func (txh unorderedTxHandler) checkUnorderedTx(ctx context.Context, req tx.Request) error {
+	if !IsUnorderedTx(req.Tx) {
+		return nil
+	}
+
+	if txh.utk == nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered txs are not supported")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	timeoutHeight := req.Tx.(sdk.TxWithUnordered).GetTimeoutHeight()
+	if timeoutHeight == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "unordered tx must set a timeout height")
+	}
+
+	maxTimeoutHeight := uint64(sdkCtx.BlockHeight()) + txh.maxTimeoutDelta
+	if timeoutHeight > maxTimeoutHeight {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidRequest, "unordered tx timeout height %d exceeds the maximum of %d", timeoutHeight, maxTimeoutHeight,
+		)
+	}
+
+	sigTx, ok := req.Tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return err
+	}
+
+	for _, sig := range sigs {
+		if !OnlyDirectSigners(sig.Data) {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx must be signed with %s", signing.SignMode_SIGN_MODE_DIRECT)
+		}
+	}
+
+	txHash, err := unorderedTxHash(req.TxBytes)
+	if err != nil {
+		return err
+	}
+
+	if txh.utk.ContainsUnorderedTx(sdkCtx, txHash) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "unordered tx %X is duplicated", txHash)
+	}
+
+	txh.utk.AddUnorderedTx(sdkCtx, txHash, timeoutHeight)
+
+	return nil
+}
+
+// OnlyDirectSigners checks SignatureData to see if all signers are using
+// SIGN_MODE_DIRECT.
+func OnlyDirectSigners(sigData signing.SignatureData) bool {
+	switch v := sigData.(type) {
+	case *signing.SingleSignatureData:
+		return v.SignMode == signing.SignMode_SIGN_MODE_DIRECT
+	case *signing.MultiSignatureData:
+		for _, s := range v.Signatures {
+			if !OnlyDirectSigners(s) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+// unorderedTxHash returns the hash identifying an unordered tx: the hash of its
+// body and auth info bytes, signed in SIGN_MODE_DIRECT, leaving out the
+// signatures so that the tx cannot be replayed with other signatures.
+func unorderedTxHash(txBytes []byte) ([]byte, error) {
+	var raw tx.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrTxDecode, err.Error())
+	}
+
+	raw.Signatures = nil
+	signedBytes, err := raw.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	txHash := sha256.Sum256(signedBytes)
+	return txHash[:], nil
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh unorderedTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	if err := txh.checkUnorderedTx(ctx, req); err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh unorderedTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkUnorderedTx(ctx, req); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx.
+func (txh unorderedTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkUnorderedTx(ctx, req); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, req)
+}
@@ -0,0 +1,188 @@
+package middleware_test
+
+import (
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	xauthsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+func (s *MWTestSuite) TestUnorderedTx() {
+	ctx := s.SetupTest(false) // setup
+	maxTimeoutDelta := uint64(10)
+	height := uint64(ctx.BlockHeight())
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	testCases := []struct {
+		desc          string
+		keeper        middleware.UnorderedTxKeeper
+		unordered     bool
+		timeoutHeight uint64
+		replay        bool
+		expErr        bool
+	}{
+		{"ordered tx", s.app.AccountKeeper, false, 0, false, false},
+		{"replayed ordered tx", s.app.AccountKeeper, false, 0, true, false},
+		{"unordered tx", s.app.AccountKeeper, true, height + 1, false, false},
+		{"unordered tx with max timeout height", s.app.AccountKeeper, true, height + maxTimeoutDelta, false, false},
+		{"replayed unordered tx", s.app.AccountKeeper, true, height + 1, true, true},
+		{"unordered tx without timeout height", s.app.AccountKeeper, true, 0, false, true},
+		{"unordered tx with too high timeout height", s.app.AccountKeeper, true, height + maxTimeoutDelta + 1, false, true},
+		{"unordered tx without keeper", nil, true, height + 1, false, true},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.desc, func() {
+			ctx, _ := ctx.CacheContext()
+			txHandler := middleware.ComposeMiddlewares(
+				noopTxHandler,
+				middleware.UnorderedTxMiddleware(tc.keeper, maxTimeoutDelta),
+			)
+
+			txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			txBuilder.SetTimeoutHeight(tc.timeoutHeight)
+			txBuilder.SetUnordered(tc.unordered)
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			testTx, txBytes, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+			s.Require().NoError(err)
+
+			req := tx.Request{Tx: testTx, TxBytes: txBytes}
+			if tc.replay {
+				_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), req)
+				s.Require().NoError(err)
+			}
+
+			_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), req)
+			if tc.expErr {
+				s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *MWTestSuite) TestUnorderedTxSequence() {
+	ctx := s.SetupTest(false) // setup
+	accounts := s.createTestAccounts(ctx, 1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1000000)))
+	addr := accounts[0].acc.GetAddress()
+
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetTimeoutHeight(uint64(ctx.BlockHeight()) + 1)
+	txBuilder.SetUnordered(true)
+
+	// The sequence of an unordered tx is neither checked nor incremented.
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{accounts[0].priv}, []uint64{accounts[0].accNum}, []uint64{5}
+	testTx, txBytes, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	_, err = s.txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx, TxBytes: txBytes})
+	s.Require().NoError(err)
+	seq, err := s.app.AccountKeeper.GetSequence(ctx, addr)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), seq)
+
+	// The unordered tx cannot be replayed until it times out.
+	_, err = s.txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx, TxBytes: txBytes})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *MWTestSuite) TestUnorderedTxReplayWithOtherSignatures() {
+	ctx := s.SetupTest(false) // setup
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.UnorderedTxMiddleware(s.app.AccountKeeper, 10),
+	)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetTimeoutHeight(uint64(ctx.BlockHeight()) + 1)
+	txBuilder.SetUnordered(true)
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	testTx, txBytes, err := s.createTestTx(txBuilder, privs, accNums, accSeqs, ctx.ChainID())
+	s.Require().NoError(err)
+
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx, TxBytes: txBytes})
+	s.Require().NoError(err)
+
+	// The same signed content with other signatures is a replay.
+	var raw tx.TxRaw
+	s.Require().NoError(raw.Unmarshal(txBytes))
+	raw.Signatures = [][]byte{[]byte("other signature")}
+	otherTxBytes, err := raw.Marshal()
+	s.Require().NoError(err)
+	otherTx, err := s.clientCtx.TxConfig.TxDecoder()(otherTxBytes)
+	s.Require().NoError(err)
+
+	_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: otherTx, TxBytes: otherTxBytes})
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *MWTestSuite) TestUnorderedTxLegacyAminoJSON() {
+	ctx := s.SetupTest(false) // setup
+	txHandler := middleware.ComposeMiddlewares(
+		noopTxHandler,
+		middleware.UnorderedTxMiddleware(s.app.AccountKeeper, 10),
+	)
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	txBuilder := s.clientCtx.TxConfig.NewTxBuilder()
+	s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+	txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	txBuilder.SetTimeoutHeight(uint64(ctx.BlockHeight()) + 1)
+
+	// The amino JSON sign doc covers neither the unordered flag nor the exact
+	// bytes of the tx body.
+	signMode := signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON
+	s.Require().NoError(txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: priv1.PubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signMode},
+	}))
+	signerData := xauthsigning.SignerData{
+		Address: addr1.String(),
+		ChainID: ctx.ChainID(),
+		PubKey:  priv1.PubKey(),
+	}
+	sig, err := clienttx.SignWithPrivKey(signMode, signerData, txBuilder, priv1, s.clientCtx.TxConfig, 0)
+	s.Require().NoError(err)
+	s.Require().NoError(txBuilder.SetSignatures(sig))
+	txBuilder.SetUnordered(true)
+
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	s.Require().NoError(err)
+
+	// Re-encode the tx body with the empty memo explicitly set, which decodes
+	// to the same tx under other bytes.
+	var raw tx.TxRaw
+	s.Require().NoError(raw.Unmarshal(txBytes))
+	raw.BodyBytes = append(raw.BodyBytes, 0x12, 0x00)
+	reencodedTxBytes, err := raw.Marshal()
+	s.Require().NoError(err)
+	s.Require().NotEqual(txBytes, reencodedTxBytes)
+
+	for _, bz := range [][]byte{txBytes, reencodedTxBytes} {
+		testTx, err := s.clientCtx.TxConfig.TxDecoder()(bz)
+		s.Require().NoError(err)
+		s.Require().True(middleware.IsUnorderedTx(testTx))
+
+		_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: testTx, TxBytes: bz})
+		s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+		s.Require().Contains(err.Error(), signing.SignMode_SIGN_MODE_DIRECT.String())
+	}
+}
@@ -71,6 +71,10 @@ func (s *StdTxBuilder) SetTimeoutHeight(height uint64) {
 	s.TimeoutHeight = height
 }
 
+func (s *StdTxBuilder) SetUnordered(_ bool) {
+	panic("StdTxBuilder does not support unordered transactions")
+}
+
 // SetFeeGranter does nothing for stdtx
 func (s *StdTxBuilder) SetFeeGranter(_ sdk.AccAddress) {}
 
@@ -0,0 +1,36 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046auth "github.com/cosmos/cosmos-sdk/x/auth/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authKey := sdk.NewKVStoreKey("auth")
+	tAuthKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(authKey, tAuthKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, authKey, tAuthKey, "auth").WithKeyTable(types.ParamKeyTable())
+
+	// Set the params in the legacy subspace.
+	params := types.DefaultParams()
+	paramstore.SetParamSet(ctx, &params)
+
+	// Run migrations.
+	err := v046auth.MigrateStore(ctx, authKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params were moved into the module store.
+	var res types.Params
+	bz := ctx.KVStore(authKey).Get(v046auth.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
@@ -30,6 +30,7 @@ func TestDecodeStore(t *testing.T) {
 	require.NoError(t, err)
 
 	globalAccNumber := gogotypes.UInt64Value{Value: 10}
+	txHash := []byte{0x01, 0x02}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
@@ -41,6 +42,14 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.GlobalAccountNumberKey,
 				Value: cdc.MustMarshal(&globalAccNumber),
 			},
+			{
+				Key:   types.UnorderedTxHashKey(txHash),
+				Value: sdk.Uint64ToBigEndian(20),
+			},
+			{
+				Key:   types.UnorderedTxTimeoutKey(20, txHash),
+				Value: []byte{},
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -53,6 +62,8 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Account", fmt.Sprintf("%v\n%v", acc, acc)},
 		{"GlobalAccNumber", fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumber, globalAccNumber)},
+		{"UnorderedTxHash", "TimeoutHeightA: 20\nTimeoutHeightB: 20"},
+		{"UnorderedTxTimeout", "00000000000000140102\n00000000000000140102"},
 		{"other", ""},
 	}
 
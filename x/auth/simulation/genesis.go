@@ -22,7 +22,8 @@ const (
 )
 
 // RandomGenesisAccounts defines the default RandomGenesisAccountsFn used on the SDK.
-// It creates a slice of BaseAccount, ContinuousVestingAccount and DelayedVestingAccount.
+// It creates a slice of BaseAccount, ContinuousVestingAccount, DelayedVestingAccount
+// and ClawbackVestingAccount.
 func RandomGenesisAccounts(simState *module.SimulationState) types.GenesisAccounts {
 	genesisAccs := make(types.GenesisAccounts, len(simState.Accounts))
 	for i, acc := range simState.Accounts {
@@ -49,16 +50,47 @@ func RandomGenesisAccounts(simState *module.SimulationState) types.GenesisAccoun
 
 		bva := vestingtypes.NewBaseVestingAccount(bacc, initialVesting, endTime)
 
-		if simState.Rand.Intn(100) < 50 {
+		switch n := simState.Rand.Intn(100); {
+		case n < 40:
 			genesisAccs[i] = vestingtypes.NewContinuousVestingAccountRaw(bva, startTime)
-		} else {
+		case n < 80:
 			genesisAccs[i] = vestingtypes.NewDelayedVestingAccountRaw(bva)
+		default:
+			funder, _ := simulation.RandomAcc(simState.Rand, simState.Accounts)
+			genesisAccs[i] = randomClawbackVestingAccount(simState.Rand, bacc, funder.Address, initialVesting, startTime, endTime)
 		}
 	}
 
 	return genesisAccs
 }
 
+// randomClawbackVestingAccount returns a ClawbackVestingAccount which vests
+// in two installments, and which may also be locked up until the end time.
+func randomClawbackVestingAccount(r *rand.Rand, bacc *types.BaseAccount, funder sdk.AccAddress, initialVesting sdk.Coins, startTime, endTime int64) *vestingtypes.ClawbackVestingAccount {
+	duration := endTime - startTime
+	vestingPeriods := []vestingtypes.Period{{Length: duration, Amount: initialVesting}}
+
+	if cliff := r.Int63n(duration); cliff > 0 {
+		firstAmount := make(sdk.Coins, 0, len(initialVesting))
+		for _, coin := range initialVesting {
+			firstAmount = append(firstAmount, sdk.NewCoin(coin.Denom, simulation.RandomAmount(r, coin.Amount)))
+		}
+		firstAmount = sdk.NewCoins(firstAmount...)
+
+		vestingPeriods = []vestingtypes.Period{
+			{Length: cliff, Amount: firstAmount},
+			{Length: duration - cliff, Amount: initialVesting.Sub(firstAmount)},
+		}
+	}
+
+	var lockupPeriods []vestingtypes.Period
+	if r.Intn(2) == 0 {
+		lockupPeriods = []vestingtypes.Period{{Length: duration, Amount: initialVesting}}
+	}
+
+	return vestingtypes.NewClawbackVestingAccount(bacc, funder, initialVesting, startTime, lockupPeriods, vestingPeriods)
+}
+
 // GenMaxMemoChars randomized MaxMemoChars
 func GenMaxMemoChars(r *rand.Rand) uint64 {
 	return uint64(simulation.RandIntBetween(r, 100, 200))
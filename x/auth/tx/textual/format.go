@@ -0,0 +1,127 @@
+package textual
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// thousandsSeparator separates the groups of three digits of the rendered
+// numbers, it can't be mistaken for the decimal point whatever the locale of
+// the signer.
+const thousandsSeparator = "'"
+
+// formatInteger formats an integer, given as a string of decimal digits with an
+// optional sign, with thousands separators, e.g. "1234567" renders as
+// "1'234'567".
+func formatInteger(v string) (string, error) {
+	i, ok := new(big.Int).SetString(v, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid integer %q", v)
+	}
+
+	return formatDecimal(i, 0), nil
+}
+
+// formatDec formats a sdk.Dec, given as its binary representation, i.e. as an
+// integer scaled by 10^18, with thousands separators and without trailing
+// zeros, e.g. "1234500000000000000000" renders as "1'234.5".
+func formatDec(v string) (string, error) {
+	i, ok := new(big.Int).SetString(v, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid decimal %q", v)
+	}
+
+	return formatDecimal(i, sdk.Precision), nil
+}
+
+// formatDecimal formats the decimal number i * 10^-prec.
+func formatDecimal(i *big.Int, prec int) string {
+	digits := new(big.Int).Abs(i).String()
+	if len(digits) <= prec {
+		digits = strings.Repeat("0", prec-len(digits)+1) + digits
+	}
+
+	intPart, fracPart := digits[:len(digits)-prec], strings.TrimRight(digits[len(digits)-prec:], "0")
+
+	var sb strings.Builder
+	if i.Sign() < 0 {
+		sb.WriteString("-")
+	}
+
+	for j, c := range intPart {
+		if j > 0 && (len(intPart)-j)%3 == 0 {
+			sb.WriteString(thousandsSeparator)
+		}
+		sb.WriteRune(c)
+	}
+
+	if fracPart != "" {
+		sb.WriteString(".")
+		sb.WriteString(fracPart)
+	}
+
+	return sb.String()
+}
+
+// formatCoins formats the coins, whose amounts are given as integers scaled by
+// 10^prec, in their display denom when the denom metadata defines one, e.g.
+// "1000000uatom" renders as "1 ATOM".
+func (t Textual) formatCoins(ctx context.Context, denoms []string, amounts []*big.Int, prec int) (string, error) {
+	formatted := make([]string, len(denoms))
+	for i, denom := range denoms {
+		var metadata *banktypes.Metadata
+		if t.coinMetadataQuerier != nil {
+			var err error
+			metadata, err = t.coinMetadataQuerier(ctx, denom)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		displayDenom, exponent := displayUnit(denom, metadata)
+		formatted[i] = formatDecimal(amounts[i], prec+int(exponent)) + " " + displayDenom
+	}
+
+	return strings.Join(formatted, ", "), nil
+}
+
+// displayUnit returns the display denom of the base denom and the exponent
+// between them, or the base denom itself when the metadata defines no display
+// denom.
+func displayUnit(denom string, metadata *banktypes.Metadata) (string, uint32) {
+	if metadata == nil || metadata.Display == "" || metadata.Display == denom {
+		return denom, 0
+	}
+
+	var baseExponent, displayExponent uint32
+	var foundBase, foundDisplay bool
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == denom || hasAlias(unit, denom) {
+			baseExponent, foundBase = unit.Exponent, true
+		}
+		if unit.Denom == metadata.Display || hasAlias(unit, metadata.Display) {
+			displayExponent, foundDisplay = unit.Exponent, true
+		}
+	}
+
+	if !foundBase || !foundDisplay || displayExponent < baseExponent {
+		return denom, 0
+	}
+
+	return metadata.Display, displayExponent - baseExponent
+}
+
+func hasAlias(unit *banktypes.DenomUnit, denom string) bool {
+	for _, alias := range unit.Aliases {
+		if alias == denom {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,103 @@
+package textual
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestFormatInteger(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out string
+	}{
+		{"0", "0"},
+		{"1", "1"},
+		{"12", "12"},
+		{"123", "123"},
+		{"1234", "1'234"},
+		{"1234567", "1'234'567"},
+		{"-1234567", "-1'234'567"},
+		{"123456789012345678901234567890", "123'456'789'012'345'678'901'234'567'890"},
+	}
+
+	for _, tc := range testCases {
+		out, err := formatInteger(tc.in)
+		require.NoError(t, err)
+		require.Equal(t, tc.out, out, tc.in)
+	}
+
+	_, err := formatInteger("1.5")
+	require.Error(t, err)
+}
+
+func TestFormatDec(t *testing.T) {
+	testCases := []struct {
+		in  string
+		out string
+	}{
+		{"0", "0"},
+		{"1", "0.000000000000000001"},
+		{"1000000000000000000", "1"},
+		{"1234500000000000000000", "1'234.5"},
+		{"-500000000000000000", "-0.5"},
+	}
+
+	for _, tc := range testCases {
+		out, err := formatDec(tc.in)
+		require.NoError(t, err)
+		require.Equal(t, tc.out, out, tc.in)
+	}
+}
+
+func TestFormatCoins(t *testing.T) {
+	metadata := &banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "matom", Exponent: 3, Aliases: []string{"milliatom"}},
+			{Denom: "atom", Exponent: 6},
+		},
+	}
+	querier := func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		if denom == "uatom" || denom == "milliatom" {
+			return metadata, nil
+		}
+		return nil, nil
+	}
+
+	testCases := []struct {
+		name    string
+		querier CoinMetadataQueryFn
+		denoms  []string
+		amounts []int64
+		prec    int
+		out     string
+	}{
+		{"no querier", nil, []string{"uatom"}, []int64{1234567}, 0, "1'234'567 uatom"},
+		{"display denom", querier, []string{"uatom"}, []int64{1234567}, 0, "1.234567 atom"},
+		{"alias", querier, []string{"milliatom"}, []int64{1500}, 0, "1.5 atom"},
+		{"no metadata", querier, []string{"stake"}, []int64{10}, 0, "10 stake"},
+		{"multiple coins", querier, []string{"stake", "uatom"}, []int64{10, 1000000}, 0, "10 stake, 1 atom"},
+		{"dec coin", querier, []string{"uatom"}, []int64{1500000}, 3, "0.0015 atom"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amounts := make([]*big.Int, len(tc.amounts))
+			for i, amount := range tc.amounts {
+				amounts[i] = big.NewInt(amount)
+			}
+
+			out, err := NewTextual(tc.querier).formatCoins(context.Background(), tc.denoms, amounts, tc.prec)
+			require.NoError(t, err)
+			require.Equal(t, tc.out, out)
+		})
+	}
+}
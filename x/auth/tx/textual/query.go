@@ -0,0 +1,55 @@
+package textual
+
+import (
+	"context"
+	"errors"
+
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BankKeeper defines the bank keeper queried for the denom metadata.
+type BankKeeper interface {
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// NewBankKeeperCoinMetadataQueryFn returns a CoinMetadataQueryFn which reads the
+// denom metadata from the bank keeper, to be used by the application. The
+// context must wrap a sdk.Context.
+func NewBankKeeperCoinMetadataQueryFn(bk BankKeeper) CoinMetadataQueryFn {
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		if ctx.Value(sdk.SdkContextKey) == nil {
+			return nil, errors.New("SIGN_MODE_TEXTUAL requires a sdk.Context to query the denom metadata")
+		}
+
+		metadata, found := bk.GetDenomMetaData(sdk.UnwrapSDKContext(ctx), denom)
+		if !found {
+			return nil, nil
+		}
+
+		return &metadata, nil
+	}
+}
+
+// NewGRPCCoinMetadataQueryFn returns a CoinMetadataQueryFn which queries the
+// denom metadata through gRPC, to be used by the clients.
+func NewGRPCCoinMetadataQueryFn(conn gogogrpc.ClientConn) CoinMetadataQueryFn {
+	queryClient := banktypes.NewQueryClient(conn)
+
+	return func(ctx context.Context, denom string) (*banktypes.Metadata, error) {
+		res, err := queryClient.DenomMetadata(ctx, &banktypes.QueryDenomMetadataRequest{Denom: denom})
+		if status.Code(err) == codes.NotFound || errors.Is(err, sdkerrors.ErrKeyNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return &res.Metadata, nil
+	}
+}
@@ -0,0 +1,149 @@
+package textual
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	gogoproto "github.com/gogo/protobuf/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// descriptorIface is implemented by the gogoproto generated messages.
+type descriptorIface interface {
+	Descriptor() ([]byte, []int)
+}
+
+// descriptorResolver builds the protoreflect descriptors of the messages
+// registered with gogoproto, which are not available through
+// protoregistry.GlobalFiles, loading their files and dependencies lazily.
+type descriptorResolver struct {
+	mu    sync.Mutex
+	files *protoregistry.Files
+}
+
+func newDescriptorResolver() *descriptorResolver {
+	return &descriptorResolver{files: new(protoregistry.Files)}
+}
+
+// FindMessageByName returns the descriptor of the message with the given full
+// name.
+func (r *descriptorResolver) FindMessageByName(name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if md, err := r.findMessage(name); err == nil {
+		return md, nil
+	}
+
+	typ := gogoproto.MessageType(string(name))
+	if typ == nil {
+		// fall back to the messages registered with the new protobuf API
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+		if err != nil {
+			return nil, fmt.Errorf("unknown message %s", name)
+		}
+		md, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a message", name)
+		}
+		return md, nil
+	}
+
+	msg, ok := reflect.New(typ.Elem()).Interface().(descriptorIface)
+	if !ok {
+		return nil, fmt.Errorf("message %s has no descriptor", name)
+	}
+
+	gzippedFd, _ := msg.Descriptor()
+	fdp, err := unzipFileDescriptor(gzippedFd)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.registerFile(fdp); err != nil {
+		return nil, err
+	}
+
+	return r.findMessage(name)
+}
+
+func (r *descriptorResolver) findMessage(name protoreflect.FullName) (protoreflect.MessageDescriptor, error) {
+	desc, err := r.files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", name)
+	}
+
+	return md, nil
+}
+
+// registerFile registers the file and its dependencies, the dependencies which
+// cannot be found (e.g. gogoproto's, which only define options) are left
+// unresolved.
+func (r *descriptorResolver) registerFile(fdp *descriptorpb.FileDescriptorProto) error {
+	if _, err := r.files.FindFileByPath(fdp.GetName()); err == nil {
+		return nil
+	}
+
+	for _, dep := range fdp.GetDependency() {
+		if _, err := r.files.FindFileByPath(dep); err == nil {
+			continue
+		}
+
+		if gzippedFd := gogoproto.FileDescriptor(dep); gzippedFd != nil {
+			depFdp, err := unzipFileDescriptor(gzippedFd)
+			if err != nil {
+				return err
+			}
+			if err := r.registerFile(depFdp); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if fd, err := protoregistry.GlobalFiles.FindFileByPath(dep); err == nil {
+			if err := r.files.RegisterFile(fd); err != nil {
+				return err
+			}
+		}
+	}
+
+	fd, err := protodesc.FileOptions{AllowUnresolvable: true}.New(fdp, r.files)
+	if err != nil {
+		return err
+	}
+
+	return r.files.RegisterFile(fd)
+}
+
+func unzipFileDescriptor(gzippedFd []byte) (*descriptorpb.FileDescriptorProto, error) {
+	gzr, err := gzip.NewReader(bytes.NewReader(gzippedFd))
+	if err != nil {
+		return nil, err
+	}
+	defer gzr.Close()
+
+	bz, err := io.ReadAll(gzr)
+	if err != nil {
+		return nil, err
+	}
+
+	fdp := new(descriptorpb.FileDescriptorProto)
+	if err := proto.Unmarshal(bz, fdp); err != nil {
+		return nil, err
+	}
+
+	return fdp, nil
+}
@@ -0,0 +1,288 @@
+package textual
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+)
+
+const (
+	coinName      protoreflect.FullName = "cosmos.base.v1beta1.Coin"
+	decCoinName   protoreflect.FullName = "cosmos.base.v1beta1.DecCoin"
+	anyName       protoreflect.FullName = "google.protobuf.Any"
+	timestampName protoreflect.FullName = "google.protobuf.Timestamp"
+	durationName  protoreflect.FullName = "google.protobuf.Duration"
+
+	scalarInt = "cosmos.Int"
+	scalarDec = "cosmos.Dec"
+)
+
+func newScreen(text string, indent int, expert bool) *signing.Screen {
+	return &signing.Screen{Text: text, Indent: uint32(indent), Expert: expert}
+}
+
+// renderAny renders the message packed in an Any, as its type URL followed by
+// its fields on the next indentation level.
+func (t Textual) renderAny(ctx context.Context, title, typeURL string, value []byte, indent int, expert bool) ([]*signing.Screen, error) {
+	name := typeURL
+	if i := strings.LastIndexByte(typeURL, '/'); i >= 0 {
+		name = typeURL[i+1:]
+	}
+
+	md, err := t.resolver.FindMessageByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+
+	msg := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(value, msg); err != nil {
+		return nil, err
+	}
+
+	fieldScreens, err := t.renderFields(ctx, msg, indent+1, expert)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]*signing.Screen{newScreen(fmt.Sprintf("%s: %s", title, typeURL), indent, expert)}, fieldScreens...), nil
+}
+
+// renderFields renders the fields set in the message, in the order of their
+// numbers.
+func (t Textual) renderFields(ctx context.Context, msg protoreflect.Message, indent int, expert bool) ([]*signing.Screen, error) {
+	fields := msg.Descriptor().Fields()
+	sorted := make([]protoreflect.FieldDescriptor, fields.Len())
+	for i := range sorted {
+		sorted[i] = fields.Get(i)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Number() < sorted[j].Number() })
+
+	var screens []*signing.Screen
+	for _, fd := range sorted {
+		if !msg.Has(fd) {
+			continue
+		}
+
+		fieldScreens, err := t.renderField(ctx, fd, msg.Get(fd), indent, expert)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, fieldScreens...)
+	}
+
+	return screens, nil
+}
+
+func (t Textual) renderField(ctx context.Context, fd protoreflect.FieldDescriptor, v protoreflect.Value, indent int, expert bool) ([]*signing.Screen, error) {
+	title := fieldTitle(fd)
+
+	switch {
+	case fd.IsList():
+		list := v.List()
+
+		// a list of coins is rendered on a single screen
+		if fd.Message() != nil && (fd.Message().FullName() == coinName || fd.Message().FullName() == decCoinName) {
+			coins := make([]protoreflect.Message, list.Len())
+			for i := range coins {
+				coins[i] = list.Get(i).Message()
+			}
+			text, err := t.formatCoinMessages(ctx, coins)
+			if err != nil {
+				return nil, err
+			}
+			return []*signing.Screen{newScreen(fmt.Sprintf("%s: %s", title, text), indent, expert)}, nil
+		}
+
+		var screens []*signing.Screen
+		for i := 0; i < list.Len(); i++ {
+			elemScreens, err := t.renderValue(ctx, fmt.Sprintf("%s (%d/%d)", title, i+1, list.Len()), fd, list.Get(i), indent, expert)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, elemScreens...)
+		}
+		return screens, nil
+
+	case fd.IsMap():
+		m := v.Map()
+		keys := make([]protoreflect.MapKey, 0, m.Len())
+		m.Range(func(k protoreflect.MapKey, _ protoreflect.Value) bool {
+			keys = append(keys, k)
+			return true
+		})
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+
+		var screens []*signing.Screen
+		for _, k := range keys {
+			entryScreens, err := t.renderValue(ctx, fmt.Sprintf("%s (%s)", title, k.String()), fd.MapValue(), m.Get(k), indent, expert)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, entryScreens...)
+		}
+		return screens, nil
+
+	default:
+		return t.renderValue(ctx, title, fd, v, indent, expert)
+	}
+}
+
+// renderValue renders a single value of the field.
+func (t Textual) renderValue(ctx context.Context, title string, fd protoreflect.FieldDescriptor, v protoreflect.Value, indent int, expert bool) ([]*signing.Screen, error) {
+	var (
+		text string
+		err  error
+	)
+
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		text = "False"
+		if v.Bool() {
+			text = "True"
+		}
+
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		text, err = formatInteger(strconv.FormatInt(v.Int(), 10))
+
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		text, err = formatInteger(strconv.FormatUint(v.Uint(), 10))
+
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		text = strconv.FormatFloat(v.Float(), 'f', -1, 64)
+
+	case protoreflect.StringKind:
+		switch scalar(fd) {
+		case scalarInt:
+			text, err = formatInteger(v.String())
+		case scalarDec:
+			text, err = formatDec(v.String())
+		default:
+			text = v.String()
+		}
+
+	case protoreflect.BytesKind:
+		text = strings.ToUpper(hex.EncodeToString(v.Bytes()))
+
+	case protoreflect.EnumKind:
+		text = strconv.Itoa(int(v.Enum()))
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			text = string(ev.Name())
+		}
+
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return t.renderMessage(ctx, title, v.Message(), indent, expert)
+
+	default:
+		return nil, fmt.Errorf("unsupported kind %s of field %s", fd.Kind(), fd.FullName())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []*signing.Screen{newScreen(fmt.Sprintf("%s: %s", title, text), indent, expert)}, nil
+}
+
+// renderMessage renders a message value, the well-known types on a single
+// screen and the other messages as their name followed by their fields on the
+// next indentation level.
+func (t Textual) renderMessage(ctx context.Context, title string, msg protoreflect.Message, indent int, expert bool) ([]*signing.Screen, error) {
+	md := msg.Descriptor()
+
+	var text string
+	switch md.FullName() {
+	case coinName, decCoinName:
+		var err error
+		text, err = t.formatCoinMessages(ctx, []protoreflect.Message{msg})
+		if err != nil {
+			return nil, err
+		}
+
+	case timestampName:
+		seconds, nanos := msg.Get(md.Fields().ByName("seconds")).Int(), msg.Get(md.Fields().ByName("nanos")).Int()
+		text = time.Unix(seconds, nanos).UTC().Format(time.RFC3339Nano)
+
+	case durationName:
+		seconds, nanos := msg.Get(md.Fields().ByName("seconds")).Int(), msg.Get(md.Fields().ByName("nanos")).Int()
+		text = (time.Duration(seconds)*time.Second + time.Duration(nanos)).String()
+
+	case anyName:
+		typeURL, value := msg.Get(md.Fields().ByName("type_url")).String(), msg.Get(md.Fields().ByName("value")).Bytes()
+		return t.renderAny(ctx, title, typeURL, value, indent, expert)
+
+	default:
+		fieldScreens, err := t.renderFields(ctx, msg, indent+1, expert)
+		if err != nil {
+			return nil, err
+		}
+
+		return append([]*signing.Screen{newScreen(fmt.Sprintf("%s: %s", title, md.FullName()), indent, expert)}, fieldScreens...), nil
+	}
+
+	return []*signing.Screen{newScreen(fmt.Sprintf("%s: %s", title, text), indent, expert)}, nil
+}
+
+// formatCoinMessages formats Coin or DecCoin messages.
+func (t Textual) formatCoinMessages(ctx context.Context, coins []protoreflect.Message) (string, error) {
+	if len(coins) == 0 {
+		return "", nil
+	}
+
+	// the amount of a DecCoin is a sdk.Dec, i.e. an integer scaled by 10^18
+	prec := 0
+	if coins[0].Descriptor().FullName() == decCoinName {
+		prec = sdk.Precision
+	}
+
+	denoms, amounts := make([]string, len(coins)), make([]*big.Int, len(coins))
+	for i, coin := range coins {
+		fields := coin.Descriptor().Fields()
+		denoms[i] = coin.Get(fields.ByName("denom")).String()
+
+		amount := coin.Get(fields.ByName("amount")).String()
+		if amount == "" {
+			amount = "0"
+		}
+
+		var ok bool
+		amounts[i], ok = new(big.Int).SetString(amount, 10)
+		if !ok {
+			return "", fmt.Errorf("invalid amount %q of coin %s", amount, denoms[i])
+		}
+	}
+
+	return t.formatCoins(ctx, denoms, amounts, prec)
+}
+
+// fieldTitle returns the title of the field, e.g. "From address" for the field
+// from_address.
+func fieldTitle(fd protoreflect.FieldDescriptor) string {
+	title := strings.ReplaceAll(string(fd.Name()), "_", " ")
+	return strings.ToUpper(title[:1]) + title[1:]
+}
+
+// scalar returns the cosmos_proto.scalar option of the field, if any.
+func scalar(fd protoreflect.FieldDescriptor) string {
+	opts, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || opts == nil {
+		return ""
+	}
+
+	s, _ := proto.GetExtension(opts, cosmos_proto.E_Scalar).(string)
+	return s
+}
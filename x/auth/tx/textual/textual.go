@@ -0,0 +1,198 @@
+// Package textual implements the rendering of the transactions signed in
+// SIGN_MODE_TEXTUAL into human-readable screens, which are displayed by the
+// hardware wallets and signed over.
+package textual
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"math/big"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// CoinMetadataQueryFn queries the metadata of a denom, it returns nil when the
+// denom has no metadata.
+type CoinMetadataQueryFn func(ctx context.Context, denom string) (*banktypes.Metadata, error)
+
+// Textual renders the transactions in SIGN_MODE_TEXTUAL.
+type Textual struct {
+	coinMetadataQuerier CoinMetadataQueryFn
+	resolver            *descriptorResolver
+}
+
+// NewTextual returns a new Textual, which renders the coins in the display
+// denom of their metadata queried with coinMetadataQuerier. The coins are
+// rendered in their base denom when coinMetadataQuerier is nil.
+func NewTextual(coinMetadataQuerier CoinMetadataQueryFn) Textual {
+	return Textual{
+		coinMetadataQuerier: coinMetadataQuerier,
+		resolver:            newDescriptorResolver(),
+	}
+}
+
+// TxData is the transaction data rendered in SIGN_MODE_TEXTUAL.
+type TxData struct {
+	Body          *tx.TxBody
+	AuthInfo      *tx.AuthInfo
+	BodyBytes     []byte
+	AuthInfoBytes []byte
+}
+
+// GetSignBytes returns the bytes signed over in SIGN_MODE_TEXTUAL, i.e. the
+// protobuf encoding of the TextualData holding the screens of the transaction.
+func (t Textual) GetSignBytes(ctx context.Context, data authsigning.SignerData, txData TxData) ([]byte, error) {
+	screens, err := t.GetScreens(ctx, data, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	textualData := signing.TextualData{Screens: screens}
+	return textualData.Marshal()
+}
+
+// GetScreens renders the transaction into the screens displayed to the signer.
+// The screens of the details of the transaction which are not necessary to
+// understand its effects, e.g. the gas limit, are marked as expert screens. The
+// last screen holds the hash of the binary representation of the transaction,
+// so that the signature covers all of its bytes.
+func (t Textual) GetScreens(ctx context.Context, data authsigning.SignerData, txData TxData) ([]*signing.Screen, error) {
+	body, authInfo := txData.Body, txData.AuthInfo
+
+	screens := []*signing.Screen{
+		newScreen(fmt.Sprintf("Chain id: %s", data.ChainID), 0, false),
+		newScreen(fmt.Sprintf("Account number: %s", formatUint(data.AccountNumber)), 0, false),
+		newScreen(fmt.Sprintf("Sequence: %s", formatUint(data.Sequence)), 0, false),
+		newScreen(fmt.Sprintf("Address: %s", data.Address), 0, false),
+	}
+
+	if data.PubKey != nil {
+		pkAny, err := codectypes.NewAnyWithValue(data.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		pkScreens, err := t.renderAny(ctx, "Public key", pkAny.TypeUrl, pkAny.Value, 0, true)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, pkScreens...)
+	}
+
+	msgCount := len(body.Messages)
+	screens = append(screens, newScreen(fmt.Sprintf("This transaction has %d %s", msgCount, plural("Message", msgCount)), 0, false))
+	for i, msg := range body.Messages {
+		msgScreens, err := t.renderAny(ctx, fmt.Sprintf("Message (%d/%d)", i+1, msgCount), msg.TypeUrl, msg.Value, 1, false)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens, msgScreens...)
+	}
+	screens = append(screens, newScreen("End of Message", 0, false))
+
+	if body.Memo != "" {
+		screens = append(screens, newScreen(fmt.Sprintf("Memo: %s", body.Memo), 0, false))
+	}
+
+	if fee := authInfo.Fee; fee != nil {
+		if !fee.Amount.Empty() {
+			text, err := t.formatSdkCoins(ctx, fee.Amount)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, newScreen(fmt.Sprintf("Fees: %s", text), 0, false))
+		}
+		if fee.Payer != "" {
+			screens = append(screens, newScreen(fmt.Sprintf("Fee payer: %s", fee.Payer), 0, true))
+		}
+		if fee.Granter != "" {
+			screens = append(screens, newScreen(fmt.Sprintf("Fee granter: %s", fee.Granter), 0, true))
+		}
+		screens = append(screens, newScreen(fmt.Sprintf("Gas limit: %s", formatUint(fee.GasLimit)), 0, true))
+	}
+
+	if tip := authInfo.Tip; tip != nil {
+		text, err := t.formatSdkCoins(ctx, tip.Amount)
+		if err != nil {
+			return nil, err
+		}
+		screens = append(screens,
+			newScreen(fmt.Sprintf("Tip: %s", text), 0, false),
+			newScreen(fmt.Sprintf("Tipper: %s", tip.Tipper), 0, false),
+		)
+	}
+
+	if body.TimeoutHeight != 0 {
+		screens = append(screens, newScreen(fmt.Sprintf("Timeout height: %s", formatUint(body.TimeoutHeight)), 0, true))
+	}
+
+	if body.Unordered {
+		screens = append(screens, newScreen("Unordered: True", 0, false))
+	}
+
+	for _, opts := range []struct {
+		title string
+		anys  []*codectypes.Any
+	}{
+		{"Extension options", body.ExtensionOptions},
+		{"Non critical extension options", body.NonCriticalExtensionOptions},
+	} {
+		for i, opt := range opts.anys {
+			optScreens, err := t.renderAny(ctx, fmt.Sprintf("%s (%d/%d)", opts.title, i+1, len(opts.anys)), opt.TypeUrl, opt.Value, 0, true)
+			if err != nil {
+				return nil, err
+			}
+			screens = append(screens, optScreens...)
+		}
+	}
+
+	hash, err := rawBytesHash(txData)
+	if err != nil {
+		return nil, err
+	}
+	screens = append(screens, newScreen(fmt.Sprintf("Hash of raw bytes: %X", hash), 0, true))
+
+	return screens, nil
+}
+
+// rawBytesHash returns the SHA-256 hash of the TxRaw holding the body and auth
+// info bytes, without the signatures.
+func rawBytesHash(txData TxData) ([]byte, error) {
+	txRaw := tx.TxRaw{
+		BodyBytes:     txData.BodyBytes,
+		AuthInfoBytes: txData.AuthInfoBytes,
+	}
+	bz, err := txRaw.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256(bz)
+	return hash[:], nil
+}
+
+func (t Textual) formatSdkCoins(ctx context.Context, coins sdk.Coins) (string, error) {
+	denoms, amounts := make([]string, len(coins)), make([]*big.Int, len(coins))
+	for i, coin := range coins {
+		denoms[i], amounts[i] = coin.Denom, coin.Amount.BigInt()
+	}
+
+	return t.formatCoins(ctx, denoms, amounts, 0)
+}
+
+func formatUint(i uint64) string {
+	return formatDecimal(new(big.Int).SetUint64(i), 0)
+}
+
+func plural(word string, count int) string {
+	if count == 1 {
+		return word
+	}
+
+	return word + "s"
+}
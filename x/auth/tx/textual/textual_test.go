@@ -0,0 +1,99 @@
+package textual_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/tx/textual"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestGetScreens(t *testing.T) {
+	_, pubKey, from := testdata.KeyTestPubAddr()
+	_, _, to := testdata.KeyTestPubAddr()
+
+	msg, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(from, to, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500000))))
+	require.NoError(t, err)
+
+	body := &tx.TxBody{Messages: []*codectypes.Any{msg}, Memo: "memo", TimeoutHeight: 10}
+	authInfo := &tx.AuthInfo{Fee: &tx.Fee{Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 2000)), GasLimit: 100000}}
+	bodyBytes, err := body.Marshal()
+	require.NoError(t, err)
+	authInfoBytes, err := authInfo.Marshal()
+	require.NoError(t, err)
+
+	querier := func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		if denom != "uatom" {
+			return nil, nil
+		}
+		return &banktypes.Metadata{
+			Base:    "uatom",
+			Display: "atom",
+			DenomUnits: []*banktypes.DenomUnit{
+				{Denom: "uatom", Exponent: 0},
+				{Denom: "atom", Exponent: 6},
+			},
+		}, nil
+	}
+
+	signerData := authsigning.SignerData{
+		Address:       from.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1234,
+		Sequence:      5,
+	}
+	txData := textual.TxData{Body: body, AuthInfo: authInfo, BodyBytes: bodyBytes, AuthInfoBytes: authInfoBytes}
+
+	screens, err := textual.NewTextual(querier).GetScreens(context.Background(), signerData, txData)
+	require.NoError(t, err)
+
+	texts := make([]string, len(screens))
+	for i, screen := range screens {
+		texts[i] = screen.Text
+	}
+	require.Equal(t, []string{
+		"Chain id: test-chain",
+		"Account number: 1'234",
+		"Sequence: 5",
+		"Address: " + from.String(),
+		"This transaction has 1 Message",
+		"Message (1/1): /cosmos.bank.v1beta1.MsgSend",
+		"From address: " + from.String(),
+		"To address: " + to.String(),
+		"Amount: 1.5 atom",
+		"End of Message",
+		"Memo: memo",
+		"Fees: 0.002 atom",
+		"Gas limit: 100'000",
+		"Timeout height: 10",
+		texts[len(texts)-1],
+	}, texts)
+	require.Contains(t, texts[len(texts)-1], "Hash of raw bytes: ")
+	require.Equal(t, uint32(1), screens[5].Indent)
+	require.Equal(t, uint32(2), screens[6].Indent)
+	require.True(t, screens[len(screens)-1].Expert)
+
+	// the public key is rendered on expert screens
+	signerData.PubKey = pubKey
+	screens, err = textual.NewTextual(nil).GetScreens(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	require.Equal(t, "Public key: /cosmos.crypto.secp256k1.PubKey", screens[4].Text)
+	require.True(t, screens[4].Expert)
+	require.True(t, screens[5].Expert)
+	require.Equal(t, "Amount: 1'500'000 uatom", screens[10].Text)
+
+	// the sign bytes are the encoded screens
+	bz, err := textual.NewTextual(nil).GetSignBytes(context.Background(), signerData, txData)
+	require.NoError(t, err)
+	var textualData signing.TextualData
+	require.NoError(t, textualData.Unmarshal(bz))
+	require.Equal(t, screens, textualData.Screens)
+}
@@ -0,0 +1,74 @@
+package tx
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestTextualModeHandler(t *testing.T) {
+	privKey, pubkey, addr := testdata.KeyTestPubAddr()
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	interfaceRegistry.RegisterImplementations((*sdk.Msg)(nil), &testdata.TestMsg{})
+	marshaler := codec.NewProtoCodec(interfaceRegistry)
+
+	querier := func(_ context.Context, denom string) (*banktypes.Metadata, error) {
+		return &banktypes.Metadata{
+			Base:       "uatom",
+			Display:    "atom",
+			DenomUnits: []*banktypes.DenomUnit{{Denom: "uatom"}, {Denom: "atom", Exponent: 6}},
+		}, nil
+	}
+	txConfig := NewTxConfigWithTextual(marshaler, []signingtypes.SignMode{signingtypes.SignMode_SIGN_MODE_TEXTUAL}, querier)
+	modeHandler := txConfig.SignModeHandler()
+	require.Equal(t, signingtypes.SignMode_SIGN_MODE_TEXTUAL, modeHandler.DefaultMode())
+	require.Len(t, modeHandler.Modes(), 1)
+
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetMemo("sometestmemo")
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("uatom", 150)))
+	txBuilder.SetGasLimit(20000)
+
+	accSeq := uint64(2)
+	sigData := &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_TEXTUAL}
+	require.NoError(t, txBuilder.SetSignatures(signingtypes.SignatureV2{PubKey: pubkey, Data: sigData, Sequence: accSeq}))
+
+	signerData := signing.SignerData{
+		Address:       addr.String(),
+		ChainID:       "test-chain",
+		AccountNumber: 1,
+		Sequence:      accSeq,
+		PubKey:        pubkey,
+	}
+
+	ctx := context.Background()
+	signBytes, err := signing.GetSignBytesWithContext(ctx, modeHandler, signingtypes.SignMode_SIGN_MODE_TEXTUAL, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	var textualData signingtypes.TextualData
+	require.NoError(t, textualData.Unmarshal(signBytes))
+	require.Contains(t, textualData.Screens, &signingtypes.Screen{Text: "Fees: 0.00015 atom"})
+
+	sigData.Signature, err = privKey.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, txBuilder.SetSignatures(signingtypes.SignatureV2{PubKey: pubkey, Data: sigData, Sequence: accSeq}))
+	require.NoError(t, signing.VerifySignature(ctx, pubkey, signerData, sigData, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify the signature doesn't match another rendering")
+	txBuilder.SetMemo("othermemo")
+	require.Error(t, signing.VerifySignature(ctx, pubkey, signerData, sigData, modeHandler, txBuilder.GetTx()))
+
+	t.Log("verify other modes are rejected")
+	_, err = modeHandler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder.GetTx())
+	require.Error(t, err)
+}
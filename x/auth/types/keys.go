@@ -19,9 +19,20 @@ const (
 )
 
 var (
+	// ParamsKey is the key of the auth params
+	ParamsKey = []byte{0x00}
+
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// UnorderedTxHashPrefix prefix for the timeout height of the unordered txs
+	// by hash
+	UnorderedTxHashPrefix = []byte{0x02}
+
+	// UnorderedTxTimeoutPrefix prefix for the unordered tx hashes by timeout
+	// height, used for pruning
+	UnorderedTxTimeoutPrefix = []byte{0x03}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +41,21 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// UnorderedTxHashKey returns the key under which the timeout height of the
+// unordered tx with the given hash is stored.
+func UnorderedTxHashKey(txHash []byte) []byte {
+	return append(UnorderedTxHashPrefix, txHash...)
+}
+
+// UnorderedTxTimeoutKey returns the key indexing the unordered tx with the given
+// hash by its timeout height.
+func UnorderedTxTimeoutKey(timeoutHeight uint64, txHash []byte) []byte {
+	return append(UnorderedTxTimeoutKeyPrefix(timeoutHeight), txHash...)
+}
+
+// UnorderedTxTimeoutKeyPrefix returns the prefix of the keys indexing the
+// unordered txs timing out at the given height.
+func UnorderedTxTimeoutKeyPrefix(timeoutHeight uint64) []byte {
+	return append(UnorderedTxTimeoutPrefix, sdk.Uint64ToBigEndian(timeoutHeight)...)
+}
@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+// GetQueryCmd returns vesting module's query commands.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the vesting module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdQueryVestingSchedule(),
+	)
+
+	return queryCmd
+}
+
+// GetCmdQueryVestingSchedule returns a CLI command handler for querying the
+// vesting schedule of a vesting account.
+func GetCmdQueryVestingSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vested and locked coins of a vesting account over time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the vested and locked coins of a vesting account at each time its
+schedule releases coins. Before the first entry, none of the original vesting coins are
+vested. For a continuous vesting account, the coins vest linearly between the entries.
+
+Example:
+$ %s query vesting schedule [address]
+`, version.AppName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VestingSchedule(cmd.Context(), &types.QueryVestingScheduleRequest{Address: addr.String()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,6 +18,8 @@ import (
 // Transaction command flags
 const (
 	FlagDelayed = "delayed"
+	FlagDest    = "dest"
+	FlagMerge   = "merge"
 )
 
 // GetTxCmd returns vesting module's transaction commands.
@@ -34,6 +36,8 @@ func GetTxCmd() *cobra.Command {
 		NewMsgCreateVestingAccountCmd(),
 		NewMsgCreatePermanentLockedAccountCmd(),
 		NewMsgCreatePeriodicVestingAccountCmd(),
+		NewMsgCreateClawbackVestingAccountCmd(),
+		NewMsgClawbackCmd(),
 	)
 
 	return txCmd
@@ -194,7 +198,89 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 				periods = append(periods, period)
 			}
 
-			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods)
+			merge, _ := cmd.Flags().GetBool(FlagMerge)
+
+			msg := types.NewMsgCreatePeriodicVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, periods, merge)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagMerge, false, "Add the periods to the schedule of the periodic vesting account if it already exists")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// ClawbackVestingData defines the schedules of a clawback vesting account, as
+// read from a JSON file.
+type ClawbackVestingData struct {
+	StartTime      int64         `json:"start_time"`
+	LockupPeriods  []InputPeriod `json:"lockup_periods"`
+	VestingPeriods []InputPeriod `json:"vesting_periods"`
+}
+
+// NewMsgCreateClawbackVestingAccountCmd returns a CLI command handler for creating a
+// MsgCreateClawbackVestingAccount transaction.
+func NewMsgCreateClawbackVestingAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-clawback-vesting-account [to_address] [periods_json_file]",
+		Short: "Create a new vesting account funded with an allocation of tokens, subject to clawback.",
+		Long: `Create a new vesting account funded with an allocation of tokens, whose unvested
+tokens can be clawed back by the sender. The coins are unlocked according to the lockup
+periods, and vested according to the vesting periods, both starting at the start time.
+Coins can only be transferred once they are both unlocked and vested, and an empty
+schedule is not enforced. For instance, the following periods.json file describes 20
+"test" coins which vest 30 days apart from each other and are all locked for 90 days:
+
+{
+  "start_time": 1625204910,
+  "lockup_periods": [
+    { "coins": "20test", "length_seconds": 7776000 }
+  ],
+  "vesting_periods": [
+    { "coins": "10test", "length_seconds": 2592000 },
+    { "coins": "10test", "length_seconds": 2592000 }
+  ]
+}`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var vestingData ClawbackVestingData
+
+			err = json.Unmarshal(contents, &vestingData)
+			if err != nil {
+				return err
+			}
+
+			lockupPeriods, err := parseInputPeriods(vestingData.LockupPeriods)
+			if err != nil {
+				return err
+			}
+
+			vestingPeriods, err := parseInputPeriods(vestingData.VestingPeriods)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClawbackVestingAccount(clientCtx.GetFromAddress(), toAddr, vestingData.StartTime, lockupPeriods, vestingPeriods)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -207,3 +293,63 @@ func NewMsgCreatePeriodicVestingAccountCmd() *cobra.Command {
 
 	return cmd
 }
+
+// NewMsgClawbackCmd returns a CLI command handler for creating a
+// MsgClawback transaction.
+func NewMsgClawbackCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [address]",
+		Short: "Transfer the unvested tokens of a clawback vesting account back to its funder.",
+		Long: `Transfer the unvested tokens of a clawback vesting account to its funder, or to the
+address given by the '--dest' flag. The tokens are taken from the balance of the account
+first, then from its unbonding delegations and its delegations. Only the funder of the
+account can claw back its tokens.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var dest sdk.AccAddress
+			if destString, _ := cmd.Flags().GetString(FlagDest); destString != "" {
+				dest, err = sdk.AccAddressFromBech32(destString)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgClawback(clientCtx.GetFromAddress(), addr, dest)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDest, "", "Address of the account to receive the clawed back tokens, instead of the funder")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func parseInputPeriods(inputPeriods []InputPeriod) ([]types.Period, error) {
+	periods := make([]types.Period, 0, len(inputPeriods))
+	for i, p := range inputPeriods {
+		amount, err := sdk.ParseCoinsNormalized(p.Coins)
+		if err != nil {
+			return nil, err
+		}
+
+		if p.Length < 0 {
+			return nil, fmt.Errorf("invalid period length of %d in period %d, length must be greater than 0", p.Length, i)
+		}
+
+		periods = append(periods, types.Period{Length: p.Length, Amount: amount})
+	}
+
+	return periods, nil
+}
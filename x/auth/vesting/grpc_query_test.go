@@ -0,0 +1,53 @@
+package vesting_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func (s *VestingTestSuite) TestQueryVestingSchedule() {
+	queryServer := vesting.NewQueryServerImpl(s.app.AccountKeeper)
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	addr := s.createClawbackAccount()
+
+	testCases := []struct {
+		name   string
+		req    *types.QueryVestingScheduleRequest
+		expErr bool
+	}{
+		{"empty request", nil, true},
+		{"invalid address", &types.QueryVestingScheduleRequest{Address: "invalid"}, true},
+		{"unknown account", &types.QueryVestingScheduleRequest{Address: sdk.AccAddress("unknown_____________").String()}, true},
+		{"not a vesting account", &types.QueryVestingScheduleRequest{Address: s.funder.String()}, true},
+		{"vesting account", &types.QueryVestingScheduleRequest{Address: addr.String()}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			res, err := queryServer.VestingSchedule(goCtx, tc.req)
+			if tc.expErr {
+				s.Require().Error(err)
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().Equal(s.stake(100), res.OriginalVesting)
+			expEntries := []types.VestingScheduleEntry{
+				{Time: s.now.Unix(), Vested: s.stake(0), Locked: s.stake(100)},
+				{Time: s.now.Add(12 * time.Hour).Unix(), Vested: s.stake(50), Locked: s.stake(50)},
+				{Time: s.now.Add(24 * time.Hour).Unix(), Vested: s.stake(100), Locked: s.stake(0)},
+			}
+			s.Require().Len(res.Entries, len(expEntries))
+			for i, entry := range res.Entries {
+				s.Require().Equal(expEntries[i].Time, entry.Time)
+				s.Require().True(expEntries[i].Vested.IsEqual(entry.Vested), "vested: %s", entry.Vested)
+				s.Require().True(expEntries[i].Locked.IsEqual(entry.Locked), "locked: %s", entry.Locked)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package vesting
 
 import (
 	"context"
+	"math"
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -11,18 +12,20 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
 type msgServer struct {
 	keeper.AccountKeeper
 	types.BankKeeper
+	types.StakingKeeper
 }
 
 // NewMsgServerImpl returns an implementation of the vesting MsgServer interface,
-// wrapping the corresponding AccountKeeper and BankKeeper.
-func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper) types.MsgServer {
-	return &msgServer{AccountKeeper: k, BankKeeper: bk}
+// wrapping the corresponding AccountKeeper, BankKeeper and StakingKeeper.
+func NewMsgServerImpl(k keeper.AccountKeeper, bk types.BankKeeper, sk types.StakingKeeper) types.MsgServer {
+	return &msgServer{AccountKeeper: k, BankKeeper: bk, StakingKeeper: sk}
 }
 
 var _ types.MsgServer = msgServer{}
@@ -180,19 +183,106 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		return nil, err
 	}
 
+	var totalCoins sdk.Coins
+
+	for _, period := range msg.VestingPeriods {
+		totalCoins = totalCoins.Add(period.Amount...)
+	}
+
+	madeNewAcc := false
+	if acc := ak.GetAccount(ctx, to); acc != nil {
+		if !msg.Merge {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
+		}
+
+		pva, ok := acc.(*types.PeriodicVestingAccount)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "account %s must be a periodic vesting account to merge the grant", msg.ToAddress)
+		}
+
+		pva.AddGrant(ctx.BlockTime(), msg.StartTime, msg.VestingPeriods, totalCoins)
+		ak.SetAccount(ctx, pva)
+	} else {
+		baseAccount := ak.NewAccountWithAddress(ctx, to)
+
+		acc := types.NewPeriodicVestingAccount(baseAccount.(*authtypes.BaseAccount), totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+
+		ak.SetAccount(ctx, acc)
+		madeNewAcc = true
+	}
+
+	defer func() {
+		if madeNewAcc {
+			telemetry.IncrCounter(1, "new", "account")
+		}
+
+		for _, a := range totalCoins {
+			if a.Amount.IsInt64() {
+				telemetry.SetGaugeWithLabels(
+					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					float32(a.Amount.Int64()),
+					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
+				)
+			}
+		}
+	}()
+
+	err = bk.SendCoins(ctx, from, to, totalCoins)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
+
+}
+
+func (s msgServer) CreateClawbackVestingAccount(goCtx context.Context, msg *types.MsgCreateClawbackVestingAccount) (*types.MsgCreateClawbackVestingAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	to, err := sdk.AccAddressFromBech32(msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if bk.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.ToAddress)
+	}
+
 	if acc := ak.GetAccount(ctx, to); acc != nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "account %s already exists", msg.ToAddress)
 	}
 
-	var totalCoins sdk.Coins
+	// ValidateBasic ensures that both schedules have the same total amount
+	// when they are both present
+	totalCoins := types.Periods(msg.VestingPeriods).TotalAmount()
+	if len(msg.VestingPeriods) == 0 {
+		totalCoins = types.Periods(msg.LockupPeriods).TotalAmount()
+	}
 
-	for _, period := range msg.VestingPeriods {
-		totalCoins = totalCoins.Add(period.Amount...)
+	if err := bk.IsSendEnabledCoins(ctx, totalCoins...); err != nil {
+		return nil, err
 	}
 
-	baseAccount := ak.NewAccountWithAddress(ctx, to)
+	baseAccountI := ak.NewAccountWithAddress(ctx, to)
+
+	baseAcc, ok := baseAccountI.(*authtypes.BaseAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid account type; expected: BaseAccount, got: %T", baseAccountI)
+	}
 
-	acc := types.NewPeriodicVestingAccount(baseAccount.(*authtypes.BaseAccount), totalCoins.Sort(), msg.StartTime, msg.VestingPeriods)
+	acc := types.NewClawbackVestingAccount(baseAcc, from, totalCoins, msg.StartTime, msg.LockupPeriods, msg.VestingPeriods)
 
 	ak.SetAccount(ctx, acc)
 
@@ -202,7 +292,7 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 		for _, a := range totalCoins {
 			if a.Amount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
-					[]string{"tx", "msg", "create_periodic_vesting_account"},
+					[]string{"tx", "msg", "create_clawback_vesting_account"},
 					float32(a.Amount.Int64()),
 					[]metrics.Label{telemetry.NewLabel("denom", a.Denom)},
 				)
@@ -221,6 +311,158 @@ func (s msgServer) CreatePeriodicVestingAccount(goCtx context.Context, msg *type
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		),
 	)
-	return &types.MsgCreatePeriodicVestingAccountResponse{}, nil
 
+	return &types.MsgCreateClawbackVestingAccountResponse{}, nil
+}
+
+func (s msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+
+	funder, err := sdk.AccAddressFromBech32(msg.FunderAddress)
+	if err != nil {
+		return nil, err
+	}
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+	dest := funder
+	if msg.DestAddress != "" {
+		dest, err = sdk.AccAddressFromBech32(msg.DestAddress)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if bk.BlockedAddr(dest) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", dest)
+	}
+
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "account %s does not exist", msg.Address)
+	}
+
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "account %s is not subject to clawback", msg.Address)
+	}
+
+	if va.FunderAddress != msg.FunderAddress {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "clawback can only be requested by the funder %s", va.FunderAddress)
+	}
+
+	// the delegations transferred to the destination account would not be
+	// tracked by its vesting schedule
+	if destAcc := ak.GetAccount(ctx, dest); destAcc != nil {
+		if _, ok := destAcc.(exported.VestingAccount); ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrNotSupported, "clawback to vesting account %s is not supported", dest)
+		}
+	}
+
+	if err := s.clawback(ctx, va, dest); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// clawback removes the unvested coins from the schedule of the account and
+// transfers them to dest, taking them from the balance of the account first,
+// then from its unbonding delegations and finally from its delegations.
+func (s msgServer) clawback(ctx sdk.Context, va *types.ClawbackVestingAccount, dest sdk.AccAddress) error {
+	ak := s.AccountKeeper
+	bk := s.BankKeeper
+	sk := s.StakingKeeper
+
+	addr := va.GetAddress()
+	bondDenom := sk.BondDenom(ctx)
+
+	updatedAcc, toClawBack := va.ComputeClawback(ctx.BlockTime().Unix())
+	if toClawBack.IsZero() {
+		return nil
+	}
+
+	// compute the current bonded and unbonding amounts of the account
+	delegations := sk.GetDelegatorDelegations(ctx, addr, math.MaxUint16)
+	bondedAmt := sdk.ZeroInt()
+	for _, delegation := range delegations {
+		validator, found := sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		bondedAmt = bondedAmt.Add(validator.TokensFromSharesTruncated(delegation.GetShares()).TruncateInt())
+	}
+
+	unbondings := sk.GetUnbondingDelegations(ctx, addr, math.MaxUint16)
+	unbondingAmt := sdk.ZeroInt()
+	for _, unbonding := range unbondings {
+		for _, entry := range unbonding.Entries {
+			unbondingAmt = unbondingAmt.Add(entry.Balance)
+		}
+	}
+
+	bonded := sdk.NewCoins(sdk.NewCoin(bondDenom, bondedAmt))
+	unbonding := sdk.NewCoins(sdk.NewCoin(bondDenom, unbondingAmt))
+	unbonded := bk.GetAllBalances(ctx, addr)
+
+	encumbered := updatedAcc.GetVestingCoins(ctx.BlockTime())
+	toClawBack = updatedAcc.UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded)
+
+	ak.SetAccount(ctx, &updatedAcc)
+
+	// transfer the spendable coins first
+	toXfer := toClawBack.Min(bk.SpendableCoins(ctx, addr))
+	if !toXfer.IsZero() {
+		if err := bk.SendCoins(ctx, addr, dest, toXfer); err != nil {
+			return err
+		}
+	}
+
+	// only the bond denom can be delegated, so the remaining coins must be
+	// transferred from the unbonding delegations and delegations
+	want := toClawBack.Sub(toXfer).AmountOf(bondDenom)
+
+	for _, unbonding := range unbondings {
+		if !want.IsPositive() {
+			return nil
+		}
+		valAddr, err := sdk.ValAddressFromBech32(unbonding.ValidatorAddress)
+		if err != nil {
+			return err
+		}
+		transferred := sk.TransferUnbonding(ctx, addr, dest, valAddr, want)
+		want = want.Sub(transferred)
+	}
+
+	for _, delegation := range delegations {
+		if !want.IsPositive() {
+			return nil
+		}
+		validator, found := sk.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found {
+			continue
+		}
+		wantShares, err := validator.SharesFromTokensTruncated(want)
+		if err != nil {
+			// the validator has no tokens left
+			continue
+		}
+		transferred, err := sk.TransferDelegation(ctx, addr, dest, validator.GetOperator(), wantShares)
+		if err != nil {
+			return err
+		}
+		want = want.Sub(validator.TokensFromSharesTruncated(transferred).TruncateInt())
+	}
+
+	return nil
 }
@@ -0,0 +1,252 @@
+package vesting_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type VestingTestSuite struct {
+	suite.Suite
+
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	msgServer types.MsgServer
+	now       time.Time
+	funder    sdk.AccAddress
+	addrs     []sdk.AccAddress
+	validator stakingtypes.Validator
+}
+
+func (s *VestingTestSuite) SetupTest() {
+	s.now = time.Now().UTC()
+	s.app = simapp.Setup(s.T(), false)
+	s.ctx = s.app.BaseApp.NewContext(false, tmproto.Header{Time: s.now})
+	s.msgServer = vesting.NewMsgServerImpl(s.app.AccountKeeper, s.app.BankKeeper, s.app.StakingKeeper)
+
+	s.addrs = simapp.AddTestAddrs(s.app, s.ctx, 3, sdk.ZeroInt())
+	s.funder = s.addrs[0]
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, s.funder, s.stake(1000)))
+
+	validators := s.app.StakingKeeper.GetAllValidators(s.ctx)
+	s.Require().Len(validators, 1)
+	s.validator = validators[0]
+}
+
+func (s *VestingTestSuite) stake(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amt))
+}
+
+// createClawbackAccount funds a new clawback vesting account with 100 stake,
+// which vests in two halves after 12 and 24 hours.
+func (s *VestingTestSuite) createClawbackAccount() sdk.AccAddress {
+	addr := sdk.AccAddress("clawback_vesting____")
+	vestingPeriods := []types.Period{
+		{Length: 12 * 60 * 60, Amount: s.stake(50)},
+		{Length: 12 * 60 * 60, Amount: s.stake(50)},
+	}
+	msg := types.NewMsgCreateClawbackVestingAccount(s.funder, addr, s.now.Unix(), nil, vestingPeriods)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	return addr
+}
+
+func (s *VestingTestSuite) delegate(addr sdk.AccAddress, amt int64) {
+	validator, found := s.app.StakingKeeper.GetValidator(s.ctx, s.validator.GetOperator())
+	s.Require().True(found)
+	_, err := s.app.StakingKeeper.Delegate(s.ctx, addr, sdk.NewInt(amt), stakingtypes.Unbonded, validator, true)
+	s.Require().NoError(err)
+}
+
+func (s *VestingTestSuite) undelegate(addr sdk.AccAddress, amt int64) {
+	validator, found := s.app.StakingKeeper.GetValidator(s.ctx, s.validator.GetOperator())
+	s.Require().True(found)
+	shares, err := validator.SharesFromTokens(sdk.NewInt(amt))
+	s.Require().NoError(err)
+	_, err = s.app.StakingKeeper.Undelegate(s.ctx, addr, validator.GetOperator(), shares)
+	s.Require().NoError(err)
+}
+
+func (s *VestingTestSuite) delegatedTokens(addr sdk.AccAddress) sdk.Int {
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, addr, s.validator.GetOperator())
+	if !found {
+		return sdk.ZeroInt()
+	}
+	validator, found := s.app.StakingKeeper.GetValidator(s.ctx, s.validator.GetOperator())
+	s.Require().True(found)
+	return validator.TokensFromShares(delegation.Shares).RoundInt()
+}
+
+func (s *VestingTestSuite) unbondingTokens(addr sdk.AccAddress) sdk.Int {
+	total := sdk.ZeroInt()
+	ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, addr, s.validator.GetOperator())
+	if !found {
+		return total
+	}
+	for _, entry := range ubd.Entries {
+		total = total.Add(entry.Balance)
+	}
+	return total
+}
+
+func (s *VestingTestSuite) TestCreateClawbackVestingAccount() {
+	addr := s.createClawbackAccount()
+
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, addr)
+	va, ok := acc.(*types.ClawbackVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(s.funder, va.GetFunder())
+	s.Require().Equal(s.stake(100), va.OriginalVesting)
+	s.Require().Equal(s.now.Add(24*time.Hour).Unix(), va.EndTime)
+	s.Require().Equal(s.stake(100), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	s.Require().Equal(s.stake(900), s.app.BankKeeper.GetAllBalances(s.ctx, s.funder))
+
+	// require the creation to fail for an existing account
+	msg := types.NewMsgCreateClawbackVestingAccount(s.funder, addr, s.now.Unix(), nil, va.VestingPeriods)
+	_, err := s.msgServer.CreateClawbackVestingAccount(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+}
+
+func (s *VestingTestSuite) TestCreatePeriodicVestingAccountMerge() {
+	addr := sdk.AccAddress("periodic_vesting____")
+	goCtx := sdk.WrapSDKContext(s.ctx)
+	periods := []types.Period{
+		{Length: 12 * 60 * 60, Amount: s.stake(50)},
+		{Length: 12 * 60 * 60, Amount: s.stake(50)},
+	}
+
+	// require the account to be created in merge mode if it does not exist
+	msg := types.NewMsgCreatePeriodicVestingAccount(s.funder, addr, s.now.Unix(), periods, true)
+	_, err := s.msgServer.CreatePeriodicVestingAccount(goCtx, msg)
+	s.Require().NoError(err)
+
+	// require the creation to fail for an existing account without merge mode
+	msg = types.NewMsgCreatePeriodicVestingAccount(s.funder, addr, s.now.Unix(), periods, false)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// require a second grant starting 6 hours later to be merged
+	grantPeriods := []types.Period{{Length: 24 * 60 * 60, Amount: s.stake(200)}}
+	msg = types.NewMsgCreatePeriodicVestingAccount(s.funder, addr, s.now.Add(6*time.Hour).Unix(), grantPeriods, true)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, msg)
+	s.Require().NoError(err)
+
+	va, ok := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.PeriodicVestingAccount)
+	s.Require().True(ok)
+	s.Require().Equal(s.stake(300), va.OriginalVesting)
+	s.Require().Equal(s.now.Unix(), va.StartTime)
+	s.Require().Equal(s.now.Add(30*time.Hour).Unix(), va.EndTime)
+	s.Require().Equal(types.Periods{
+		{Length: 12 * 60 * 60, Amount: s.stake(50)},
+		{Length: 12 * 60 * 60, Amount: s.stake(50)},
+		{Length: 6 * 60 * 60, Amount: s.stake(200)},
+	}, types.Periods(va.VestingPeriods))
+	s.Require().Equal(s.stake(300), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	s.Require().Equal(s.stake(700), s.app.BankKeeper.GetAllBalances(s.ctx, s.funder))
+
+	// require the grants to be merged into periodic vesting accounts only
+	clawbackAddr := s.createClawbackAccount()
+	msg = types.NewMsgCreatePeriodicVestingAccount(s.funder, clawbackAddr, s.now.Unix(), periods, true)
+	_, err = s.msgServer.CreatePeriodicVestingAccount(goCtx, msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrNotSupported)
+}
+
+func (s *VestingTestSuite) TestClawbackErrors() {
+	addr := s.createClawbackAccount()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	// require only the funder to be able to claw back
+	_, err := s.msgServer.Clawback(goCtx, types.NewMsgClawback(s.addrs[1], addr, nil))
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// require the account to be a clawback vesting account
+	_, err = s.msgServer.Clawback(goCtx, types.NewMsgClawback(s.funder, s.addrs[1], nil))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotSupported)
+
+	// require the account to exist
+	_, err = s.msgServer.Clawback(goCtx, types.NewMsgClawback(s.funder, sdk.AccAddress("unknown_____________"), nil))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotFound)
+
+	// require the destination not to be a vesting account
+	_, err = s.msgServer.Clawback(goCtx, types.NewMsgClawback(s.funder, addr, addr))
+	s.Require().ErrorIs(err, sdkerrors.ErrNotSupported)
+}
+
+func (s *VestingTestSuite) TestClawbackUnbonded() {
+	addr := s.createClawbackAccount()
+	s.ctx = s.ctx.WithBlockTime(s.now.Add(12 * time.Hour))
+
+	_, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(s.funder, addr, s.addrs[1]))
+	s.Require().NoError(err)
+
+	// require the unvested coins to be sent to the destination
+	s.Require().Equal(s.stake(50), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+	s.Require().Equal(s.stake(50), s.app.BankKeeper.GetAllBalances(s.ctx, s.addrs[1]))
+	s.Require().Equal(s.stake(50), s.app.BankKeeper.SpendableCoins(s.ctx, addr))
+
+	va := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(s.stake(50), va.OriginalVesting)
+	s.Require().Equal(s.now.Add(12*time.Hour).Unix(), va.EndTime)
+	s.Require().Len(va.VestingPeriods, 1)
+
+	// require a second clawback to be a no-op
+	_, err = s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(s.funder, addr, nil))
+	s.Require().NoError(err)
+	s.Require().Equal(s.stake(50), s.app.BankKeeper.GetAllBalances(s.ctx, addr))
+}
+
+func (s *VestingTestSuite) TestClawbackUnbonding() {
+	addr := s.createClawbackAccount()
+	s.delegate(addr, 60)
+	s.undelegate(addr, 20)
+	s.ctx = s.ctx.WithBlockTime(s.now.Add(12 * time.Hour))
+
+	_, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(s.funder, addr, nil))
+	s.Require().NoError(err)
+
+	// require the balance to be clawed back before the unbonding delegation
+	s.Require().True(s.app.BankKeeper.GetAllBalances(s.ctx, addr).IsZero())
+	s.Require().Equal(s.stake(940), s.app.BankKeeper.GetAllBalances(s.ctx, s.funder))
+	s.Require().Equal(sdk.NewInt(10), s.unbondingTokens(addr))
+	s.Require().Equal(sdk.NewInt(10), s.unbondingTokens(s.funder))
+	s.Require().Equal(sdk.NewInt(40), s.delegatedTokens(addr))
+
+	va := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(s.stake(50), va.OriginalVesting)
+	s.Require().True(va.DelegatedVesting.IsZero())
+	s.Require().Equal(s.stake(50), va.DelegatedFree)
+}
+
+func (s *VestingTestSuite) TestClawbackDelegated() {
+	addr := s.createClawbackAccount()
+	s.delegate(addr, 100)
+	s.ctx = s.ctx.WithBlockTime(s.now.Add(12 * time.Hour))
+
+	_, err := s.msgServer.Clawback(sdk.WrapSDKContext(s.ctx), types.NewMsgClawback(s.funder, addr, nil))
+	s.Require().NoError(err)
+
+	// require the unvested delegation to be transferred to the funder
+	s.Require().Equal(sdk.NewInt(50), s.delegatedTokens(addr))
+	s.Require().Equal(sdk.NewInt(50), s.delegatedTokens(s.funder))
+	s.Require().Equal(s.stake(900), s.app.BankKeeper.GetAllBalances(s.ctx, s.funder))
+
+	va := s.app.AccountKeeper.GetAccount(s.ctx, addr).(*types.ClawbackVestingAccount)
+	s.Require().Equal(s.stake(50), va.OriginalVesting)
+	s.Require().True(va.DelegatedVesting.IsZero())
+	s.Require().Equal(s.stake(50), va.DelegatedFree)
+}
+
+func TestVestingTestSuite(t *testing.T) {
+	suite.Run(t, new(VestingTestSuite))
+}
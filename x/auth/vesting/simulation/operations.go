@@ -0,0 +1,187 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateClawbackVestingAccount = "op_weight_msg_create_clawback_vesting_account"
+	OpWeightMsgClawback                     = "op_weight_msg_clawback"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec, ak keeper.AccountKeeper, bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var weightMsgCreateClawbackVestingAccount, weightMsgClawback int
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClawbackVestingAccount, &weightMsgCreateClawbackVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClawbackVestingAccount = simappparams.DefaultWeightMsgCreateClawbackVestingAccount
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgClawback, &weightMsgClawback, nil,
+		func(_ *rand.Rand) {
+			weightMsgClawback = simappparams.DefaultWeightMsgClawback
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateClawbackVestingAccount,
+			SimulateMsgCreateClawbackVestingAccount(ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClawback,
+			SimulateMsgClawback(ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateClawbackVestingAccount generates a MsgCreateClawbackVestingAccount
+// funding a new account with a random subset of the funder's coins, on random
+// lockup and vesting schedules.
+func SimulateMsgCreateClawbackVestingAccount(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		funder, _ := simtypes.RandomAcc(r, accs)
+		recipient := simtypes.RandomAccounts(r, 1)[0]
+
+		if ak.GetAccount(ctx, recipient.Address) != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, "account already exists"), nil, nil
+		}
+
+		spendable := bk.SpendableCoins(ctx, funder.Address)
+		amount := simtypes.RandSubsetCoins(r, spendable)
+		if amount.Empty() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, "empty coins"), nil, nil
+		}
+
+		if err := bk.IsSendEnabledCoins(ctx, amount...); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgCreateClawbackVestingAccount, err.Error()), nil, nil
+		}
+
+		vestingPeriods := RandomPeriods(r, amount)
+		var lockupPeriods []types.Period
+		if r.Intn(2) == 0 {
+			lockupPeriods = RandomPeriods(r, amount)
+		}
+
+		msg := types.NewMsgCreateClawbackVestingAccount(funder.Address, recipient.Address, ctx.BlockTime().Unix(), lockupPeriods, vestingPeriods)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      funder,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: amount,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback for a random ClawbackVestingAccount
+// funded by one of the simulation accounts, to the funder or to a random
+// account.
+func SimulateMsgClawback(ak keeper.AccountKeeper, bk types.BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		var clawbackAccs []*types.ClawbackVestingAccount
+		ak.IterateAccounts(ctx, func(acc authtypes.AccountI) (stop bool) {
+			va, ok := acc.(*types.ClawbackVestingAccount)
+			if !ok {
+				return false
+			}
+			if _, found := simtypes.FindAccount(accs, va.GetFunder()); found {
+				clawbackAccs = append(clawbackAccs, va)
+			}
+			return false
+		})
+
+		if len(clawbackAccs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClawback, "no clawback vesting accounts"), nil, nil
+		}
+
+		va := clawbackAccs[r.Intn(len(clawbackAccs))]
+		funder, _ := simtypes.FindAccount(accs, va.GetFunder())
+
+		// the coins are clawed back to the funder unless a destination is set
+		var dest sdk.AccAddress
+		destAddr := funder.Address
+		if r.Intn(2) == 0 {
+			destAcc, _ := simtypes.RandomAcc(r, accs)
+			dest, destAddr = destAcc.Address, destAcc.Address
+		}
+
+		if _, ok := ak.GetAccount(ctx, destAddr).(exported.VestingAccount); ok {
+			return simtypes.NoOpMsg(types.ModuleName, types.TypeMsgClawback, "destination is a vesting account"), nil, nil
+		}
+
+		msg := types.NewMsgClawback(funder.Address, va.GetAddress(), dest)
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         msg.Type(),
+			Context:         ctx,
+			SimAccount:      funder,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// RandomPeriods returns between one and four periods of random lengths of up
+// to a day, which release the given coins in random installments.
+func RandomPeriods(r *rand.Rand, amount sdk.Coins) []types.Period {
+	numPeriods := simtypes.RandIntBetween(r, 1, 5)
+	periods := make([]types.Period, numPeriods)
+
+	remaining := amount
+	for i := range periods {
+		periods[i].Length = int64(simtypes.RandIntBetween(r, 1, 60*60*24))
+
+		if i == numPeriods-1 {
+			periods[i].Amount = remaining
+			break
+		}
+
+		installment := make(sdk.Coins, 0, len(remaining))
+		for _, coin := range remaining {
+			installment = append(installment, sdk.NewCoin(coin.Denom, simtypes.RandomAmount(r, coin.Amount)))
+		}
+		periods[i].Amount = sdk.NewCoins(installment...)
+		remaining = remaining.Sub(periods[i].Amount)
+	}
+
+	return periods
+}
@@ -5,11 +5,76 @@ import (
 	"strings"
 
 	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Periods stores all vesting periods passed as part of a PeriodicVestingAccount
 type Periods []Period
 
+// TotalLength returns the sum of the lengths of the periods.
+func (vp Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range vp {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the sum of the amounts of the periods.
+func (vp Periods) TotalAmount() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, period := range vp {
+		total = total.Add(period.Amount...)
+	}
+	return total
+}
+
+// MergePeriods merges the vesting schedules of periodsA starting at startA and
+// periodsB starting at startB into a single schedule, which releases at any
+// time the coins released by either schedule at that time. It returns the
+// start and end times of the merged schedule, which starts at the earliest of
+// the two start times.
+func MergePeriods(startA, startB int64, periodsA, periodsB Periods) (int64, int64, Periods) {
+	start := startA
+	if startB < start {
+		start = startB
+	}
+
+	merged := Periods{}
+	last := start
+	endA, endB := startA, startB
+	i, j := 0, 0
+	for i < len(periodsA) || j < len(periodsB) {
+		var end int64
+		amount := sdk.NewCoins()
+
+		// release the next period of either schedule, or of both if they
+		// end at the same time
+		if i < len(periodsA) {
+			end = endA + periodsA[i].Length
+		}
+		if j < len(periodsB) && (i == len(periodsA) || endB+periodsB[j].Length <= end) {
+			end = endB + periodsB[j].Length
+		}
+		if i < len(periodsA) && endA+periodsA[i].Length == end {
+			endA = end
+			amount = amount.Add(periodsA[i].Amount...)
+			i++
+		}
+		if j < len(periodsB) && endB+periodsB[j].Length == end {
+			endB = end
+			amount = amount.Add(periodsB[j].Amount...)
+			j++
+		}
+
+		merged = append(merged, Period{Length: end - last, Amount: amount})
+		last = end
+	}
+
+	return start, last, merged
+}
+
 // String Period implements stringer interface
 func (p Period) String() string {
 	out, _ := yaml.Marshal(p)
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+)
+
+// VestingSchedule returns the vested and locked coins of a vesting account at
+// each time its schedule releases coins, in chronological order. The times of
+// the vesting account types which are not defined in this package are their
+// start and end times.
+func VestingSchedule(va vestexported.VestingAccount) []VestingScheduleEntry {
+	var times []int64
+	switch va := va.(type) {
+	case *ContinuousVestingAccount:
+		times = []int64{va.StartTime, va.EndTime}
+	case *DelayedVestingAccount:
+		times = []int64{va.EndTime}
+	case *PeriodicVestingAccount:
+		times = periodTimes(va.StartTime, va.VestingPeriods)
+	case *PermanentLockedAccount:
+		// the coins are never released
+	case *ClawbackVestingAccount:
+		times = append(periodTimes(va.StartTime, va.LockupPeriods), periodTimes(va.StartTime, va.VestingPeriods)...)
+	default:
+		times = []int64{va.GetStartTime(), va.GetEndTime()}
+	}
+
+	sort.Slice(times, func(i, j int) bool { return times[i] < times[j] })
+
+	entries := make([]VestingScheduleEntry, 0, len(times))
+	for i, t := range times {
+		if i > 0 && t == times[i-1] {
+			continue
+		}
+		blockTime := time.Unix(t, 0)
+		entries = append(entries, VestingScheduleEntry{
+			Time:   t,
+			Vested: va.GetVestedCoins(blockTime),
+			Locked: va.GetVestingCoins(blockTime),
+		})
+	}
+
+	return entries
+}
+
+// periodTimes returns the start time of a schedule, followed by the end times
+// of its periods.
+func periodTimes(startTime int64, periods Periods) []int64 {
+	times := make([]int64, 0, len(periods)+1)
+	times = append(times, startTime)
+	for _, period := range periods {
+		startTime += period.Length
+		times = append(times, startTime)
+	}
+	return times
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
 	"sigs.k8s.io/yaml"
@@ -174,6 +175,8 @@ type vestingAccountYAML struct {
 	// custom fields based on concrete vesting type which can be omitted
 	StartTime      int64   `json:"start_time,omitempty"`
 	VestingPeriods Periods `json:"vesting_periods,omitempty"`
+	FunderAddress  string  `json:"funder_address,omitempty"`
+	LockupPeriods  Periods `json:"lockup_periods,omitempty"`
 }
 
 func (bva BaseVestingAccount) String() string {
@@ -411,6 +414,27 @@ func (pva PeriodicVestingAccount) GetVestingPeriods() Periods {
 	return pva.VestingPeriods
 }
 
+// AddGrant merges a grant of coins, vesting on the given schedule, into the
+// vesting schedule of the account at the given block time. The periods of the
+// merged schedule are relative to the start time of the account, unless the
+// grant starts earlier. The delegated vesting coins are first capped at the
+// coins still vesting at the block time, the excess being delegated free
+// coins, so that the delegations of the vested coins don't unlock the grant.
+func (pva *PeriodicVestingAccount) AddGrant(blockTime time.Time, grantStartTime int64, grantVestingPeriods Periods, grantCoins sdk.Coins) {
+	delegatedVesting := pva.DelegatedVesting.Min(pva.GetVestingCoins(blockTime))
+	if excess := pva.DelegatedVesting.Sub(delegatedVesting); !excess.IsZero() {
+		pva.DelegatedVesting = delegatedVesting
+		pva.DelegatedFree = pva.DelegatedFree.Add(excess...)
+	}
+
+	startTime, endTime, periods := MergePeriods(pva.StartTime, grantStartTime, pva.VestingPeriods, grantVestingPeriods)
+
+	pva.StartTime = startTime
+	pva.EndTime = endTime
+	pva.VestingPeriods = periods
+	pva.OriginalVesting = pva.OriginalVesting.Add(grantCoins...)
+}
+
 // Validate checks for errors on the account fields
 func (pva PeriodicVestingAccount) Validate() error {
 	if pva.GetStartTime() >= pva.GetEndTime() {
@@ -593,6 +617,245 @@ func (plva PermanentLockedAccount) String() string {
 	return out.(string)
 }
 
+//-----------------------------------------------------------------------------
+// Clawback Vesting Account
+
+var _ vestexported.VestingAccount = (*ClawbackVestingAccount)(nil)
+var _ authtypes.GenesisAccount = (*ClawbackVestingAccount)(nil)
+
+// NewClawbackVestingAccount returns a new ClawbackVestingAccount. An empty
+// lockup schedule means that the coins are never locked, and an empty vesting
+// schedule means that the coins are fully vested and cannot be clawed back.
+func NewClawbackVestingAccount(baseAcc *authtypes.BaseAccount, funder sdk.AccAddress, originalVesting sdk.Coins, startTime int64, lockupPeriods, vestingPeriods Periods) *ClawbackVestingAccount {
+	baseVestingAcc := &BaseVestingAccount{
+		BaseAccount:     baseAcc,
+		OriginalVesting: originalVesting,
+		EndTime:         clawbackEndTime(startTime, lockupPeriods, vestingPeriods),
+	}
+
+	return &ClawbackVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		FunderAddress:      funder.String(),
+		StartTime:          startTime,
+		LockupPeriods:      lockupPeriods,
+		VestingPeriods:     vestingPeriods,
+	}
+}
+
+// GetVestedCoins returns the total number of vested coins, i.e. the coins
+// which are both vested and unlocked. If no coins are vested, nil is returned.
+func (va ClawbackVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	return va.GetUnlockedOnly(blockTime).Min(va.GetVestedOnly(blockTime))
+}
+
+// GetVestingCoins returns the total number of vesting coins, i.e. the coins
+// which are still unvested or locked.
+func (va ClawbackVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return va.OriginalVesting.Sub(va.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the set of coins that are not spendable (i.e. locked),
+// defined as the vesting coins that are not delegated.
+func (va ClawbackVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return va.BaseVestingAccount.LockedCoinsFromVesting(va.GetVestingCoins(blockTime))
+}
+
+// GetUnlockedOnly returns the coins unlocked by the lockup schedule,
+// regardless of the vesting schedule.
+func (va ClawbackVestingAccount) GetUnlockedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.LockupPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// GetVestedOnly returns the coins vested by the vesting schedule, regardless
+// of the lockup schedule.
+func (va ClawbackVestingAccount) GetVestedOnly(blockTime time.Time) sdk.Coins {
+	return readSchedule(va.StartTime, va.VestingPeriods, va.OriginalVesting, blockTime.Unix())
+}
+
+// TrackDelegation tracks a desired delegation amount by setting the appropriate
+// values for the amount of delegated vesting, delegated free, and reducing the
+// overall amount of base coins.
+func (va *ClawbackVestingAccount) TrackDelegation(blockTime time.Time, balance, amount sdk.Coins) {
+	va.BaseVestingAccount.TrackDelegation(balance, va.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the time when vesting and unlocking start for a
+// clawback vesting account.
+func (va ClawbackVestingAccount) GetStartTime() int64 {
+	return va.StartTime
+}
+
+// GetLockupPeriods returns the lockup periods of the clawback vesting account.
+func (va ClawbackVestingAccount) GetLockupPeriods() Periods {
+	return va.LockupPeriods
+}
+
+// GetVestingPeriods returns the vesting periods of the clawback vesting account.
+func (va ClawbackVestingAccount) GetVestingPeriods() Periods {
+	return va.VestingPeriods
+}
+
+// GetFunder returns the address which can claw back the unvested coins.
+func (va ClawbackVestingAccount) GetFunder() sdk.AccAddress {
+	funder, _ := sdk.AccAddressFromBech32(va.FunderAddress)
+	return funder
+}
+
+// Validate checks for errors on the account fields
+func (va ClawbackVestingAccount) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(va.FunderAddress); err != nil {
+		return fmt.Errorf("invalid funder address: %w", err)
+	}
+	if va.GetStartTime() > va.GetEndTime() {
+		return errors.New("vesting start-time cannot be after end-time")
+	}
+	if clawbackEndTime(va.StartTime, va.LockupPeriods, va.VestingPeriods) != va.EndTime {
+		return errors.New("vesting end time does not match length of all lockup and vesting periods")
+	}
+	if len(va.LockupPeriods) > 0 && !Periods(va.LockupPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in lockup periods")
+	}
+	if len(va.VestingPeriods) > 0 && !Periods(va.VestingPeriods).TotalAmount().IsEqual(va.OriginalVesting) {
+		return errors.New("original vesting coins does not match the sum of all coins in vesting periods")
+	}
+
+	return va.BaseVestingAccount.Validate()
+}
+
+// ComputeClawback returns a copy of the account with the unvested coins
+// removed from its vesting schedule, along with the removed coins. The lockup
+// schedule is capped at the remaining vested coins, so that vested coins which
+// are still locked remain locked on the same schedule.
+func (va ClawbackVestingAccount) ComputeClawback(clawbackTime int64) (ClawbackVestingAccount, sdk.Coins) {
+	if len(va.VestingPeriods) == 0 {
+		return va, sdk.NewCoins()
+	}
+
+	// truncate the vesting schedule to the periods which have elapsed
+	vestTime := va.StartTime
+	totalVested := sdk.NewCoins()
+	newVestingPeriods := Periods{}
+	for _, period := range va.VestingPeriods {
+		vestTime += period.Length
+		if vestTime > clawbackTime {
+			break
+		}
+		totalVested = totalVested.Add(period.Amount...)
+		newVestingPeriods = append(newVestingPeriods, period)
+	}
+	toClawBack := va.OriginalVesting.Sub(totalVested)
+
+	// cap the cumulative amounts of the lockup schedule at the vested coins,
+	// dropping the periods that no longer unlock anything at the end
+	newLockupPeriods := Periods{}
+	lastUnlocking := 0
+	unlocked := sdk.NewCoins()
+	for i, period := range va.LockupPeriods {
+		newUnlocked := unlocked.Add(period.Amount...)
+		amount := newUnlocked.Min(totalVested).Sub(unlocked.Min(totalVested))
+		newLockupPeriods = append(newLockupPeriods, Period{Length: period.Length, Amount: amount})
+		if !amount.IsZero() {
+			lastUnlocking = i + 1
+		}
+		unlocked = newUnlocked
+	}
+	newLockupPeriods = newLockupPeriods[:lastUnlocking]
+
+	bva := *va.BaseVestingAccount
+	bva.OriginalVesting = totalVested
+	bva.EndTime = clawbackEndTime(va.StartTime, newLockupPeriods, newVestingPeriods)
+
+	va.BaseVestingAccount = &bva
+	va.LockupPeriods = newLockupPeriods
+	va.VestingPeriods = newVestingPeriods
+
+	return va, toClawBack
+}
+
+// UpdateDelegation sets the DelegatedVesting and DelegatedFree fields from
+// the actual bonded and unbonding coins of the account, as they will be once
+// toClawBack coins have been clawed back, and returns the coins which can
+// actually be clawed back. The encumbered coins are the vesting coins of the
+// account after the clawback, and unbonded are its current balances. Coins
+// lost to slashing remain accounted as delegated, as they are for the other
+// vesting account types.
+func (va *ClawbackVestingAccount) UpdateDelegation(encumbered, toClawBack, bonded, unbonding, unbonded sdk.Coins) sdk.Coins {
+	delegated := bonded.Add(unbonding...)
+	oldDelegated := va.DelegatedVesting.Add(va.DelegatedFree...)
+	slashed := oldDelegated.Sub(delegated.Min(oldDelegated))
+	total := delegated.Add(unbonded...)
+
+	// slashing might have left fewer coins than the ones to claw back
+	toClawBack = toClawBack.Min(total)
+
+	newDelegated := delegated.Min(total.Sub(toClawBack)).Add(slashed...)
+	va.DelegatedVesting = encumbered.Min(newDelegated)
+	va.DelegatedFree = newDelegated.Sub(va.DelegatedVesting)
+
+	return toClawBack
+}
+
+func (va ClawbackVestingAccount) String() string {
+	out, _ := va.MarshalYAML()
+	return out.(string)
+}
+
+// MarshalYAML returns the YAML representation of a ClawbackVestingAccount.
+func (va ClawbackVestingAccount) MarshalYAML() (interface{}, error) {
+	accAddr, err := sdk.AccAddressFromBech32(va.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	out := vestingAccountYAML{
+		Address:          accAddr,
+		AccountNumber:    va.AccountNumber,
+		PubKey:           getPKString(va),
+		Sequence:         va.Sequence,
+		OriginalVesting:  va.OriginalVesting,
+		DelegatedFree:    va.DelegatedFree,
+		DelegatedVesting: va.DelegatedVesting,
+		EndTime:          va.EndTime,
+		StartTime:        va.StartTime,
+		VestingPeriods:   va.VestingPeriods,
+		FunderAddress:    va.FunderAddress,
+		LockupPeriods:    va.LockupPeriods,
+	}
+	return marshalYaml(out)
+}
+
+// clawbackEndTime returns the end time of the longest of the lockup and
+// vesting schedules.
+func clawbackEndTime(startTime int64, lockupPeriods, vestingPeriods Periods) int64 {
+	lockupEnd := startTime + lockupPeriods.TotalLength()
+	vestingEnd := startTime + vestingPeriods.TotalLength()
+	if lockupEnd > vestingEnd {
+		return lockupEnd
+	}
+	return vestingEnd
+}
+
+// readSchedule returns the coins released by the periods of a schedule
+// starting at startTime, at readTime. An empty schedule releases all the
+// coins immediately.
+func readSchedule(startTime int64, periods Periods, totalCoins sdk.Coins, readTime int64) sdk.Coins {
+	if len(periods) == 0 {
+		return totalCoins
+	}
+
+	var coins sdk.Coins
+	periodEnd := startTime
+	for _, period := range periods {
+		periodEnd += period.Length
+		if readTime < periodEnd {
+			break
+		}
+		coins = coins.Add(period.Amount...)
+	}
+
+	return coins
+}
+
 type getPK interface {
 	GetPubKey() cryptotypes.PubKey
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
 	"github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 )
 
@@ -676,6 +677,413 @@ func TestTrackUndelegationPermLockedVestingAcc(t *testing.T) {
 	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, plva.DelegatedVesting)
 }
 
+func TestGetVestedCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+		types.Period{Length: int64(6 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 250), sdk.NewInt64Coin(stakeDenom, 25)}},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	// without a lockup schedule, coins vest as for a periodic vesting account
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, periods)
+	require.Equal(t, endTime.Unix(), va.GetEndTime())
+	require.True(t, va.GetVestedCoins(now).IsZero())
+	require.Equal(t, periods[0].Amount, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, periods[0].Amount, va.GetVestedCoins(now.Add(15*time.Hour)))
+	require.Equal(t, periods[0].Amount.Add(periods[1].Amount...), va.GetVestedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, origCoins, va.GetVestedCoins(endTime))
+
+	// without a vesting schedule, coins are vested once unlocked
+	lockupPeriods := types.Periods{types.Period{Length: int64(12 * 60 * 60), Amount: origCoins}}
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, nil)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va.GetEndTime())
+	require.True(t, va.GetVestedCoins(now).IsZero())
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(12*time.Hour)))
+
+	// with both schedules, coins must be both vested and unlocked
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, periods)
+	require.True(t, va.GetVestedCoins(now.Add(6*time.Hour)).IsZero())
+	require.Equal(t, periods[0].Amount, va.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, periods[0].Amount.Add(periods[1].Amount...), va.GetVestedCoins(now.Add(18*time.Hour)))
+
+	// a lockup longer than the vesting schedule keeps vested coins locked
+	lockupPeriods = types.Periods{types.Period{Length: int64(48 * 60 * 60), Amount: origCoins}}
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, periods)
+	require.Equal(t, now.Add(48*time.Hour).Unix(), va.GetEndTime())
+	require.True(t, va.GetVestedCoins(endTime).IsZero())
+	require.Equal(t, origCoins, va.GetVestedOnly(endTime))
+	require.Equal(t, origCoins, va.GetVestingCoins(endTime))
+	require.Equal(t, origCoins, va.GetVestedCoins(now.Add(48*time.Hour)))
+}
+
+func TestSpendableCoinsClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	lockupPeriods := types.Periods{types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}}}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, periods)
+
+	// require that all coins are locked at the beginning of the schedules
+	require.Equal(t, origCoins, va.LockedCoins(now))
+
+	// require that vested coins remain locked until the end of the lockup
+	require.Equal(t, origCoins, va.LockedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, periods[1].Amount, va.LockedCoins(now.Add(18*time.Hour)))
+
+	// require that all coins are spendable at the end of the schedules
+	require.Equal(t, sdk.NewCoins(), va.LockedCoins(now.Add(24*time.Hour)))
+
+	// require that delegated vesting coins are not locked
+	va.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 60)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 40)}, va.LockedCoins(now))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500)}, va.LockedCoins(now.Add(18*time.Hour)))
+}
+
+func TestTrackDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	lockupPeriods := types.Periods{types.Period{Length: int64(18 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}}}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+
+	// require the ability to delegate all vesting coins
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, periods)
+	va.TrackDelegation(now, origCoins, origCoins)
+	require.Equal(t, origCoins, va.DelegatedVesting)
+	require.Nil(t, va.DelegatedFree)
+
+	// require the ability to delegate all vested coins
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, periods)
+	va.TrackDelegation(endTime, origCoins, origCoins)
+	require.Nil(t, va.DelegatedVesting)
+	require.Equal(t, origCoins, va.DelegatedFree)
+
+	// require that vested but locked coins are delegated as vesting coins
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, periods)
+	va.TrackDelegation(now.Add(12*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, va.DelegatedVesting)
+	require.Nil(t, va.DelegatedFree)
+
+	// require the ability to undelegate the vesting coins
+	va.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Nil(t, va.DelegatedVesting)
+	require.Nil(t, va.DelegatedFree)
+
+	// require the delegated coins to be split between vested and vesting coins
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, periods)
+	va.TrackDelegation(now.Add(18*time.Hour), origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 80)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, va.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}, va.DelegatedFree)
+}
+
+func TestComputeClawback(t *testing.T) {
+	now := tmtime.Now()
+	c := func(fee, stake int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(feeDenom, fee), sdk.NewInt64Coin(stakeDenom, stake))
+	}
+	vestingPeriods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: c(500, 50)},
+		types.Period{Length: int64(6 * 60 * 60), Amount: c(250, 25)},
+		types.Period{Length: int64(6 * 60 * 60), Amount: c(250, 25)},
+	}
+	lockupPeriods := types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: c(200, 20)},
+		types.Period{Length: int64(6 * 60 * 60), Amount: c(400, 40)},
+		types.Period{Length: int64(24 * 60 * 60), Amount: c(400, 40)},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, vestingPeriods)
+
+	// require everything to be clawed back before the first vesting period
+	va2, toClawBack := va.ComputeClawback(now.Add(6 * time.Hour).Unix())
+	require.Equal(t, origCoins, toClawBack)
+	require.True(t, va2.OriginalVesting.IsZero())
+	require.Empty(t, va2.LockupPeriods)
+	require.Empty(t, va2.VestingPeriods)
+	require.Equal(t, now.Unix(), va2.EndTime)
+	require.NoError(t, va2.Validate())
+
+	// require the unvested coins to be clawed back and the lockup to be capped
+	va2, toClawBack = va.ComputeClawback(now.Add(15 * time.Hour).Unix())
+	require.Equal(t, c(500, 50), toClawBack)
+	require.Equal(t, c(500, 50), va2.OriginalVesting)
+	require.Equal(t, types.Periods{vestingPeriods[0]}, types.Periods(va2.VestingPeriods))
+	require.Equal(t, types.Periods{
+		types.Period{Length: int64(6 * 60 * 60), Amount: c(200, 20)},
+		types.Period{Length: int64(6 * 60 * 60), Amount: c(300, 30)},
+	}, types.Periods(va2.LockupPeriods))
+	require.Equal(t, now.Add(12*time.Hour).Unix(), va2.EndTime)
+	require.NoError(t, va2.Validate())
+
+	// require the original account to be left untouched
+	require.Equal(t, origCoins, va.OriginalVesting)
+	require.Equal(t, now.Add(36*time.Hour).Unix(), va.EndTime)
+	require.Len(t, va.VestingPeriods, 3)
+
+	// require nothing to be clawed back once everything has vested
+	va2, toClawBack = va.ComputeClawback(now.Add(24 * time.Hour).Unix())
+	require.True(t, toClawBack.IsZero())
+	require.Equal(t, origCoins, va2.OriginalVesting)
+	require.Equal(t, va.EndTime, va2.EndTime)
+
+	// require nothing to be clawed back without a vesting schedule
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), lockupPeriods, nil)
+	_, toClawBack = va.ComputeClawback(now.Unix())
+	require.True(t, toClawBack.IsZero())
+}
+
+func TestUpdateDelegationClawbackVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	bacc, _ := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	stake := func(amt int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(stakeDenom, amt))
+	}
+
+	// delegate 60 vesting coins, and claw back 50 once half of the coins vested
+	va := types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, periods)
+	va.TrackDelegation(now, origCoins, stake(60))
+	toClawBack := va.UpdateDelegation(sdk.NewCoins(), stake(50), stake(60), sdk.NewCoins(), stake(40))
+	require.Equal(t, stake(50), toClawBack)
+	require.True(t, va.DelegatedVesting.IsZero())
+	require.Equal(t, stake(50), va.DelegatedFree)
+
+	// require the vested but locked coins to remain delegated vesting
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, periods)
+	va.TrackDelegation(now, origCoins, stake(60))
+	toClawBack = va.UpdateDelegation(stake(50), stake(50), stake(40), stake(20), stake(40))
+	require.Equal(t, stake(50), toClawBack)
+	require.Equal(t, stake(50), va.DelegatedVesting)
+	require.True(t, va.DelegatedFree.IsZero())
+
+	// require slashed coins to remain delegated
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, periods)
+	va.TrackDelegation(now, origCoins, stake(60))
+	toClawBack = va.UpdateDelegation(stake(50), stake(50), stake(40), sdk.NewCoins(), stake(40))
+	require.Equal(t, stake(50), toClawBack)
+	require.Equal(t, stake(50), va.DelegatedVesting)
+	require.True(t, va.DelegatedFree.IsZero())
+
+	// require no more than the remaining coins to be clawed back
+	va = types.NewClawbackVestingAccount(bacc, funder, origCoins, now.Unix(), nil, periods)
+	va.TrackDelegation(now, origCoins, stake(100))
+	toClawBack = va.UpdateDelegation(sdk.NewCoins(), stake(100), stake(30), sdk.NewCoins(), sdk.NewCoins())
+	require.Equal(t, stake(30), toClawBack)
+	require.True(t, va.DelegatedVesting.IsZero())
+	require.Equal(t, stake(70), va.DelegatedFree)
+}
+
+func TestMergePeriods(t *testing.T) {
+	c := func(fee, stake int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(feeDenom, fee), sdk.NewInt64Coin(stakeDenom, stake))
+	}
+	periodsA := types.Periods{
+		types.Period{Length: 10, Amount: c(10, 1)},
+		types.Period{Length: 10, Amount: c(10, 1)},
+	}
+	periodsB := types.Periods{
+		types.Period{Length: 5, Amount: c(5, 0)},
+		types.Period{Length: 10, Amount: c(5, 5)},
+		types.Period{Length: 10, Amount: c(5, 5)},
+	}
+
+	// require the periods ending at the same time to be combined
+	start, end, merged := types.MergePeriods(100, 105, periodsA, periodsB)
+	require.Equal(t, int64(100), start)
+	require.Equal(t, int64(130), end)
+	require.Equal(t, types.Periods{
+		types.Period{Length: 10, Amount: c(15, 1)},
+		types.Period{Length: 10, Amount: c(15, 6)},
+		types.Period{Length: 10, Amount: c(5, 5)},
+	}, merged)
+
+	// require the schedules to be merged from the earliest start time
+	start, end, merged = types.MergePeriods(100, 90, periodsA, periodsB)
+	require.Equal(t, int64(90), start)
+	require.Equal(t, int64(120), end)
+	require.Equal(t, types.Periods{
+		types.Period{Length: 5, Amount: c(5, 0)},
+		types.Period{Length: 10, Amount: c(5, 5)},
+		types.Period{Length: 5, Amount: c(10, 1)},
+		types.Period{Length: 5, Amount: c(5, 5)},
+		types.Period{Length: 5, Amount: c(10, 1)},
+	}, merged)
+
+	// require merging with an empty schedule to shift the periods
+	start, end, merged = types.MergePeriods(100, 50, periodsA, nil)
+	require.Equal(t, int64(50), start)
+	require.Equal(t, int64(120), end)
+	require.Equal(t, types.Periods{
+		types.Period{Length: 60, Amount: c(10, 1)},
+		types.Period{Length: 10, Amount: c(10, 1)},
+	}, merged)
+}
+
+func TestAddGrantPeriodicVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+		types.Period{Length: int64(12 * 60 * 60), Amount: sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}},
+	}
+	grantCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	grantPeriods := types.Periods{
+		types.Period{Length: int64(24 * 60 * 60), Amount: grantCoins},
+	}
+
+	bacc, origCoins := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, origCoins, now.Unix(), periods)
+	pva.TrackDelegation(now, origCoins, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+
+	// add a grant starting after 6 hours
+	pva.AddGrant(now, now.Add(6*time.Hour).Unix(), grantPeriods, grantCoins)
+	require.NoError(t, pva.Validate())
+	require.Equal(t, now.Unix(), pva.StartTime)
+	require.Equal(t, now.Add(30*time.Hour).Unix(), pva.EndTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 200)}, pva.OriginalVesting)
+	require.Len(t, pva.VestingPeriods, 3)
+
+	// require the delegations of vesting coins to be left unchanged
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.DelegatedVesting)
+	require.Nil(t, pva.DelegatedFree)
+
+	// require both grants to vest on their own schedules
+	require.Equal(t, periods[0].Amount, pva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, origCoins, pva.GetVestedCoins(now.Add(24*time.Hour)))
+	require.Equal(t, origCoins.Add(grantCoins...), pva.GetVestedCoins(now.Add(30*time.Hour)))
+
+	// require the delegated vesting coins to count towards the locked coins
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, pva.LockedCoins(now.Add(24*time.Hour)))
+}
+
+func TestAddGrantPeriodicVestingAccDelegated(t *testing.T) {
+	now := tmtime.Now()
+	vestingCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}
+	halfCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: halfCoins},
+		types.Period{Length: int64(12 * 60 * 60), Amount: halfCoins},
+	}
+	grantPeriods := types.Periods{
+		types.Period{Length: int64(24 * 60 * 60), Amount: halfCoins},
+	}
+
+	bacc, _ := initBaseAccount()
+	pva := types.NewPeriodicVestingAccount(bacc, vestingCoins, now.Unix(), periods)
+	// all the coins are delegated at the start
+	pva.TrackDelegation(now, vestingCoins, vestingCoins)
+	require.Equal(t, vestingCoins, pva.DelegatedVesting)
+
+	// merge a grant once half of the coins have vested
+	t1 := now.Add(12 * time.Hour)
+	pva.AddGrant(t1, t1.Unix(), grantPeriods, halfCoins)
+	require.NoError(t, pva.Validate())
+
+	// require the delegated vesting coins to be capped at the coins which were still vesting
+	require.Equal(t, halfCoins, pva.DelegatedVesting)
+	require.Equal(t, halfCoins, pva.DelegatedFree)
+
+	// require the coins of the grant to be locked
+	require.Equal(t, vestingCoins, pva.GetVestingCoins(t1))
+	require.Equal(t, halfCoins, pva.LockedCoins(t1))
+	require.Empty(t, pva.LockedCoins(now.Add(36*time.Hour)))
+}
+
+func TestVestingSchedule(t *testing.T) {
+	now := tmtime.Now()
+	bacc, origCoins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	halfCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}
+	periods := types.Periods{
+		types.Period{Length: int64(12 * 60 * 60), Amount: halfCoins},
+		types.Period{Length: int64(12 * 60 * 60), Amount: halfCoins},
+	}
+	start := now.Unix()
+	end := now.Add(24 * time.Hour).Unix()
+
+	testCases := []struct {
+		name    string
+		acc     vestexported.VestingAccount
+		entries []types.VestingScheduleEntry
+	}{
+		{
+			"continuous vesting account",
+			types.NewContinuousVestingAccount(bacc, origCoins, start, end),
+			[]types.VestingScheduleEntry{
+				{Time: start, Locked: origCoins},
+				{Time: end, Vested: origCoins, Locked: sdk.Coins{}},
+			},
+		},
+		{
+			"delayed vesting account",
+			types.NewDelayedVestingAccount(bacc, origCoins, end),
+			[]types.VestingScheduleEntry{
+				{Time: end, Vested: origCoins, Locked: sdk.Coins{}},
+			},
+		},
+		{
+			"periodic vesting account",
+			types.NewPeriodicVestingAccount(bacc, origCoins, start, periods),
+			[]types.VestingScheduleEntry{
+				{Time: start, Locked: origCoins},
+				{Time: start + periods[0].Length, Vested: halfCoins, Locked: halfCoins},
+				{Time: end, Vested: origCoins, Locked: sdk.Coins{}},
+			},
+		},
+		{
+			"permanent locked account",
+			types.NewPermanentLockedAccount(bacc, origCoins),
+			[]types.VestingScheduleEntry{},
+		},
+		{
+			"clawback vesting account",
+			types.NewClawbackVestingAccount(bacc, funder, origCoins, start,
+				types.Periods{types.Period{Length: int64(18 * 60 * 60), Amount: origCoins}}, periods),
+			[]types.VestingScheduleEntry{
+				{Time: start, Vested: sdk.Coins{}, Locked: origCoins},
+				{Time: start + periods[0].Length, Vested: sdk.Coins{}, Locked: origCoins},
+				{Time: now.Add(18 * time.Hour).Unix(), Vested: halfCoins, Locked: halfCoins},
+				{Time: end, Vested: origCoins, Locked: sdk.Coins{}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			entries := types.VestingSchedule(tc.acc)
+			require.Len(t, entries, len(tc.entries))
+			for i, entry := range entries {
+				require.Equal(t, tc.entries[i].Time, entry.Time)
+				require.True(t, tc.entries[i].Vested.IsEqual(entry.Vested), "vested: %s", entry.Vested)
+				require.True(t, tc.entries[i].Locked.IsEqual(entry.Locked), "locked: %s", entry.Locked)
+			}
+		})
+	}
+}
+
 func TestGenesisAccountValidate(t *testing.T) {
 	pubkey := secp256k1.GenPrivKey().PubKey()
 	addr := sdk.AccAddress(pubkey.Address())
@@ -741,6 +1149,39 @@ func TestGenesisAccountValidate(t *testing.T) {
 			&types.PermanentLockedAccount{BaseVestingAccount: baseVestingWithCoins},
 			true,
 		},
+		{
+			"valid clawback vesting account",
+			types.NewClawbackVestingAccount(baseAcc, addr, initialVesting, 0,
+				types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+				types.Periods{types.Period{Length: int64(50), Amount: initialVesting}}),
+			false,
+		},
+		{
+			"invalid clawback vesting account funder",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: initialVesting}},
+			},
+			true,
+		},
+		{
+			"invalid clawback vesting period lengths",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				FunderAddress:      addr.String(),
+				VestingPeriods:     types.Periods{types.Period{Length: int64(50), Amount: initialVesting}},
+			},
+			true,
+		},
+		{
+			"invalid clawback lockup period amounts",
+			&types.ClawbackVestingAccount{
+				BaseVestingAccount: baseVestingWithCoins,
+				FunderAddress:      addr.String(),
+				LockupPeriods:      types.Periods{types.Period{Length: int64(100), Amount: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 25)}}},
+			},
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -828,6 +1269,27 @@ func (s *VestingAccountTestSuite) TestPermanentLockedAccountMarshal() {
 	require.NotNil(err)
 }
 
+func (s *VestingAccountTestSuite) TestClawbackVestingAccountMarshal() {
+	app := s.app
+	require := s.Require()
+	baseAcc, coins := initBaseAccount()
+	_, _, funder := testdata.KeyTestPubAddr()
+	acc := types.NewClawbackVestingAccount(baseAcc, funder, coins, time.Now().Unix(),
+		types.Periods{types.Period{7200, coins}}, types.Periods{types.Period{3600, coins}})
+
+	bz, err := app.AccountKeeper.MarshalAccount(acc)
+	require.Nil(err)
+
+	acc2, err := app.AccountKeeper.UnmarshalAccount(bz)
+	require.Nil(err)
+	require.IsType(&types.ClawbackVestingAccount{}, acc2)
+	require.Equal(acc.String(), acc2.String())
+
+	// error on bad bytes
+	_, err = app.AccountKeeper.UnmarshalAccount(bz[:len(bz)/2])
+	require.NotNil(err)
+}
+
 func initBaseAccount() (*authtypes.BaseAccount, sdk.Coins) {
 	_, _, addr := testdata.KeyTestPubAddr()
 	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
@@ -109,34 +109,78 @@ func (m *Grant) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Grant proto.InternalMessageInfo
 
+// GrantQueueItem contains the list of TypeURL of a sdk.Msg expiring at the same
+// time for a (granter, grantee) pair.
+type GrantQueueItem struct {
+	// msg_type_urls contains the list of TypeURL of a sdk.Msg.
+	MsgTypeUrls []string `protobuf:"bytes,1,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+}
+
+func (m *GrantQueueItem) Reset()         { *m = GrantQueueItem{} }
+func (m *GrantQueueItem) String() string { return proto.CompactTextString(m) }
+func (*GrantQueueItem) ProtoMessage()    {}
+func (*GrantQueueItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_544dc2e84b61c637, []int{2}
+}
+func (m *GrantQueueItem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GrantQueueItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GrantQueueItem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GrantQueueItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantQueueItem.Merge(m, src)
+}
+func (m *GrantQueueItem) XXX_Size() int {
+	return m.Size()
+}
+func (m *GrantQueueItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantQueueItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GrantQueueItem proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*GenericAuthorization)(nil), "cosmos.authz.v1beta1.GenericAuthorization")
 	proto.RegisterType((*Grant)(nil), "cosmos.authz.v1beta1.Grant")
+	proto.RegisterType((*GrantQueueItem)(nil), "cosmos.authz.v1beta1.GrantQueueItem")
 }
 
 func init() { proto.RegisterFile("cosmos/authz/v1beta1/authz.proto", fileDescriptor_544dc2e84b61c637) }
 
 var fileDescriptor_544dc2e84b61c637 = []byte{
-	// 301 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8, 0xd2, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34,
-	0x84, 0xf0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0x20, 0x2a, 0xf4, 0x20, 0x62, 0x50,
-	0x15, 0x52, 0x92, 0x10, 0xd1, 0x78, 0xb0, 0x1a, 0x7d, 0xa8, 0x12, 0x30, 0x47, 0x4a, 0x3e, 0x3d,
-	0x3f, 0x3f, 0x3d, 0x27, 0x55, 0x1f, 0xcc, 0x4b, 0x2a, 0x4d, 0xd3, 0x2f, 0xc9, 0xcc, 0x4d, 0x2d,
-	0x2e, 0x49, 0xcc, 0x2d, 0x80, 0x2a, 0x10, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x68, 0x04, 0xb1, 0xa0,
-	0xa2, 0x92, 0xe8, 0xda, 0x12, 0xf3, 0x2a, 0x21, 0x52, 0x4a, 0xd6, 0x5c, 0x22, 0xee, 0xa9, 0x79,
-	0xa9, 0x45, 0x99, 0xc9, 0x8e, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x55, 0x89, 0x25, 0x99, 0xf9,
-	0x79, 0x42, 0x02, 0x5c, 0xcc, 0xb9, 0xc5, 0xe9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x20,
-	0xa6, 0x95, 0xe0, 0xa9, 0x2d, 0xba, 0xbc, 0x28, 0x8a, 0x94, 0xe6, 0x30, 0x72, 0xb1, 0xba, 0x17,
-	0x25, 0xe6, 0x95, 0x08, 0xf9, 0x72, 0xf1, 0x26, 0x22, 0x4b, 0x81, 0x35, 0x72, 0x1b, 0x89, 0xe8,
-	0x41, 0x6c, 0xd6, 0x83, 0xd9, 0xac, 0xe7, 0x98, 0x57, 0xe9, 0x84, 0x69, 0x52, 0x10, 0xaa, 0x6e,
-	0x21, 0x17, 0x2e, 0xae, 0xd4, 0x8a, 0x82, 0xcc, 0x22, 0x88, 0x59, 0x4c, 0x60, 0xb3, 0xa4, 0x30,
-	0xcc, 0x0a, 0x81, 0x79, 0xde, 0x89, 0xe3, 0xc4, 0x3d, 0x79, 0x86, 0x09, 0xf7, 0xe5, 0x19, 0x83,
-	0x90, 0xf4, 0x39, 0x39, 0x9d, 0x78, 0x28, 0xc7, 0x70, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x2a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xd0, 0x40,
-	0x86, 0x52, 0xba, 0xc5, 0x29, 0xd9, 0xfa, 0x15, 0x90, 0x88, 0x4a, 0x62, 0x03, 0xdb, 0x66, 0x0c,
-	0x08, 0x00, 0x00, 0xff, 0xff, 0x46, 0xfb, 0xa1, 0x7a, 0xcd, 0x01, 0x00, 0x00,
+	// 348 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xbd, 0x6e, 0xea, 0x30,
+	0x14, 0xc7, 0xe3, 0x8b, 0xee, 0xd5, 0xc5, 0x88, 0xab, 0xdb, 0x28, 0x03, 0x30, 0x04, 0x14, 0x75,
+	0x60, 0x21, 0x11, 0x6d, 0xa7, 0x76, 0x22, 0xaa, 0x84, 0x3a, 0x74, 0x68, 0x44, 0x97, 0x2e, 0xc8,
+	0xa1, 0xae, 0x89, 0x8a, 0xe3, 0xc8, 0x1f, 0x15, 0xe1, 0x29, 0x78, 0x80, 0x3e, 0x46, 0x1f, 0x02,
+	0x75, 0x62, 0xec, 0xd4, 0x0f, 0x78, 0x91, 0x0a, 0x3b, 0x48, 0x50, 0x26, 0x9f, 0x8f, 0xdf, 0xff,
+	0x7f, 0x8e, 0x75, 0x60, 0x6b, 0xc4, 0x04, 0x65, 0x22, 0x40, 0x4a, 0x8e, 0x67, 0xc1, 0x53, 0x37,
+	0xc6, 0x12, 0x75, 0x4d, 0xe6, 0x67, 0x9c, 0x49, 0x66, 0x3b, 0x86, 0xf0, 0x4d, 0xad, 0x20, 0x1a,
+	0x75, 0x53, 0x1d, 0x6a, 0x26, 0x28, 0x10, 0x9d, 0x34, 0x9a, 0x84, 0x31, 0x32, 0xc1, 0x81, 0xce,
+	0x62, 0xf5, 0x10, 0xc8, 0x84, 0x62, 0x21, 0x11, 0xcd, 0x0a, 0xc0, 0x21, 0x8c, 0x30, 0x23, 0xdc,
+	0x44, 0x45, 0xb5, 0xfe, 0x53, 0x86, 0xd2, 0xdc, 0xb4, 0xbc, 0x0b, 0xe8, 0xf4, 0x71, 0x8a, 0x79,
+	0x32, 0xea, 0x29, 0x39, 0x66, 0x3c, 0x99, 0x21, 0x99, 0xb0, 0xd4, 0xfe, 0x0f, 0x4b, 0x54, 0x90,
+	0x1a, 0x68, 0x81, 0x76, 0x39, 0xda, 0x84, 0xe7, 0x47, 0xaf, 0x2f, 0x9d, 0xea, 0x1e, 0xe4, 0x3d,
+	0x03, 0xf8, 0xbb, 0xcf, 0x51, 0x2a, 0xed, 0x6b, 0x58, 0x45, 0xbb, 0x2d, 0x2d, 0xac, 0x9c, 0x38,
+	0xbe, 0x99, 0xec, 0x6f, 0x27, 0xfb, 0xbd, 0x34, 0x0f, 0x0f, 0x9d, 0xa2, 0x7d, 0xb5, 0x7d, 0x09,
+	0x21, 0x9e, 0x66, 0x09, 0x37, 0x5e, 0xbf, 0xb4, 0x57, 0xe3, 0xc0, 0x6b, 0xb0, 0xfd, 0x7c, 0xf8,
+	0x77, 0xf1, 0xde, 0xb4, 0xe6, 0x1f, 0x4d, 0x10, 0xed, 0xe8, 0xbc, 0x33, 0xf8, 0x4f, 0x6f, 0x77,
+	0xa3, 0xb0, 0xc2, 0x57, 0x12, 0x53, 0xdb, 0x83, 0x55, 0x2a, 0xc8, 0x50, 0xe6, 0x19, 0x1e, 0x2a,
+	0x3e, 0x11, 0x35, 0xd0, 0x2a, 0xb5, 0xcb, 0x51, 0x85, 0x0a, 0x32, 0xc8, 0x33, 0x7c, 0xcb, 0x27,
+	0x22, 0x0c, 0x17, 0x5f, 0xae, 0xb5, 0x58, 0xb9, 0x60, 0xb9, 0x72, 0xc1, 0xe7, 0xca, 0x05, 0xf3,
+	0xb5, 0x6b, 0x2d, 0xd7, 0xae, 0xf5, 0xb6, 0x76, 0xad, 0xbb, 0x63, 0x92, 0xc8, 0xb1, 0x8a, 0xfd,
+	0x11, 0xa3, 0xc5, 0x69, 0x8a, 0xa7, 0x23, 0xee, 0x1f, 0x83, 0xa9, 0x39, 0x6f, 0xfc, 0x47, 0xef,
+	0x78, 0xfa, 0x3d, 0x00, 0x76, 0x4b, 0x71, 0x6b, 0x03, 0x02, 0x00, 0x00,
 }
 
 func (m *GenericAuthorization) Marshal() (dAtA []byte, err error) {
@@ -212,6 +256,38 @@ func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GrantQueueItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GrantQueueItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GrantQueueItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -251,6 +327,21 @@ func (m *Grant) Size() (n int) {
 	return n
 }
 
+func (m *GrantQueueItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovAuthz(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -458,6 +549,88 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GrantQueueItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GrantQueueItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GrantQueueItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthz(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -252,3 +252,78 @@ func (s *IntegrationTestSuite) TestQueryGranterGrants() {
 		})
 	}
 }
+
+func (s *IntegrationTestSuite) TestQueryGranteeGrants() {
+	val := s.network.Validators[0]
+	grantee := s.grantee[2]
+	require := s.Require()
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expectErr   bool
+		expectedErr string
+		expItems    int
+	}{
+		{
+			"invalid address",
+			[]string{
+				"invalid-address",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			true,
+			"decoding bech32 failed",
+			0,
+		},
+		{
+			"no authorization found",
+			[]string{
+				val.Address.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			0,
+		},
+		{
+			"valid case",
+			[]string{
+				grantee.String(),
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			1,
+		},
+		{
+			"valid case with pagination",
+			[]string{
+				grantee.String(),
+				"--limit=1",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			false,
+			"",
+			1,
+		},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetQueryGranteeGrants()
+			clientCtx := val.ClientCtx
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				require.Error(err)
+				require.Contains(out.String(), tc.expectedErr)
+			} else {
+				require.NoError(err)
+				var grants authz.QueryGranteeGrantsResponse
+				require.NoError(val.ClientCtx.Codec.UnmarshalJSON(out.Bytes(), &grants))
+				require.Len(grants.Grants, tc.expItems)
+				for _, grant := range grants.Grants {
+					require.Equal(tc.args[0], grant.Grantee)
+				}
+			}
+		})
+	}
+}
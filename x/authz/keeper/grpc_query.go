@@ -137,6 +137,47 @@ func (k Keeper) GranterGrants(c context.Context, req *authz.QueryGranterGrantsRe
 	}, nil
 }
 
+// GranteeGrants implements the Query/GranteeGrants gRPC method.
+func (k Keeper) GranteeGrants(c context.Context, req *authz.QueryGranteeGrantsRequest) (*authz.QueryGranteeGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	grantee, err := sdk.AccAddressFromBech32(req.Grantee)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(k.storeKey)
+	granteeStore := prefix.NewStore(store, granteeGrantStoreKey(grantee, nil, ""))
+
+	var grants []*authz.GrantAuthorization
+	pageRes, err := query.Paginate(granteeStore, req.Pagination, func(key []byte, _ []byte) error {
+		granter, msgType := parseGranteeGrantStoreKey(key)
+		grant, found := k.getGrant(ctx, grantStoreKey(grantee, granter, msgType))
+		if !found {
+			return status.Errorf(codes.Internal, "no grant found for the grantee index %X", key)
+		}
+
+		grants = append(grants, &authz.GrantAuthorization{
+			Granter:       granter.String(),
+			Grantee:       grantee.String(),
+			Authorization: grant.Authorization,
+			Expiration:    grant.Expiration,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &authz.QueryGranteeGrantsResponse{
+		Grants:     grants,
+		Pagination: pageRes,
+	}, nil
+}
+
 // unmarshal an authorization from a store value
 func unmarshalAuthorization(cdc codec.BinaryCodec, value []byte) (v authz.Grant, err error) {
 	err = cdc.Unmarshal(value, &v)
@@ -239,3 +239,84 @@ func (suite *TestSuite) TestGRPCQueryGranterGrants() {
 		})
 	}
 }
+
+func (suite *TestSuite) TestGRPCQueryGranteeGrants() {
+	require := suite.Require()
+	app, ctx, queryClient, addrs := suite.app, suite.ctx, suite.queryClient, suite.addrs
+
+	testCases := []struct {
+		msg      string
+		preRun   func()
+		expError bool
+		request  authz.QueryGranteeGrantsRequest
+		numItems int
+	}{
+		{
+			"fail invalid grantee addr",
+			func() {},
+			true,
+			authz.QueryGranteeGrantsRequest{},
+			0,
+		},
+		{
+			"valid case, single authorization",
+			func() {
+				now := ctx.BlockHeader().Time
+				newCoins := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+				authorization := &banktypes.SendAuthorization{SpendLimit: newCoins}
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[1], authorization, now.Add(time.Hour))
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee: addrs[0].String(),
+			},
+			1,
+		},
+		{
+			"valid case, multiple authorization",
+			func() {
+				now := ctx.BlockHeader().Time
+				authorization := authz.NewGenericAuthorization(bankSendAuthMsgType)
+				err := app.AuthzKeeper.SaveGrant(ctx, addrs[0], addrs[2], authorization, now.Add(time.Hour))
+				require.NoError(err)
+				// a grant to another grantee
+				err = app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[2], authorization, now.Add(time.Hour))
+				require.NoError(err)
+			},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee: addrs[0].String(),
+			},
+			2,
+		},
+		{
+			"valid case, pagination",
+			func() {},
+			false,
+			authz.QueryGranteeGrantsRequest{
+				Grantee: addrs[0].String(),
+				Pagination: &query.PageRequest{
+					Limit: 1,
+				},
+			},
+			1,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			tc.preRun()
+			result, err := queryClient.GranteeGrants(gocontext.Background(), &tc.request)
+			if tc.expError {
+				require.Error(err)
+			} else {
+				require.NoError(err)
+				require.Len(result.Grants, tc.numItems)
+				for _, grant := range result.Grants {
+					require.Equal(tc.request.Grantee, grant.Grantee)
+				}
+			}
+		})
+	}
+}
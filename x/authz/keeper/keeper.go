@@ -142,9 +142,23 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 		return err
 	}
 
+	msgType := authorization.MsgTypeURL()
+	skey := grantStoreKey(grantee, granter, msgType)
+	oldGrant, found := k.getGrant(ctx, skey)
+	if found && !oldGrant.Expiration.Equal(expiration) {
+		if err := k.removeFromGrantQueue(ctx, granter, grantee, msgType, oldGrant.Expiration); err != nil {
+			return err
+		}
+	}
+	if !found || !oldGrant.Expiration.Equal(expiration) {
+		if err := k.insertIntoGrantQueue(ctx, granter, grantee, msgType, expiration); err != nil {
+			return err
+		}
+	}
+
 	bz := k.cdc.MustMarshal(&grant)
-	skey := grantStoreKey(grantee, granter, authorization.MsgTypeURL())
 	store.Set(skey, bz)
+	store.Set(granteeGrantStoreKey(grantee, granter, msgType), []byte{0x01})
 	return ctx.EventManager().EmitTypedEvent(&authz.EventGrant{
 		MsgTypeUrl: authorization.MsgTypeURL(),
 		Granter:    granter.String(),
@@ -157,11 +171,15 @@ func (k Keeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, auth
 func (k Keeper) DeleteGrant(ctx sdk.Context, grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) error {
 	store := ctx.KVStore(k.storeKey)
 	skey := grantStoreKey(grantee, granter, msgType)
-	_, found := k.getGrant(ctx, skey)
+	grant, found := k.getGrant(ctx, skey)
 	if !found {
 		return sdkerrors.ErrNotFound.Wrap("authorization not found")
 	}
 	store.Delete(skey)
+	store.Delete(granteeGrantStoreKey(grantee, granter, msgType))
+	if err := k.removeFromGrantQueue(ctx, granter, grantee, msgType, grant.Expiration); err != nil {
+		return err
+	}
 	return ctx.EventManager().EmitTypedEvent(&authz.EventRevoke{
 		MsgTypeUrl: msgType,
 		Granter:    granter.String(),
@@ -217,6 +235,102 @@ func (k Keeper) IterateGrants(ctx sdk.Context,
 	}
 }
 
+// getGrantQueueItem returns the message types of the grants from granter to grantee
+// expiring at the given time.
+func (k Keeper) getGrantQueueItem(ctx sdk.Context, expiration time.Time, granter, grantee sdk.AccAddress) (authz.GrantQueueItem, error) {
+	var queueItem authz.GrantQueueItem
+	bz := ctx.KVStore(k.storeKey).Get(grantQueueKey(expiration, granter, grantee))
+	if bz == nil {
+		return queueItem, nil
+	}
+	err := k.cdc.Unmarshal(bz, &queueItem)
+	return queueItem, err
+}
+
+// setGrantQueueItem stores the grant queue item, deleting it when it has no message types left.
+func (k Keeper) setGrantQueueItem(ctx sdk.Context, expiration time.Time, granter, grantee sdk.AccAddress, queueItem authz.GrantQueueItem) error {
+	store := ctx.KVStore(k.storeKey)
+	key := grantQueueKey(expiration, granter, grantee)
+	if len(queueItem.MsgTypeUrls) == 0 {
+		store.Delete(key)
+		return nil
+	}
+
+	bz, err := k.cdc.Marshal(&queueItem)
+	if err != nil {
+		return err
+	}
+	store.Set(key, bz)
+	return nil
+}
+
+// insertIntoGrantQueue adds the grant to the expiration queue.
+func (k Keeper) insertIntoGrantQueue(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string, expiration time.Time) error {
+	queueItem, err := k.getGrantQueueItem(ctx, expiration, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	queueItem.MsgTypeUrls = append(queueItem.MsgTypeUrls, msgType)
+	return k.setGrantQueueItem(ctx, expiration, granter, grantee, queueItem)
+}
+
+// removeFromGrantQueue removes the grant from the expiration queue.
+func (k Keeper) removeFromGrantQueue(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string, expiration time.Time) error {
+	queueItem, err := k.getGrantQueueItem(ctx, expiration, granter, grantee)
+	if err != nil {
+		return err
+	}
+
+	msgTypes := queueItem.MsgTypeUrls[:0]
+	for _, typeURL := range queueItem.MsgTypeUrls {
+		if typeURL != msgType {
+			msgTypes = append(msgTypes, typeURL)
+		}
+	}
+	queueItem.MsgTypeUrls = msgTypes
+	return k.setGrantQueueItem(ctx, expiration, granter, grantee, queueItem)
+}
+
+// DequeueAndDeleteExpiredGrants removes at most limit grants which expired
+// before the block time from the store, oldest first. The remaining expired
+// grants are left in the queue to be removed by the next calls.
+func (k Keeper) DequeueAndDeleteExpiredGrants(ctx sdk.Context, limit int) error {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(GrantQueuePrefix, grantQueueTimePrefix(ctx.BlockTime()))
+	defer iter.Close()
+
+	var pruned int
+	for ; iter.Valid() && pruned < limit; iter.Next() {
+		var queueItem authz.GrantQueueItem
+		if err := k.cdc.Unmarshal(iter.Value(), &queueItem); err != nil {
+			return err
+		}
+
+		expiration, granter, grantee, err := parseGrantQueueKey(iter.Key())
+		if err != nil {
+			return err
+		}
+
+		n := len(queueItem.MsgTypeUrls)
+		if n > limit-pruned {
+			n = limit - pruned
+		}
+		for _, typeURL := range queueItem.MsgTypeUrls[:n] {
+			store.Delete(grantStoreKey(grantee, granter, typeURL))
+			store.Delete(granteeGrantStoreKey(grantee, granter, typeURL))
+		}
+		pruned += n
+
+		queueItem.MsgTypeUrls = queueItem.MsgTypeUrls[n:]
+		if err := k.setGrantQueueItem(ctx, expiration, granter, grantee, queueItem); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ExportGenesis returns a GenesisState for a given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *authz.GenesisState {
 	var entries []authz.GrantAuthorization
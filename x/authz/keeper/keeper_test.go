@@ -244,6 +244,46 @@ func (s *TestSuite) TestDispatchedEvents() {
 	}
 }
 
+func (s *TestSuite) TestDequeueExpiredGrants() {
+	require := s.Require()
+	app, ctx, addrs := s.app, s.ctx, s.addrs
+	now := ctx.BlockHeader().Time
+	sendAuthz := &banktypes.SendAuthorization{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("steak", 100))}
+	multiSendAuthz := authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}))
+
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], sendAuthz, now.Add(time.Hour)))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], multiSendAuthz, now.Add(time.Hour)))
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[2], addrs[0], sendAuthz, now.Add(2*time.Hour)))
+	// overwriting a grant moves it in the expiration queue
+	require.NoError(app.AuthzKeeper.SaveGrant(ctx, addrs[1], addrs[0], sendAuthz, now.Add(3*time.Hour)))
+
+	countGrants := func() int {
+		var n int
+		app.AuthzKeeper.IterateGrants(ctx, func(_, _ sdk.AccAddress, _ authz.Grant) bool {
+			n++
+			return false
+		})
+		return n
+	}
+
+	ctx = ctx.WithBlockTime(now.Add(90 * time.Minute))
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 10))
+	require.Equal(2, countGrants())
+	require.Len(app.AuthzKeeper.GetAuthorizations(ctx, addrs[1], addrs[0]), 1)
+
+	// the expired grants are pruned in batches of at most limit grants
+	ctx = ctx.WithBlockTime(now.Add(4 * time.Hour))
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 1))
+	require.Equal(1, countGrants())
+	require.Empty(app.AuthzKeeper.GetAuthorizations(ctx, addrs[2], addrs[0]))
+	require.NoError(app.AuthzKeeper.DequeueAndDeleteExpiredGrants(ctx, 1))
+	require.Equal(0, countGrants())
+
+	res, err := app.AuthzKeeper.GranteeGrants(sdk.WrapSDKContext(ctx), &authz.QueryGranteeGrantsRequest{Grantee: addrs[1].String()})
+	require.NoError(err)
+	require.Empty(res.Grants)
+}
+
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
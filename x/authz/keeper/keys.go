@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/internal/conv"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -10,7 +12,9 @@ import (
 
 // Keys for store prefixes
 var (
-	GrantKey = []byte{0x01} // prefix for each key
+	GrantKey         = []byte{0x01} // prefix for each key
+	GrantQueuePrefix = []byte{0x02} // prefix for the grants expiration queue
+	GranteeGrantKey  = []byte{0x03} // prefix for the grants indexed by grantee
 )
 
 // StoreKey is the store key string for authz
@@ -49,3 +53,70 @@ func addressesFromGrantStoreKey(key []byte) (granterAddr, granteeAddr sdk.AccAdd
 
 	return granterAddr, granteeAddr
 }
+
+// granteeGrantStoreKey - return the grantee index store key of a grant
+// Items are stored with the following key: values
+//
+// - 0x03<granteeAddressLen (1 Byte)><granteeAddress_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>: []byte{0x01}
+func granteeGrantStoreKey(grantee sdk.AccAddress, granter sdk.AccAddress, msgType string) []byte {
+	m := conv.UnsafeStrToBytes(msgType)
+	grantee = address.MustLengthPrefix(grantee)
+	granter = address.MustLengthPrefix(granter)
+
+	key := make([]byte, 0, 1+len(grantee)+len(granter)+len(m))
+	key = append(key, GranteeGrantKey...)
+	key = append(key, grantee...)
+	key = append(key, granter...)
+	return append(key, m...)
+}
+
+// parseGranteeGrantStoreKey - split the granter & msgType from the grantee index key
+// prefixed by the grantee, i.e. <granterAddressLen (1 Byte)><granterAddress_Bytes><msgType_Bytes>
+func parseGranteeGrantStoreKey(key []byte) (granterAddr sdk.AccAddress, msgType string) {
+	kv.AssertKeyAtLeastLength(key, 1)
+	granterAddrLen := int(key[0])
+	kv.AssertKeyAtLeastLength(key, 1+granterAddrLen)
+	granterAddr = sdk.AccAddress(key[1 : 1+granterAddrLen])
+	return granterAddr, string(key[1+granterAddrLen:])
+}
+
+// grantQueueKey - return the grant expiration queue store key
+// Items are stored with the following key: values
+//
+// - 0x02<expiration_Bytes><granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes>: GrantQueueItem
+func grantQueueKey(expiration time.Time, granter sdk.AccAddress, grantee sdk.AccAddress) []byte {
+	exp := sdk.FormatTimeBytes(expiration)
+	granter = address.MustLengthPrefix(granter)
+	grantee = address.MustLengthPrefix(grantee)
+
+	key := make([]byte, 0, 1+len(exp)+len(granter)+len(grantee))
+	key = append(key, GrantQueuePrefix...)
+	key = append(key, exp...)
+	key = append(key, granter...)
+	return append(key, grantee...)
+}
+
+// grantQueueTimePrefix - return the prefix of the grants expiring at the given time
+func grantQueueTimePrefix(expiration time.Time) []byte {
+	return append(append([]byte{}, GrantQueuePrefix...), sdk.FormatTimeBytes(expiration)...)
+}
+
+// parseGrantQueueKey - split the expiration, granter & grantee from the grant queue key
+func parseGrantQueueKey(key []byte) (expiration time.Time, granterAddr, granteeAddr sdk.AccAddress, err error) {
+	lenTime := len(sdk.FormatTimeBytes(time.Time{}))
+	kv.AssertKeyAtLeastLength(key, 1+lenTime+1)
+	expiration, err = sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		return expiration, nil, nil, err
+	}
+
+	addrs := key[1+lenTime:]
+	granterAddrLen := int(addrs[0])
+	kv.AssertKeyAtLeastLength(addrs, 2+granterAddrLen)
+	granterAddr = sdk.AccAddress(addrs[1 : 1+granterAddrLen])
+	granteeAddrLen := int(addrs[1+granterAddrLen])
+	kv.AssertKeyAtLeastLength(addrs, 2+granterAddrLen+granteeAddrLen)
+	granteeAddr = sdk.AccAddress(addrs[2+granterAddrLen : 2+granterAddrLen+granteeAddrLen])
+
+	return expiration, granterAddr, granteeAddr, nil
+}
@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -24,3 +26,27 @@ func TestGrantkey(t *testing.T) {
 	require.Equal(granter, granter1)
 	require.Equal(grantee, grantee1)
 }
+
+func TestGrantQueueKey(t *testing.T) {
+	require := require.New(t)
+	expiration := time.Now().UTC().Round(0)
+	key := grantQueueKey(expiration, granter, grantee)
+	require.True(bytes.HasPrefix(key, grantQueueTimePrefix(expiration)))
+
+	exp, granter1, grantee1, err := parseGrantQueueKey(key)
+	require.NoError(err)
+	require.Equal(expiration, exp)
+	require.Equal(granter, granter1)
+	require.Equal(grantee, grantee1)
+}
+
+func TestGranteeGrantKey(t *testing.T) {
+	require := require.New(t)
+	key := granteeGrantStoreKey(grantee, granter, msgType)
+	prefix := granteeGrantStoreKey(grantee, nil, "")
+	require.True(bytes.HasPrefix(key, prefix))
+
+	granter1, msgType1 := parseGranteeGrantStoreKey(key[len(prefix):])
+	require.Equal(granter, granter1)
+	require.Equal(msgType, msgType1)
+}
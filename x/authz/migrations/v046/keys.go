@@ -0,0 +1,46 @@
+package v046
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	GrantPrefix        = []byte{0x01}
+	GrantQueuePrefix   = []byte{0x02}
+	GranteeGrantPrefix = []byte{0x03}
+)
+
+// ParseGrantKey splits the granter, grantee and message type from a grant key
+// of the format 0x01<granterAddressLen (1 Byte)><granterAddress_Bytes><granteeAddressLen (1 Byte)><granteeAddress_Bytes><msgType_Bytes>.
+func ParseGrantKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress, msgType string) {
+	kv.AssertKeyAtLeastLength(key, 2)
+	granterAddrLen := int(key[1])
+	kv.AssertKeyAtLeastLength(key, 3+granterAddrLen)
+	granterAddr = sdk.AccAddress(key[2 : 2+granterAddrLen])
+	granteeAddrLen := int(key[2+granterAddrLen])
+	kv.AssertKeyAtLeastLength(key, 3+granterAddrLen+granteeAddrLen)
+	granteeAddr = sdk.AccAddress(key[3+granterAddrLen : 3+granterAddrLen+granteeAddrLen])
+
+	return granterAddr, granteeAddr, string(key[3+granterAddrLen+granteeAddrLen:])
+}
+
+// GrantQueueKey returns the key of the grants from granter to grantee expiring
+// at the given time in the expiration queue.
+func GrantQueueKey(expiration time.Time, granter, grantee sdk.AccAddress) []byte {
+	key := append([]byte{}, GrantQueuePrefix...)
+	key = append(key, sdk.FormatTimeBytes(expiration)...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	return append(key, address.MustLengthPrefix(grantee)...)
+}
+
+// GranteeGrantKey returns the key of a grant in the grantee index.
+func GranteeGrantKey(grantee, granter sdk.AccAddress, msgType string) []byte {
+	key := append([]byte{}, GranteeGrantPrefix...)
+	key = append(key, address.MustLengthPrefix(grantee)...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	return append(key, msgType...)
+}
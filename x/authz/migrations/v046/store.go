@@ -0,0 +1,57 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Delete the grants which already expired.
+// - Add the remaining grants to the expiration queue.
+// - Add an index of the grants by grantee.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	iter := sdk.KVStorePrefixIterator(store, GrantPrefix)
+	defer iter.Close()
+
+	var expiredKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		var grant authz.Grant
+		if err := cdc.Unmarshal(iter.Value(), &grant); err != nil {
+			return err
+		}
+
+		if grant.Expiration.Before(ctx.BlockTime()) {
+			expiredKeys = append(expiredKeys, append([]byte{}, iter.Key()...))
+			continue
+		}
+
+		granter, grantee, msgType := ParseGrantKey(iter.Key())
+		store.Set(GranteeGrantKey(grantee, granter, msgType), []byte{0x01})
+
+		queueKey := GrantQueueKey(grant.Expiration, granter, grantee)
+		var queueItem authz.GrantQueueItem
+		if bz := store.Get(queueKey); bz != nil {
+			if err := cdc.Unmarshal(bz, &queueItem); err != nil {
+				return err
+			}
+		}
+		queueItem.MsgTypeUrls = append(queueItem.MsgTypeUrls, msgType)
+		bz, err := cdc.Marshal(&queueItem)
+		if err != nil {
+			return err
+		}
+		store.Set(queueKey, bz)
+	}
+
+	for _, key := range expiredKeys {
+		store.Delete(key)
+	}
+
+	return nil
+}
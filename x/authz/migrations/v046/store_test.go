@@ -0,0 +1,67 @@
+package v046_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	v046 "github.com/cosmos/cosmos-sdk/x/authz/migrations/v046"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func grantKey(granter, grantee sdk.AccAddress, msgType string) []byte {
+	key := append([]byte{}, v046.GrantPrefix...)
+	key = append(key, address.MustLengthPrefix(granter)...)
+	key = append(key, address.MustLengthPrefix(grantee)...)
+	return append(key, msgType...)
+}
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	authzKey := sdk.NewKVStoreKey("authz")
+	ctx := testutil.DefaultContext(authzKey, sdk.NewTransientStoreKey("transient_test"))
+	now := time.Now().UTC().Round(0)
+	ctx = ctx.WithBlockTime(now)
+	store := ctx.KVStore(authzKey)
+
+	granter := sdk.AccAddress([]byte("granter_____________"))
+	grantee := sdk.AccAddress([]byte("grantee_____________"))
+	sendMsgType := banktypes.SendAuthorization{}.MsgTypeURL()
+	multiSendMsgType := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+	expiration := now.Add(time.Hour)
+
+	grants := []struct {
+		msgType    string
+		expiration time.Time
+	}{
+		{sendMsgType, expiration},
+		{multiSendMsgType, expiration},
+		{"expired", now.Add(-time.Hour)},
+	}
+	for _, g := range grants {
+		grant, err := authz.NewGrant(now.Add(-2*time.Hour), authz.NewGenericAuthorization(g.msgType), g.expiration)
+		require.NoError(t, err)
+		bz, err := encCfg.Codec.Marshal(&grant)
+		require.NoError(t, err)
+		store.Set(grantKey(granter, grantee, g.msgType), bz)
+	}
+
+	require.NoError(t, v046.MigrateStore(ctx, authzKey, encCfg.Codec))
+
+	require.Nil(t, store.Get(grantKey(granter, grantee, "expired")))
+	require.Nil(t, store.Get(v046.GranteeGrantKey(grantee, granter, "expired")))
+	require.NotNil(t, store.Get(v046.GranteeGrantKey(grantee, granter, sendMsgType)))
+	require.NotNil(t, store.Get(v046.GranteeGrantKey(grantee, granter, multiSendMsgType)))
+
+	var queueItem authz.GrantQueueItem
+	bz := store.Get(v046.GrantQueueKey(expiration, granter, grantee))
+	require.NotNil(t, bz)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &queueItem))
+	require.ElementsMatch(t, []string{sendMsgType, multiSendMsgType}, queueItem.MsgTypeUrls)
+}
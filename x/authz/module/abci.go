@@ -0,0 +1,17 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz/keeper"
+)
+
+// maxPrunedGrants is the maximum number of expired grants removed at the end of
+// a block, the remaining ones are removed in the following blocks.
+const maxPrunedGrants = 200
+
+// EndBlocker removes the expired grants from the store.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if err := k.DequeueAndDeleteExpiredGrants(ctx, maxPrunedGrants); err != nil {
+		panic(err)
+	}
+}
@@ -24,9 +24,14 @@ func TestDecodeStore(t *testing.T) {
 	grant, _ := authz.NewGrant(now, banktypes.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin("foo", 123))), now.Add(1))
 	grantBz, err := cdc.Marshal(&grant)
 	require.NoError(t, err)
+	queueItem := authz.GrantQueueItem{MsgTypeUrls: []string{banktypes.SendAuthorization{}.MsgTypeURL()}}
+	queueItemBz, err := cdc.Marshal(&queueItem)
+	require.NoError(t, err)
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(keeper.GrantKey), Value: grantBz},
+			{Key: []byte(keeper.GrantQueuePrefix), Value: queueItemBz},
+			{Key: []byte(keeper.GranteeGrantKey), Value: []byte{0x01}},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -37,6 +42,8 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Grant", false, fmt.Sprintf("%v\n%v", grant, grant)},
+		{"GrantQueueItem", false, fmt.Sprintf("%v\n%v", queueItem, queueItem)},
+		{"GranteeGrant", false, fmt.Sprintf("%v\n%v", []byte{0x01}, []byte{0x01})},
 		{"other", true, ""},
 	}
 
@@ -33,6 +33,9 @@ func GetQueryCmd() *cobra.Command {
 		GetBalancesCmd(),
 		GetCmdQueryTotalSupply(),
 		GetCmdDenomsMetadata(),
+		GetCmdQuerySendEnabled(),
+		GetCmdQueryFrozenAddresses(),
+		GetCmdQueryFreezeStatus(),
 	)
 
 	return cmd
@@ -212,3 +215,141 @@ To query for the total supply of a specific coin denomination use:
 
 	return cmd
 }
+
+// GetCmdQuerySendEnabled defines the cobra command to query the SendEnabled entries.
+func GetCmdQuerySendEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-enabled [denom1 ...]",
+		Short: "Query for send enabled entries",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for send enabled entries that have been specifically set.
+
+To look up one or more specific denoms, supply them as arguments to this command.
+To look up all denoms, do not provide any arguments.
+
+Example:
+  $ %s query %s send-enabled
+
+To query for the send enabled entries of specific coin denominations use:
+  $ %s query %s send-enabled foo bar
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.SendEnabled(cmd.Context(), &types.QuerySendEnabledRequest{
+				Denoms:     args,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "send enabled entries")
+
+	return cmd
+}
+
+// GetCmdQueryFrozenAddresses defines the cobra command to query the frozen
+// addresses of a denom.
+func GetCmdQueryFrozenAddresses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "frozen-addresses [denom]",
+		Short: "Query for the frozen addresses of a denom",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the addresses whose holdings of a denom are frozen by its admin, and
+whether all the transfers of the denom are frozen.
+
+Example:
+  $ %s query %s frozen-addresses [denom]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FrozenAddresses(cmd.Context(), &types.QueryFrozenAddressesRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "frozen addresses")
+
+	return cmd
+}
+
+// GetCmdQueryFreezeStatus defines the cobra command to query whether the
+// holdings of a denom of an address are frozen.
+func GetCmdQueryFreezeStatus() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-status [denom] [address]",
+		Short: "Query whether the holdings of a denom of an address are frozen",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query whether the holdings of a denom of an address are frozen by its admin, and
+whether all the transfers of the denom are frozen.
+
+Example:
+  $ %s query %s freeze-status [denom] [address]
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FreezeStatus(cmd.Context(), &types.QueryFreezeStatusRequest{
+				Denom:   args[0],
+				Address: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
+var frozenAddr = sdk.AccAddress("frozen______________")
+
 type IntegrationTestSuite struct {
 	suite.Suite
 
@@ -75,6 +77,15 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		},
 	}
 
+	bankGenesis.SendEnabled = []types.SendEnabled{
+		{Denom: "uatom", Enabled: true},
+		{Denom: "wei", Enabled: true},
+	}
+
+	bankGenesis.DenomFreezes = []types.DenomFreeze{
+		{Denom: "wei", FrozenAddresses: []string{frozenAddr.String()}},
+	}
+
 	bankGenesisBz, err := s.cfg.Codec.MarshalJSON(&bankGenesis)
 	s.Require().NoError(err)
 	genesisState[types.ModuleName] = bankGenesisBz
@@ -235,6 +246,113 @@ func (s *IntegrationTestSuite) TestGetCmdQueryTotalSupply() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQuerySendEnabled() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected proto.Message
+	}{
+		{
+			name: "all send enabled entries",
+			args: []string{
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("uatom", true),
+					types.NewSendEnabled("wei", true),
+				},
+				Pagination: &query.PageResponse{},
+			},
+		},
+		{
+			name: "send enabled entries of specific denominations",
+			args: []string{
+				"wei",
+				"foobar",
+				fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+			},
+			expected: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					types.NewSendEnabled("wei", true),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySendEnabled()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var resp types.QuerySendEnabledResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			s.Require().Equal(tc.expected, &resp)
+		})
+	}
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryFrozenAddresses() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryFrozenAddresses(), []string{
+		"wei",
+		fmt.Sprintf("--%s=json", tmcli.OutputFlag),
+	})
+	s.Require().NoError(err)
+
+	var resp types.QueryFrozenAddressesResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+	s.Require().Equal(&types.QueryFrozenAddressesResponse{
+		Addresses:  []string{frozenAddr.String()},
+		Pagination: &query.PageResponse{},
+	}, &resp)
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryFreezeStatus() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name     string
+		args     []string
+		expected *types.QueryFreezeStatusResponse
+	}{
+		{
+			name:     "frozen address",
+			args:     []string{"wei", frozenAddr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			expected: &types.QueryFreezeStatusResponse{Frozen: true},
+		},
+		{
+			name:     "address not frozen",
+			args:     []string{"uatom", frozenAddr.String(), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			expected: &types.QueryFreezeStatusResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryFreezeStatus(), tc.args)
+			s.Require().NoError(err)
+
+			var resp types.QueryFreezeStatusResponse
+			s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &resp))
+			s.Require().Equal(tc.expected, &resp)
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryDenomsMetadata() {
 	val := s.network.Validators[0]
 
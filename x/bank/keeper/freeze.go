@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// IsFrozen returns true if the holdings of the denom of the address are frozen.
+// It does not take the global freeze of the denom into account.
+func (k BaseSendKeeper) IsFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CreateFrozenKey(denom, addr))
+}
+
+// SetFrozen freezes or unfreezes the holdings of the denom of the address.
+// Module accounts and blocked addresses can't be frozen.
+func (k BaseSendKeeper) SetFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress, frozen bool) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.CreateFrozenKey(denom, addr)
+	if !frozen {
+		store.Delete(key)
+		return nil
+	}
+
+	if k.BlockedAddr(addr) || k.isModuleAccount(ctx, addr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account or a blocked address", addr)
+	}
+
+	store.Set(key, []byte{0})
+	return nil
+}
+
+// IsGloballyFrozen returns true if all the transfers of the denom are frozen.
+func (k BaseSendKeeper) IsGloballyFrozen(ctx sdk.Context, denom string) bool {
+	return ctx.KVStore(k.storeKey).Has(types.CreateGlobalFreezeKey(denom))
+}
+
+// SetGlobalFreeze freezes or unfreezes all the transfers of the denom.
+func (k BaseSendKeeper) SetGlobalFreeze(ctx sdk.Context, denom string, frozen bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.CreateGlobalFreezeKey(denom)
+	if frozen {
+		store.Set(key, []byte{0})
+	} else {
+		store.Delete(key)
+	}
+}
+
+// IterateFrozenAddresses iterates over the frozen addresses of the denom and
+// performs a callback function.
+func (k BaseSendKeeper) IterateFrozenAddresses(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateFrozenDenomPrefix(denom))
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		addr, err := types.AddressFromFrozenDenomStore(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(addr) {
+			break
+		}
+	}
+}
+
+// GetAllDenomFreezes returns the frozen addresses and the global freezes of all
+// the denoms.
+func (k BaseSendKeeper) GetAllDenomFreezes(ctx sdk.Context) []types.DenomFreeze {
+	store := ctx.KVStore(k.storeKey)
+	freezes := make(map[string]*types.DenomFreeze)
+	var denoms []string
+
+	getFreeze := func(denom string) *types.DenomFreeze {
+		freeze, ok := freezes[denom]
+		if !ok {
+			freeze = &types.DenomFreeze{Denom: denom}
+			freezes[denom] = freeze
+			denoms = append(denoms, denom)
+		}
+		return freeze
+	}
+
+	globalIter := sdk.KVStorePrefixIterator(store, types.GlobalFreezePrefix)
+	defer globalIter.Close()
+
+	for ; globalIter.Valid(); globalIter.Next() {
+		denom := string(globalIter.Key()[len(types.GlobalFreezePrefix):])
+		getFreeze(denom).GlobalFrozen = true
+	}
+
+	frozenIter := sdk.KVStorePrefixIterator(store, types.FrozenPrefix)
+	defer frozenIter.Close()
+
+	for ; frozenIter.Valid(); frozenIter.Next() {
+		key := frozenIter.Key()[len(types.FrozenPrefix):]
+		// the denom is terminated by a null byte, followed by the length
+		// prefixed address
+		var i int
+		for i < len(key) && key[i] != 0 {
+			i++
+		}
+		if i == len(key) {
+			panic(types.ErrInvalidKey)
+		}
+
+		addr, err := types.AddressFromFrozenDenomStore(key[i+1:])
+		if err != nil {
+			panic(err)
+		}
+
+		freeze := getFreeze(string(key[:i]))
+		freeze.FrozenAddresses = append(freeze.FrozenAddresses, addr.String())
+	}
+
+	sort.Strings(denoms)
+	result := make([]types.DenomFreeze, 0, len(denoms))
+	for _, denom := range denoms {
+		result = append(result, *freezes[denom])
+	}
+
+	return result
+}
+
+// ForceTransfer moves amt coins from fromAddr to toAddr on behalf of the admin
+// of the denoms. Unlike SendCoins, it ignores the send restriction, the
+// BeforeSend hook, the SendEnabled flags and the freezes, so that the admin can
+// claw back the holdings of frozen accounts. Only the spendable coins of
+// fromAddr can be transferred, and the coins of module accounts and blocked
+// addresses can't be moved. The AfterSend hook is still called.
+func (k BaseSendKeeper) ForceTransfer(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.BlockedAddr(fromAddr) || k.isModuleAccount(ctx, fromAddr) {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is a module account or a blocked address", fromAddr)
+	}
+
+	if err := k.subUnlockedCoins(ctx, fromAddr, amt); err != nil {
+		return err
+	}
+
+	if err := k.addCoins(ctx, toAddr, amt); err != nil {
+		return err
+	}
+
+	// Create account if recipient does not exist.
+	accExists := k.ak.HasAccount(ctx, toAddr)
+	if !accExists {
+		defer telemetry.IncrCounter(1, "new", "account")
+		k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, toAddr))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySender, fromAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+	)
+
+	return k.afterSend(ctx, fromAddr, toAddr, amt)
+}
+
+// checkFrozen returns an error if any of the coins are globally frozen, or
+// frozen for any of the addresses. The freezes don't apply to module accounts,
+// so that the transfers between modules and the fees and deposits paid to them
+// keep working.
+func (k BaseSendKeeper) checkFrozen(ctx sdk.Context, amt sdk.Coins, addrs ...sdk.AccAddress) error {
+	for _, coin := range amt {
+		globallyFrozen := k.IsGloballyFrozen(ctx, coin.Denom)
+
+		for _, addr := range addrs {
+			if !globallyFrozen && !k.IsFrozen(ctx, coin.Denom, addr) {
+				continue
+			}
+			if k.isModuleAccount(ctx, addr) {
+				continue
+			}
+
+			if globallyFrozen {
+				return sdkerrors.Wrapf(types.ErrFrozen, "%s transfers are globally frozen", coin.Denom)
+			}
+			return sdkerrors.Wrapf(types.ErrFrozen, "%s holdings of %s are frozen", coin.Denom, addr)
+		}
+	}
+
+	return nil
+}
+
+// checkSendFrozen returns an error if any of the coins are frozen for a
+// transfer from fromAddr to toAddr. The receiver isn't checked when the sender
+// is a module account, so that the module payouts, e.g. the deposit refunds in
+// the gov EndBlocker, can't be blocked by freezing the receiver.
+func (k BaseSendKeeper) checkSendFrozen(ctx sdk.Context, amt sdk.Coins, fromAddr, toAddr sdk.AccAddress) error {
+	err := k.checkFrozen(ctx, amt, fromAddr, toAddr)
+	// The freezes are already skipped for a module account sender, so the
+	// error can only come from the receiver in that case.
+	if err != nil && k.isModuleAccount(ctx, fromAddr) {
+		return nil
+	}
+
+	return err
+}
+
+// isModuleAccount returns true if the address is the address of a module account.
+func (k BaseSendKeeper) isModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	_, ok := k.ak.GetAccount(ctx, addr).(authtypes.ModuleAccountI)
+	return ok
+}
@@ -12,6 +12,10 @@ import (
 func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 
+	for _, se := range genState.SendEnabled {
+		k.SetSendEnabled(ctx, se.Denom, se.Enabled)
+	}
+
 	totalSupply := sdk.Coins{}
 	genState.Balances = types.SanitizeGenesisBalances(genState.Balances)
 
@@ -39,6 +43,21 @@ func (k BaseKeeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	for _, meta := range genState.DenomMetadata {
 		k.SetDenomMetaData(ctx, meta)
 	}
+
+	for _, freeze := range genState.DenomFreezes {
+		k.SetGlobalFreeze(ctx, freeze.Denom, freeze.GlobalFrozen)
+
+		for _, addrStr := range freeze.FrozenAddresses {
+			addr, err := sdk.AccAddressFromBech32(addrStr)
+			if err != nil {
+				panic(err)
+			}
+
+			if err := k.SetFrozen(ctx, freeze.Denom, addr, true); err != nil {
+				panic(err)
+			}
+		}
+	}
 }
 
 // ExportGenesis returns the bank module's genesis state.
@@ -48,10 +67,14 @@ func (k BaseKeeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(fmt.Errorf("unable to fetch total supply %v", err))
 	}
 
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetParams(ctx),
 		k.GetAccountsBalances(ctx),
 		totalSupply,
 		k.GetAllDenomMetaData(ctx),
+		k.GetAllSendEnabledEntries(ctx),
 	)
+	genState.DenomFreezes = k.GetAllDenomFreezes(ctx)
+
+	return genState
 }
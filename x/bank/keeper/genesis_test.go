@@ -33,6 +33,7 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 			NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, accAddr, expectedBalances[i].Coins))
 	}
 	app.BankKeeper.SetParams(ctx, types.DefaultParams())
+	app.BankKeeper.SetSendEnabled(ctx, "testcoin1", false)
 
 	exportGenesis := app.BankKeeper.ExportGenesis(ctx)
 
@@ -41,6 +42,7 @@ func (suite *IntegrationTestSuite) TestExportGenesis() {
 	suite.Require().Equal(expTotalSupply, exportGenesis.Supply)
 	suite.Require().Subset(exportGenesis.Balances, expectedBalances)
 	suite.Require().Equal(expectedMetadata, exportGenesis.DenomMetadata)
+	suite.Require().Equal([]types.SendEnabled{{Denom: "testcoin1", Enabled: false}}, exportGenesis.SendEnabled)
 }
 
 func (suite *IntegrationTestSuite) getTestBalancesAndSupply() ([]types.Balance, sdk.Coins) {
@@ -70,6 +72,40 @@ func (suite *IntegrationTestSuite) TestInitGenesis() {
 	suite.Require().Equal(m, m2)
 }
 
+func (suite *IntegrationTestSuite) TestInitGenesisSendEnabled() {
+	g := types.DefaultGenesisState()
+	g.Params = types.NewParams(true, types.SendEnabledParams{types.NewSendEnabled("foo", false)})
+	g.SendEnabled = []types.SendEnabled{{Denom: "bar", Enabled: false}}
+	bk := suite.app.BankKeeper
+	bk.InitGenesis(suite.ctx, g)
+
+	// the entries of the params are moved out of the params
+	suite.Require().Empty(bk.GetParams(suite.ctx).SendEnabled)
+	suite.Require().True(bk.GetParams(suite.ctx).DefaultSendEnabled)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "bar", Enabled: false},
+		{Denom: "foo", Enabled: false},
+	}, bk.GetAllSendEnabledEntries(suite.ctx))
+}
+
+func (suite *IntegrationTestSuite) TestInitGenesisDenomFreezes() {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	g := types.DefaultGenesisState()
+	g.DenomFreezes = []types.DenomFreeze{
+		{Denom: "bar", GlobalFrozen: true},
+		{Denom: "foo", FrozenAddresses: []string{addr1.String(), addr2.String()}},
+	}
+	bk := suite.app.BankKeeper
+	bk.InitGenesis(suite.ctx, g)
+
+	suite.Require().True(bk.IsGloballyFrozen(suite.ctx, "bar"))
+	suite.Require().True(bk.IsFrozen(suite.ctx, "foo", addr1))
+	suite.Require().False(bk.IsFrozen(suite.ctx, "bar", addr1))
+	suite.Require().Equal(g.DenomFreezes, bk.ExportGenesis(suite.ctx).DenomFreezes)
+}
+
 func (suite *IntegrationTestSuite) TestTotalSupply() {
 	// Prepare some test data.
 	defaultGenesis := types.DefaultGenesisState()
@@ -92,17 +128,17 @@ func (suite *IntegrationTestSuite) TestTotalSupply() {
 	}{
 		{
 			"calculation NOT matching genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, sdk.NewCoins(sdk.NewCoin("wrongcoin", sdk.NewInt(1))), defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			nil, true, "genesis supply is incorrect, expected 1wrongcoin, got 21barcoin,11foocoin",
 		},
 		{
 			"calculation matches genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, totalSupply, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 		{
 			"calculation is correct, empty genesis Supply field",
-			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata),
+			types.NewGenesisState(defaultGenesis.Params, balances, nil, defaultGenesis.DenomMetadata, defaultGenesis.SendEnabled),
 			totalSupply, false, "",
 		},
 	}
@@ -210,3 +210,89 @@ func (k BaseKeeper) DenomOwners(
 
 	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
+
+// SendEnabled implements the Query/SendEnabled gRPC method
+func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	resp := &types.QuerySendEnabledResponse{}
+	if len(req.Denoms) > 0 {
+		for _, denom := range req.Denoms {
+			if se, ok := k.GetSendEnabledEntry(ctx, denom); ok {
+				resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(se.Denom, se.Enabled))
+			}
+		}
+		return resp, nil
+	}
+
+	var err error
+	resp.Pagination, err = query.Paginate(k.getSendEnabledPrefixStore(ctx), req.Pagination, func(key []byte, value []byte) error {
+		resp.SendEnabled = append(resp.SendEnabled, types.NewSendEnabled(string(key), isTrueB(value)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return resp, nil
+}
+
+// FrozenAddresses implements the Query/FrozenAddresses gRPC method.
+func (k BaseKeeper) FrozenAddresses(goCtx context.Context, req *types.QueryFrozenAddressesRequest) (*types.QueryFrozenAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.CreateFrozenDenomPrefix(req.Denom))
+
+	var addresses []string
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		addr, err := types.AddressFromFrozenDenomStore(key)
+		if err != nil {
+			return err
+		}
+
+		addresses = append(addresses, addr.String())
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFrozenAddressesResponse{
+		Addresses:    addresses,
+		GlobalFrozen: k.IsGloballyFrozen(ctx, req.Denom),
+		Pagination:   pageRes,
+	}, nil
+}
+
+// FreezeStatus implements the Query/FreezeStatus gRPC method.
+func (k BaseKeeper) FreezeStatus(goCtx context.Context, req *types.QueryFreezeStatusRequest) (*types.QueryFreezeStatusResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryFreezeStatusResponse{
+		Frozen:       k.IsFrozen(ctx, req.Denom, addr),
+		GlobalFrozen: k.IsGloballyFrozen(ctx, req.Denom),
+	}, nil
+}
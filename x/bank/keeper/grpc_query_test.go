@@ -400,3 +400,90 @@ func (suite *IntegrationTestSuite) TestGRPCDenomOwners() {
 
 	suite.Require().True(true)
 }
+
+func (suite *IntegrationTestSuite) TestQuerySendEnabled() {
+	ctx, bankKeeper := suite.ctx, suite.app.BankKeeper
+
+	bankKeeper.SetSendEnabled(ctx, "falsestcoin", false)
+	bankKeeper.SetSendEnabled(ctx, "truestcoin", true)
+
+	tests := []struct {
+		name string
+		req  *types.QuerySendEnabledRequest
+		exp  *types.QuerySendEnabledResponse
+	}{
+		{
+			name: "nil denoms list",
+			req:  &types.QuerySendEnabledRequest{Denoms: []string{}},
+			exp: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					{Denom: "falsestcoin", Enabled: false},
+					{Denom: "truestcoin", Enabled: true},
+				},
+				Pagination: &query.PageResponse{NextKey: nil, Total: 2},
+			},
+		},
+		{
+			name: "paginated",
+			req:  &types.QuerySendEnabledRequest{Pagination: &query.PageRequest{Limit: 1}},
+			exp: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					{Denom: "falsestcoin", Enabled: false},
+				},
+				Pagination: &query.PageResponse{NextKey: []byte("truestcoin"), Total: 0},
+			},
+		},
+		{
+			name: "specific denoms",
+			req:  &types.QuerySendEnabledRequest{Denoms: []string{"truestcoin", "unknowncoin"}},
+			exp: &types.QuerySendEnabledResponse{
+				SendEnabled: []*types.SendEnabled{
+					{Denom: "truestcoin", Enabled: true},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		suite.Run(tc.name, func() {
+			resp, err := suite.queryClient.SendEnabled(gocontext.Background(), tc.req)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.exp, resp)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryFrozenAddresses() {
+	ctx, bankKeeper := suite.ctx, suite.app.BankKeeper
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	suite.Require().NoError(bankKeeper.SetFrozen(ctx, fooDenom, addr1, true))
+	suite.Require().NoError(bankKeeper.SetFrozen(ctx, fooDenom, addr2, true))
+	bankKeeper.SetGlobalFreeze(ctx, barDenom, true)
+
+	_, err := suite.queryClient.FrozenAddresses(gocontext.Background(), &types.QueryFrozenAddressesRequest{})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.FrozenAddresses(gocontext.Background(), &types.QueryFrozenAddressesRequest{Denom: fooDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{addr1.String(), addr2.String()}, res.Addresses)
+	suite.Require().False(res.GlobalFrozen)
+	suite.Require().Equal(uint64(2), res.Pagination.Total)
+
+	res, err = suite.queryClient.FrozenAddresses(gocontext.Background(), &types.QueryFrozenAddressesRequest{Denom: barDenom})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Addresses)
+	suite.Require().True(res.GlobalFrozen)
+
+	status, err := suite.queryClient.FreezeStatus(gocontext.Background(), &types.QueryFreezeStatusRequest{Denom: fooDenom, Address: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryFreezeStatusResponse{Frozen: true, GlobalFrozen: false}, status)
+
+	status, err = suite.queryClient.FreezeStatus(gocontext.Background(), &types.QueryFreezeStatusRequest{Denom: barDenom, Address: addr1.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryFreezeStatusResponse{Frozen: false, GlobalFrozen: true}, status)
+
+	_, err = suite.queryClient.FreezeStatus(gocontext.Background(), &types.QueryFreezeStatusRequest{Denom: fooDenom, Address: "invalid"})
+	suite.Require().Error(err)
+}
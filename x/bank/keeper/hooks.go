@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// sendHooks holds the send restriction and transfer hooks registered on a
+// BaseSendKeeper. It is referenced by pointer so that every copy of the keeper
+// handed to other modules observes the same registrations.
+type sendHooks struct {
+	restriction types.SendRestrictionFn
+	hooks       types.BankHooks
+}
+
+// AppendSendRestriction adds the provided SendRestrictionFn to run after
+// previously provided restrictions.
+func (k BaseSendKeeper) AppendSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendHooks.restriction = types.ComposeSendRestrictions(k.sendHooks.restriction, restriction)
+}
+
+// PrependSendRestriction adds the provided SendRestrictionFn to run before
+// previously provided restrictions.
+func (k BaseSendKeeper) PrependSendRestriction(restriction types.SendRestrictionFn) {
+	k.sendHooks.restriction = types.ComposeSendRestrictions(restriction, k.sendHooks.restriction)
+}
+
+// ClearSendRestriction removes the send restriction (if there is one).
+func (k BaseSendKeeper) ClearSendRestriction() {
+	k.sendHooks.restriction = nil
+}
+
+// SetHooks sets the bank hooks. It panics if hooks were already set.
+func (k BaseSendKeeper) SetHooks(bh types.BankHooks) {
+	if k.sendHooks.hooks != nil {
+		panic("cannot set bank hooks twice")
+	}
+
+	k.sendHooks.hooks = bh
+}
+
+// hasSendHooks returns true if a send restriction or bank hooks are registered.
+func (k BaseSendKeeper) hasSendHooks() bool {
+	return k.sendHooks.restriction != nil || k.sendHooks.hooks != nil
+}
+
+// applySendRestriction runs the registered send restriction, if any, and
+// returns the address the coins should be delivered to.
+func (k BaseSendKeeper) applySendRestriction(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+	if k.sendHooks.restriction == nil {
+		return toAddr, nil
+	}
+	return k.sendHooks.restriction(ctx, fromAddr, toAddr, amt)
+}
+
+// beforeSend - call hook if registered
+func (k BaseSendKeeper) beforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.sendHooks.hooks != nil {
+		return k.sendHooks.hooks.BeforeSend(ctx, fromAddr, toAddr, amt)
+	}
+	return nil
+}
+
+// afterSend - call hook if registered
+func (k BaseSendKeeper) afterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if k.sendHooks.hooks != nil {
+		return k.sendHooks.hooks.AfterSend(ctx, fromAddr, toAddr, amt)
+	}
+	return nil
+}
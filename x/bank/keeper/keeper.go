@@ -84,18 +84,24 @@ func (k BaseKeeper) GetPaginatedTotalSupply(ctx sdk.Context, pagination *query.P
 }
 
 // NewBaseKeeper returns a new BaseKeeper object with a given codec, dedicated
-// store key, an AccountKeeper implementation, and a parameter Subspace used to
-// store and fetch module parameters. The BaseKeeper also accepts a
-// blocklist map. This blocklist describes the set of addresses that are not allowed
-// to receive funds through direct and explicit actions, for example, by using a MsgSend or
-// by using a SendCoinsFromModuleToAccount execution.
+// store key, an AccountKeeper implementation, and the legacy parameter Subspace
+// the module parameters are migrated from into the module store. The BaseKeeper
+// also accepts a blocklist map. This blocklist describes the set of addresses that
+// are not allowed to receive funds through direct and explicit actions, for example,
+// by using a MsgSend or by using a SendCoinsFromModuleToAccount execution. authority
+// is the address of the account, usually the governance module account, allowed to
+// update the module parameters.
 func NewBaseKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	ak types.AccountKeeper,
 	paramSpace paramtypes.Subspace,
 	blockedAddrs map[string]bool,
+	authority string,
 ) BaseKeeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid bank authority address: %s", err))
+	}
 
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
@@ -103,7 +109,7 @@ func NewBaseKeeper(
 	}
 
 	return BaseKeeper{
-		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, paramSpace, blockedAddrs),
+		BaseSendKeeper: NewBaseSendKeeper(cdc, storeKey, ak, blockedAddrs, authority),
 		ak:             ak,
 		cdc:            cdc,
 		storeKey:       storeKey,
@@ -114,8 +120,8 @@ func NewBaseKeeper(
 // DelegateCoins performs delegation by deducting amt coins from an account with
 // address addr. For vesting accounts, delegations amounts are tracked for both
 // vesting and vested coins. The coins are then transferred from the delegator
-// address to a ModuleAccount address. If any of the delegation amounts are negative,
-// an error is returned.
+// address to a ModuleAccount address. If any of the delegation amounts are negative
+// or frozen for the delegator, an error is returned.
 func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr sdk.AccAddress, amt sdk.Coins) error {
 	moduleAcc := k.ak.GetAccount(ctx, moduleAccAddr)
 	if moduleAcc == nil {
@@ -126,6 +132,10 @@ func (k BaseKeeper) DelegateCoins(ctx sdk.Context, delegatorAddr, moduleAccAddr
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amt.String())
 	}
 
+	if err := k.checkFrozen(ctx, amt, delegatorAddr); err != nil {
+		return err
+	}
+
 	balances := sdk.NewCoins()
 
 	for _, coin := range amt {
@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -19,7 +20,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 const (
@@ -82,11 +85,11 @@ func (suite *IntegrationTestSuite) initKeepersWithmAccPerms(blockedAddrs map[str
 	maccPerms[randomPerm] = []string{"random"}
 	authKeeper := authkeeper.NewAccountKeeper(
 		appCodec, app.GetKey(types.StoreKey), app.GetSubspace(types.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, app.AccountKeeper.GetAuthority(),
 	)
 	keeper := keeper.NewBaseKeeper(
 		appCodec, app.GetKey(types.StoreKey), authKeeper,
-		app.GetSubspace(types.ModuleName), blockedAddrs,
+		app.GetSubspace(types.ModuleName), blockedAddrs, app.BankKeeper.GetAuthority(),
 	)
 
 	return authKeeper, keeper
@@ -526,6 +529,45 @@ func (suite *IntegrationTestSuite) TestSendEnabled() {
 	suite.Require().Error(err)
 }
 
+func (suite *IntegrationTestSuite) TestSendEnabledEntries() {
+	app, ctx := suite.app, suite.ctx
+
+	_, found := app.BankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().False(found)
+
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, false)
+	app.BankKeeper.SetAllSendEnabled(ctx, []*types.SendEnabled{
+		types.NewSendEnabled(barDenom, true),
+		types.NewSendEnabled("bazcoin", false),
+	})
+
+	se, found := app.BankKeeper.GetSendEnabledEntry(ctx, fooDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.SendEnabled{Denom: fooDenom, Enabled: false}, se)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: barDenom, Enabled: true},
+		{Denom: "bazcoin", Enabled: false},
+		{Denom: fooDenom, Enabled: false},
+	}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+
+	var denoms []string
+	app.BankKeeper.IterateSendEnabledEntries(ctx, func(denom string, _ bool) bool {
+		denoms = append(denoms, denom)
+		return len(denoms) == 2
+	})
+	suite.Require().Equal([]string{barDenom, "bazcoin"}, denoms)
+
+	app.BankKeeper.DeleteSendEnabled(ctx, fooDenom, "bazcoin", "unknowncoin")
+	suite.Require().Equal([]types.SendEnabled{{Denom: barDenom, Enabled: true}}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+
+	// entries set through the deprecated params are moved to the store
+	app.BankKeeper.SetParams(ctx, types.NewParams(false, types.SendEnabledParams{types.NewSendEnabled(fooDenom, true)}))
+	suite.Require().Empty(app.BankKeeper.GetParams(ctx).SendEnabled)
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, fooDenom))
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, barDenom))
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "bazcoin"))
+}
+
 func (suite *IntegrationTestSuite) TestHasBalance() {
 	app, ctx := suite.app, suite.ctx
 	addr := sdk.AccAddress([]byte("addr1_______________"))
@@ -1049,11 +1091,11 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 
 	suite.app.AccountKeeper = authkeeper.NewAccountKeeper(
 		suite.app.AppCodec(), suite.app.GetKey(authtypes.StoreKey), suite.app.GetSubspace(authtypes.ModuleName),
-		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix,
+		authtypes.ProtoBaseAccount, maccPerms, sdk.Bech32MainPrefix, suite.app.AccountKeeper.GetAuthority(),
 	)
 
 	suite.app.BankKeeper = keeper.NewBaseKeeper(suite.app.AppCodec(), suite.app.GetKey(types.StoreKey),
-		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil)
+		suite.app.AccountKeeper, suite.app.GetSubspace(types.ModuleName), nil, suite.app.BankKeeper.GetAuthority())
 
 	// set account with multiple permissions
 	suite.app.AccountKeeper.SetModuleAccount(suite.ctx, multiPermAcc)
@@ -1138,6 +1180,305 @@ func (suite *IntegrationTestSuite) TestBalanceTrackingEvents() {
 	})
 }
 
+// mockBankHooks records the transfers it is notified about and can veto sends
+// from a given address.
+type mockBankHooks struct {
+	blocked sdk.AccAddress
+	before  []string
+	after   []string
+}
+
+func (h *mockBankHooks) BeforeSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	if fromAddr.Equals(h.blocked) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is blocked", fromAddr)
+	}
+	h.before = append(h.before, fromAddr.String()+"->"+toAddr.String()+":"+amt.String())
+	return nil
+}
+
+func (h *mockBankHooks) AfterSend(_ sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	h.after = append(h.after, fromAddr.String()+"->"+toAddr.String()+":"+amt.String())
+	return nil
+}
+
+func (suite *IntegrationTestSuite) TestSendRestriction() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	sanctioned := sdk.AccAddress("sanctioned__________")
+	quarantine := sdk.AccAddress("quarantine__________")
+
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+
+	// veto any transfer to the sanctioned address and redirect bar coins
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		if toAddr.Equals(sanctioned) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is sanctioned", toAddr)
+		}
+		return toAddr, nil
+	})
+	app.BankKeeper.AppendSendRestriction(func(_ sdk.Context, _, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.AccAddress, error) {
+		if amt.AmountOf(barDenom).IsPositive() {
+			return quarantine, nil
+		}
+		return toAddr, nil
+	})
+
+	// keeper calls
+	err := app.BankKeeper.SendCoins(ctx, addr1, sanctioned, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, sanctioned).IsZero())
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(newBarCoin(10))))
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, addr2).IsZero())
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(10)), app.BankKeeper.GetAllBalances(ctx, quarantine))
+
+	// module to account sends
+	suite.Require().NoError(app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(newFooCoin(10))))
+	err = app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sanctioned, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// MsgSend
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(addr1, sanctioned, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(addr1, addr2, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// MsgMultiSend
+	_, err = msgServer.MultiSend(goCtx, types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr1, sdk.NewCoins(newFooCoin(20)))},
+		[]types.Output{
+			types.NewOutput(addr2, sdk.NewCoins(newFooCoin(10))),
+			types.NewOutput(sanctioned, sdk.NewCoins(newFooCoin(10))),
+		},
+	))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	_, err = msgServer.MultiSend(goCtx, types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr1, sdk.NewCoins(newFooCoin(10), newBarCoin(10)))},
+		[]types.Output{
+			types.NewOutput(addr2, sdk.NewCoins(newFooCoin(10))),
+			types.NewOutput(addr2, sdk.NewCoins(newBarCoin(10))),
+		},
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().Equal(sdk.NewCoins(newBarCoin(20)), app.BankKeeper.GetAllBalances(ctx, quarantine))
+
+	// a multi-send with several inputs is rejected, since the outputs can't be attributed to the inputs
+	multiInputs := types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr1, sdk.NewCoins(newFooCoin(10))), types.NewInput(addr2, sdk.NewCoins(newFooCoin(10)))},
+		[]types.Output{types.NewOutput(sanctioned, sdk.NewCoins(newFooCoin(20)))},
+	)
+	_, err = msgServer.MultiSend(goCtx, multiInputs)
+	suite.Require().ErrorIs(err, types.ErrMultipleSenders)
+	suite.Require().True(app.BankKeeper.GetAllBalances(ctx, sanctioned).IsZero())
+
+	// once cleared, transfers are no longer restricted
+	app.BankKeeper.ClearSendRestriction()
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, sanctioned, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10)), app.BankKeeper.GetAllBalances(ctx, sanctioned))
+	_, err = msgServer.MultiSend(goCtx, multiInputs)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, sanctioned))
+}
+
+func (suite *IntegrationTestSuite) TestBankHooks() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+
+	balances := sdk.NewCoins(newFooCoin(100))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr3, balances))
+
+	hooks := &mockBankHooks{blocked: addr3}
+	app.BankKeeper.SetHooks(hooks)
+	suite.Require().Panics(func() { app.BankKeeper.SetHooks(hooks) })
+
+	amt := sdk.NewCoins(newFooCoin(10))
+	expTransfer := addr1.String() + "->" + addr2.String() + ":" + amt.String()
+
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr2, amt))
+	suite.Require().Equal([]string{expTransfer}, hooks.before)
+	suite.Require().Equal([]string{expTransfer}, hooks.after)
+
+	_, err := msgServer.MultiSend(goCtx, types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr1, amt)},
+		[]types.Output{types.NewOutput(addr2, amt)},
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{expTransfer, expTransfer}, hooks.before)
+	suite.Require().Equal([]string{expTransfer, expTransfer}, hooks.after)
+
+	// the hooks are run for each output of a multi-send
+	expTransfer3 := addr1.String() + "->" + addr3.String() + ":" + amt.String()
+	_, err = msgServer.MultiSend(goCtx, types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr1, amt.Add(amt...))},
+		[]types.Output{types.NewOutput(addr2, amt), types.NewOutput(addr3, amt)},
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{expTransfer, expTransfer, expTransfer, expTransfer3}, hooks.before)
+	suite.Require().Equal([]string{expTransfer, expTransfer, expTransfer, expTransfer3}, hooks.after)
+
+	// a multi-send with several inputs can't be attributed to a single sender
+	_, err = msgServer.MultiSend(goCtx, types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr1, amt), types.NewInput(addr3, amt)},
+		[]types.Output{types.NewOutput(addr2, amt.Add(amt...))},
+	))
+	suite.Require().ErrorIs(err, types.ErrMultipleSenders)
+	suite.Require().Len(hooks.before, 4)
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(30)), app.BankKeeper.GetAllBalances(ctx, addr2))
+
+	// the before send hook can veto a transfer
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(addr3, addr2, amt))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(balances.Add(amt...), app.BankKeeper.GetAllBalances(ctx, addr3))
+	suite.Require().Len(hooks.after, 4)
+}
+
+func (suite *IntegrationTestSuite) TestFrozenSends() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+
+	balances := sdk.NewCoins(newFooCoin(100), newBarCoin(100))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, balances))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr2, balances))
+
+	suite.Require().NoError(app.BankKeeper.SetFrozen(ctx, fooDenom, addr1, true))
+	suite.Require().True(app.BankKeeper.IsFrozen(ctx, fooDenom, addr1))
+	suite.Require().False(app.BankKeeper.IsFrozen(ctx, barDenom, addr1))
+
+	// frozen coins can neither be sent nor received
+	err := app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+	err = app.BankKeeper.SendCoins(ctx, addr2, addr1, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+	_, err = msgServer.MultiSend(goCtx, types.NewMsgMultiSend(
+		[]types.Input{types.NewInput(addr2, sdk.NewCoins(newFooCoin(10)))},
+		[]types.Output{types.NewOutput(addr1, sdk.NewCoins(newFooCoin(10)))},
+	))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+	err = app.BankKeeper.DelegateCoinsFromAccountToModule(ctx, addr1, stakingtypes.BondedPoolName, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+
+	// other denoms are not affected
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newBarCoin(10))))
+
+	// a global freeze applies to every address
+	app.BankKeeper.SetGlobalFreeze(ctx, barDenom, true)
+	suite.Require().True(app.BankKeeper.IsGloballyFrozen(ctx, barDenom))
+	err = app.BankKeeper.SendCoins(ctx, addr2, addr3, sdk.NewCoins(newBarCoin(10)))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+
+	app.BankKeeper.SetGlobalFreeze(ctx, barDenom, false)
+	suite.Require().NoError(app.BankKeeper.SetFrozen(ctx, fooDenom, addr1, false))
+	suite.Require().NoError(app.BankKeeper.SendCoins(ctx, addr1, addr3, sdk.NewCoins(newFooCoin(10), newBarCoin(10))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(10), newBarCoin(20)), app.BankKeeper.GetAllBalances(ctx, addr3))
+}
+
+func (suite *IntegrationTestSuite) TestForceTransfer() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(app.BankKeeper.SetFrozen(ctx, fooDenom, addr1, true))
+	app.BankKeeper.SetSendEnabled(ctx, fooDenom, false)
+
+	// the freezes and send enabled flags do not apply
+	suite.Require().NoError(app.BankKeeper.ForceTransfer(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(60))))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(40)), app.BankKeeper.GetAllBalances(ctx, addr1))
+	suite.Require().Equal(sdk.NewCoins(newFooCoin(60)), app.BankKeeper.GetAllBalances(ctx, addr2))
+	suite.Require().NotNil(app.AccountKeeper.GetAccount(ctx, addr2))
+
+	err := app.BankKeeper.ForceTransfer(ctx, addr1, addr2, sdk.NewCoins(newFooCoin(50)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFunds)
+
+	// the coins of module accounts can't be moved
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, distrtypes.ModuleName, sdk.NewCoins(newFooCoin(100))))
+	distrAddr := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	err = app.BankKeeper.ForceTransfer(ctx, distrAddr, addr2, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(newFooCoin(100), app.BankKeeper.GetBalance(ctx, distrAddr, fooDenom))
+}
+
+func (suite *IntegrationTestSuite) TestFreezesSkipModuleAccounts() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	feeCollectorAddr := app.AccountKeeper.GetModuleAccount(ctx, authtypes.FeeCollectorName).GetAddress()
+	distrAddr := app.AccountKeeper.GetModuleAccount(ctx, distrtypes.ModuleName).GetAddress()
+
+	// module accounts and blocked addresses can't be frozen
+	err := app.BankKeeper.SetFrozen(ctx, fooDenom, feeCollectorAddr, true)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	err = app.BankKeeper.SetFrozen(ctx, fooDenom, distrAddr, true)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().False(app.BankKeeper.IsFrozen(ctx, fooDenom, distrAddr))
+
+	// a freeze recorded for a module account doesn't apply when it receives coins
+	ctx.KVStore(app.GetKey(types.StoreKey)).Set(types.CreateFrozenKey(fooDenom, feeCollectorAddr), []byte{0})
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, addr1, sdk.NewCoins(newFooCoin(100))))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(10))))
+
+	// a global freeze still applies to the other accounts, but not between module accounts
+	app.BankKeeper.SetGlobalFreeze(ctx, fooDenom, true)
+	err = app.BankKeeper.SendCoinsFromAccountToModule(ctx, addr1, authtypes.FeeCollectorName, sdk.NewCoins(newFooCoin(10)))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, distrtypes.ModuleName, sdk.NewCoins(newFooCoin(10))))
+	suite.Require().Equal(newFooCoin(10), app.BankKeeper.GetBalance(ctx, distrAddr, fooDenom))
+
+	// the payouts of module accounts reach frozen receivers
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, addr1, sdk.NewCoins(newFooCoin(5))))
+	app.BankKeeper.SetGlobalFreeze(ctx, fooDenom, false)
+	suite.Require().NoError(app.BankKeeper.SetFrozen(ctx, fooDenom, addr1, true))
+	suite.Require().NoError(app.BankKeeper.SendCoinsFromModuleToAccount(ctx, distrtypes.ModuleName, addr1, sdk.NewCoins(newFooCoin(5))))
+	suite.Require().Equal(newFooCoin(100), app.BankKeeper.GetBalance(ctx, addr1, fooDenom))
+}
+
+func (suite *IntegrationTestSuite) TestGetAllDenomFreezes() {
+	app, ctx := suite.app, suite.ctx
+
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+
+	suite.Require().NoError(app.BankKeeper.SetFrozen(ctx, fooDenom, addr1, true))
+	suite.Require().NoError(app.BankKeeper.SetFrozen(ctx, fooDenom, addr2, true))
+	suite.Require().NoError(app.BankKeeper.SetFrozen(ctx, "foo2", addr2, true))
+	app.BankKeeper.SetGlobalFreeze(ctx, barDenom, true)
+
+	var frozen []sdk.AccAddress
+	app.BankKeeper.IterateFrozenAddresses(ctx, fooDenom, func(addr sdk.AccAddress) bool {
+		frozen = append(frozen, addr)
+		return false
+	})
+	suite.Require().Equal([]sdk.AccAddress{addr1, addr2}, frozen)
+
+	suite.Require().Equal([]types.DenomFreeze{
+		{Denom: barDenom, GlobalFrozen: true},
+		{Denom: fooDenom, FrozenAddresses: []string{addr1.String(), addr2.String()}},
+		{Denom: "foo2", FrozenAddresses: []string{addr2.String()}},
+	}, app.BankKeeper.GetAllDenomFreezes(ctx))
+}
+
 func (suite *IntegrationTestSuite) getTestMetadata() []types.Metadata {
 	return []types.Metadata{
 		{
@@ -25,3 +25,15 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
 }
+
+// Migrate3to4 migrates the x/bank params from the x/params subspace to the
+// x/bank module store.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v046.MigrateParams(ctx, m.keeper.storeKey, m.keeper.paramSpace, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates the x/bank SendEnabled entries out of the params into
+// their own keyed store.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v046.MigrateSendEnabled(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/armon/go-metrics"
 
@@ -104,3 +105,192 @@ func (k msgServer) MultiSend(goCtx context.Context, msg *types.MsgMultiSend) (*t
 
 	return &types.MsgMultiSendResponse{}, nil
 }
+
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	// The SendEnabled entries are set with MsgSetSendEnabled.
+	if len(msg.Params.SendEnabled) > 0 { //nolint:staticcheck
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("use of send_enabled in params is no longer supported")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) SetSendEnabled(goCtx context.Context, msg *types.MsgSetSendEnabled) (*types.MsgSetSendEnabledResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("invalid authority; expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if len(msg.SendEnabled) > 0 {
+		k.Keeper.SetAllSendEnabled(ctx, msg.SendEnabled)
+	}
+	if len(msg.UseDefaultFor) > 0 {
+		k.Keeper.DeleteSendEnabled(ctx, msg.UseDefaultFor...)
+	}
+
+	return &types.MsgSetSendEnabledResponse{}, nil
+}
+
+func (k msgServer) SetDenomAdmin(goCtx context.Context, msg *types.MsgSetDenomAdmin) (*types.MsgSetDenomAdminResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	metadata, found := k.GetDenomMetaData(ctx, msg.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrDenomMetadataNotFound, "denom %s", msg.Denom)
+	}
+
+	if msg.Sender != k.GetAuthority() && (metadata.Admin == "" || msg.Sender != metadata.Admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is neither the authority nor the admin of %s", msg.Sender, msg.Denom)
+	}
+
+	metadata.Admin = msg.Admin
+	k.SetDenomMetaData(ctx, metadata)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetDenomAdmin,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAdmin, msg.Admin),
+		),
+	)
+
+	return &types.MsgSetDenomAdminResponse{}, nil
+}
+
+func (k msgServer) Freeze(goCtx context.Context, msg *types.MsgFreeze) (*types.MsgFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDenomAdmin(ctx, msg.Admin, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetFrozen(ctx, msg.Denom, addr, true); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeFreeze,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	return &types.MsgFreezeResponse{}, nil
+}
+
+func (k msgServer) Unfreeze(goCtx context.Context, msg *types.MsgUnfreeze) (*types.MsgUnfreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDenomAdmin(ctx, msg.Admin, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	addr, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetFrozen(ctx, msg.Denom, addr, false); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnfreeze,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+		),
+	)
+
+	return &types.MsgUnfreezeResponse{}, nil
+}
+
+func (k msgServer) SetGlobalFreeze(goCtx context.Context, msg *types.MsgSetGlobalFreeze) (*types.MsgSetGlobalFreezeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDenomAdmin(ctx, msg.Admin, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	k.Keeper.SetGlobalFreeze(ctx, msg.Denom, msg.Frozen)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetGlobalFreeze,
+			sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+			sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(msg.Frozen)),
+		),
+	)
+
+	return &types.MsgSetGlobalFreezeResponse{}, nil
+}
+
+func (k msgServer) Clawback(goCtx context.Context, msg *types.MsgClawback) (*types.MsgClawbackResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.validateDenomAdmin(ctx, msg.Admin, msg.Amount.Denom); err != nil {
+		return nil, err
+	}
+
+	from, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddress := msg.ToAddress
+	if toAddress == "" {
+		toAddress = msg.Admin
+	}
+	to, err := sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.BlockedAddr(to) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", toAddress)
+	}
+
+	if err := k.Keeper.ForceTransfer(ctx, from, to, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyAdmin, msg.Admin),
+			sdk.NewAttribute(types.AttributeKeySender, msg.FromAddress),
+			sdk.NewAttribute(types.AttributeKeyRecipient, toAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgClawbackResponse{}, nil
+}
+
+// validateDenomAdmin returns an error if admin is not the admin recorded in
+// the metadata of the denom.
+func (k msgServer) validateDenomAdmin(ctx sdk.Context, admin, denom string) error {
+	metadata, found := k.GetDenomMetaData(ctx, denom)
+	if !found || metadata.Admin == "" {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s has no admin", denom)
+	}
+
+	if metadata.Admin != admin {
+		return sdkerrors.ErrUnauthorized.Wrapf("invalid admin of %s; expected %s, got %s", denom, metadata.Admin, admin)
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+)
+
+func (suite *IntegrationTestSuite) TestMsgSetSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := app.BankKeeper.GetAuthority()
+
+	app.BankKeeper.SetSendEnabled(ctx, "barcoin", false)
+	app.BankKeeper.SetSendEnabled(ctx, "bazcoin", true)
+
+	_, err := msgServer.SetSendEnabled(goCtx, &types.MsgSetSendEnabled{
+		Authority:   sdk.AccAddress("not_the_authority___").String(),
+		SendEnabled: []*types.SendEnabled{types.NewSendEnabled("foocoin", false)},
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetSendEnabled(goCtx, &types.MsgSetSendEnabled{
+		Authority: authority,
+		SendEnabled: []*types.SendEnabled{
+			types.NewSendEnabled("foocoin", false),
+			types.NewSendEnabled("bazcoin", false),
+		},
+		UseDefaultFor: []string{"barcoin", "unknowncoin"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SendEnabled{
+		{Denom: "bazcoin", Enabled: false},
+		{Denom: "foocoin", Enabled: false},
+	}, app.BankKeeper.GetAllSendEnabledEntries(ctx))
+
+	// the default is used for denoms without an entry
+	suite.Require().True(app.BankKeeper.IsSendEnabledDenom(ctx, "barcoin"))
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+	suite.Require().ErrorIs(app.BankKeeper.IsSendEnabledCoins(ctx, sdk.NewInt64Coin("foocoin", 1)), types.ErrSendDisabled)
+}
+
+func (suite *IntegrationTestSuite) TestMsgUpdateParamsSendEnabled() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority := app.BankKeeper.GetAuthority()
+
+	_, err := msgServer.UpdateParams(goCtx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams(true, types.SendEnabledParams{types.NewSendEnabled("foocoin", false)}),
+	})
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = msgServer.UpdateParams(goCtx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams(false, nil),
+	})
+	suite.Require().NoError(err)
+	suite.Require().False(app.BankKeeper.IsSendEnabledDenom(ctx, "foocoin"))
+}
+
+func (suite *IntegrationTestSuite) TestMsgSetDenomAdmin() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+	authority, err := sdk.AccAddressFromBech32(app.BankKeeper.GetAuthority())
+	suite.Require().NoError(err)
+
+	admin1 := sdk.AccAddress("admin1______________")
+	admin2 := sdk.AccAddress("admin2______________")
+
+	metadata := suite.getTestMetadata()[0]
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+
+	_, err = msgServer.SetDenomAdmin(goCtx, types.NewMsgSetDenomAdmin(authority, "unknown", admin1))
+	suite.Require().ErrorIs(err, types.ErrDenomMetadataNotFound)
+
+	// only the authority can set the first admin
+	_, err = msgServer.SetDenomAdmin(goCtx, types.NewMsgSetDenomAdmin(admin1, metadata.Base, admin1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomAdmin(goCtx, types.NewMsgSetDenomAdmin(authority, metadata.Base, admin1))
+	suite.Require().NoError(err)
+	stored, _ := app.BankKeeper.GetDenomMetaData(ctx, metadata.Base)
+	suite.Require().Equal(admin1.String(), stored.Admin)
+
+	// the admin can hand over the denom, after which it is no longer the admin
+	_, err = msgServer.SetDenomAdmin(goCtx, types.NewMsgSetDenomAdmin(admin1, metadata.Base, admin2))
+	suite.Require().NoError(err)
+	_, err = msgServer.SetDenomAdmin(goCtx, types.NewMsgSetDenomAdmin(admin1, metadata.Base, admin1))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.SetDenomAdmin(goCtx, types.NewMsgSetDenomAdmin(admin2, metadata.Base, nil))
+	suite.Require().NoError(err)
+	stored, _ = app.BankKeeper.GetDenomMetaData(ctx, metadata.Base)
+	suite.Require().Empty(stored.Admin)
+}
+
+func (suite *IntegrationTestSuite) TestMsgFreezeAndClawback() {
+	app, ctx := suite.app, suite.ctx
+	goCtx := sdk.WrapSDKContext(ctx)
+	msgServer := keeper.NewMsgServerImpl(app.BankKeeper)
+
+	admin := sdk.AccAddress("admin_______________")
+	holderAddr := sdk.AccAddress("holder______________")
+	other := sdk.AccAddress("other_______________")
+
+	metadata := suite.getTestMetadata()[0]
+	metadata.Admin = admin.String()
+	app.BankKeeper.SetDenomMetaData(ctx, metadata)
+	denom := metadata.Base
+
+	balance := sdk.NewCoins(sdk.NewInt64Coin(denom, 100))
+	suite.Require().NoError(testutil.FundAccount(app.BankKeeper, ctx, holderAddr, balance))
+
+	// only the admin can freeze
+	_, err := msgServer.Freeze(goCtx, types.NewMsgFreeze(other, denom, holderAddr))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Freeze(goCtx, types.NewMsgFreeze(admin, "unmanaged", holderAddr))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = msgServer.Freeze(goCtx, types.NewMsgFreeze(admin, denom, holderAddr))
+	suite.Require().NoError(err)
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(holderAddr, other, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+
+	// the admin can claw back frozen coins, to itself by default
+	_, err = msgServer.Clawback(goCtx, types.NewMsgClawback(other, holderAddr, other, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Clawback(goCtx, types.NewMsgClawback(admin, holderAddr, nil, sdk.NewInt64Coin(denom, 30)))
+	suite.Require().NoError(err)
+	_, err = msgServer.Clawback(goCtx, types.NewMsgClawback(admin, holderAddr, other, sdk.NewInt64Coin(denom, 20)))
+	suite.Require().NoError(err)
+
+	// module accounts and blocked addresses can neither be frozen nor clawed back
+	feeCollectorAddr := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	_, err = msgServer.Freeze(goCtx, types.NewMsgFreeze(admin, denom, feeCollectorAddr))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().NoError(testutil.FundModuleAccount(app.BankKeeper, ctx, distrtypes.ModuleName, balance))
+	distrAddr := app.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+	_, err = msgServer.Freeze(goCtx, types.NewMsgFreeze(admin, denom, distrAddr))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.Clawback(goCtx, types.NewMsgClawback(admin, distrAddr, nil, sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 50), app.BankKeeper.GetBalance(ctx, holderAddr, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 30), app.BankKeeper.GetBalance(ctx, admin, denom))
+	suite.Require().Equal(sdk.NewInt64Coin(denom, 20), app.BankKeeper.GetBalance(ctx, other, denom))
+
+	_, err = msgServer.Unfreeze(goCtx, types.NewMsgUnfreeze(admin, denom, holderAddr))
+	suite.Require().NoError(err)
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(holderAddr, other, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	suite.Require().NoError(err)
+
+	// a global freeze blocks every holderAddr
+	_, err = msgServer.SetGlobalFreeze(goCtx, types.NewMsgSetGlobalFreeze(admin, denom, true))
+	suite.Require().NoError(err)
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(other, holderAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	suite.Require().ErrorIs(err, types.ErrFrozen)
+
+	_, err = msgServer.SetGlobalFreeze(goCtx, types.NewMsgSetGlobalFreeze(admin, denom, false))
+	suite.Require().NoError(err)
+	_, err = msgServer.Send(goCtx, types.NewMsgSend(other, holderAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 10))))
+	suite.Require().NoError(err)
+}
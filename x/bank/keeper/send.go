@@ -9,7 +9,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
-	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 // SendKeeper defines a module interface that facilitates the transfer of coins
@@ -20,13 +19,34 @@ type SendKeeper interface {
 	InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error
 	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 
+	GetAuthority() string
 	GetParams(ctx sdk.Context) types.Params
 	SetParams(ctx sdk.Context, params types.Params)
 
 	IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool
 	IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) error
+	IsSendEnabledDenom(ctx sdk.Context, denom string) bool
+	GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool)
+	SetSendEnabled(ctx sdk.Context, denom string, value bool)
+	SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled)
+	DeleteSendEnabled(ctx sdk.Context, denoms ...string)
+	IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) (stop bool))
+	GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled
 
 	BlockedAddr(addr sdk.AccAddress) bool
+
+	AppendSendRestriction(restriction types.SendRestrictionFn)
+	PrependSendRestriction(restriction types.SendRestrictionFn)
+	ClearSendRestriction()
+	SetHooks(bh types.BankHooks)
+
+	IsFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress) bool
+	SetFrozen(ctx sdk.Context, denom string, addr sdk.AccAddress, frozen bool) error
+	IsGloballyFrozen(ctx sdk.Context, denom string) bool
+	SetGlobalFreeze(ctx sdk.Context, denom string, frozen bool)
+	IterateFrozenAddresses(ctx sdk.Context, denom string, cb func(addr sdk.AccAddress) (stop bool))
+	GetAllDenomFreezes(ctx sdk.Context) []types.DenomFreeze
+	ForceTransfer(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 var _ SendKeeper = (*BaseSendKeeper)(nil)
@@ -36,17 +56,23 @@ var _ SendKeeper = (*BaseSendKeeper)(nil)
 type BaseSendKeeper struct {
 	BaseViewKeeper
 
-	cdc        codec.BinaryCodec
-	ak         types.AccountKeeper
-	storeKey   storetypes.StoreKey
-	paramSpace paramtypes.Subspace
+	cdc      codec.BinaryCodec
+	ak       types.AccountKeeper
+	storeKey storetypes.StoreKey
 
 	// list of addresses that are restricted from receiving transactions
 	blockedAddrs map[string]bool
+
+	// the address allowed to update the module parameters
+	authority string
+
+	// send restriction and transfer hooks shared by all copies of the keeper
+	sendHooks *sendHooks
 }
 
 func NewBaseSendKeeper(
-	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, paramSpace paramtypes.Subspace, blockedAddrs map[string]bool,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, ak types.AccountKeeper, blockedAddrs map[string]bool,
+	authority string,
 ) BaseSendKeeper {
 
 	return BaseSendKeeper{
@@ -54,25 +80,50 @@ func NewBaseSendKeeper(
 		cdc:            cdc,
 		ak:             ak,
 		storeKey:       storeKey,
-		paramSpace:     paramSpace,
 		blockedAddrs:   blockedAddrs,
+		authority:      authority,
+		sendHooks:      &sendHooks{},
 	}
 }
 
+// GetAuthority returns the address allowed to update the bank parameters.
+func (k BaseSendKeeper) GetAuthority() string {
+	return k.authority
+}
+
 // GetParams returns the total set of bank parameters.
 func (k BaseSendKeeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+	bz := ctx.KVStore(k.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
 
 // SetParams sets the total set of bank parameters.
+//
+// Note: params.SendEnabled is deprecated but it should be here regardless.
+// Any SendEnabled entries in the params are moved to the SendEnabled store
+// and are not kept in the stored params.
+//nolint:staticcheck
 func (k BaseSendKeeper) SetParams(ctx sdk.Context, params types.Params) {
-	k.paramSpace.SetParamSet(ctx, &params)
+	if len(params.SendEnabled) > 0 {
+		k.SetAllSendEnabled(ctx, params.SendEnabled)
+	}
+	p := types.NewParams(params.DefaultSendEnabled, nil)
+	ctx.KVStore(k.storeKey).Set(types.ParamsKey, k.cdc.MustMarshal(&p))
 }
 
 // InputOutputCoins performs multi-send functionality. It accepts a series of
 // inputs that correspond to a series of outputs. It returns an error if the
 // inputs and outputs don't lineup or if any single transfer of tokens fails.
+//
+// The registered send restriction and hooks are run for every output with the
+// input as the sender. Since a multi-send does not attribute the outputs to the
+// inputs, a multi-send with several inputs is rejected when a send restriction
+// or hooks are registered.
 func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input, outputs []types.Output) error {
 	// Safety check ensuring that when sending coins the keeper must maintain the
 	// Check supply invariant and validity of Coins.
@@ -80,13 +131,48 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		return err
 	}
 
-	for _, in := range inputs {
+	if len(inputs) > 1 && k.hasSendHooks() {
+		return sdkerrors.Wrap(types.ErrMultipleSenders, "a multi-send can only have one input when a send restriction or bank hooks are registered")
+	}
+
+	inAddresses := make([]sdk.AccAddress, len(inputs))
+	for i, in := range inputs {
 		inAddress, err := sdk.AccAddressFromBech32(in.Address)
 		if err != nil {
 			return err
 		}
 
-		err = k.subUnlockedCoins(ctx, inAddress, in.Coins)
+		if err := k.checkFrozen(ctx, in.Coins, inAddress); err != nil {
+			return err
+		}
+		inAddresses[i] = inAddress
+	}
+
+	outAddresses := make([]sdk.AccAddress, len(outputs))
+	for i, out := range outputs {
+		outAddress, err := sdk.AccAddressFromBech32(out.Address)
+		if err != nil {
+			return err
+		}
+
+		// there is a single input if a send restriction or hooks are registered
+		outAddress, err = k.applySendRestriction(ctx, inAddresses[0], outAddress, out.Coins)
+		if err != nil {
+			return err
+		}
+
+		if err := k.checkFrozen(ctx, out.Coins, outAddress); err != nil {
+			return err
+		}
+
+		if err := k.beforeSend(ctx, inAddresses[0], outAddress, out.Coins); err != nil {
+			return err
+		}
+		outAddresses[i] = outAddress
+	}
+
+	for i, in := range inputs {
+		err := k.subUnlockedCoins(ctx, inAddresses[i], in.Coins)
 		if err != nil {
 			return err
 		}
@@ -99,12 +185,9 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		)
 	}
 
-	for _, out := range outputs {
-		outAddress, err := sdk.AccAddressFromBech32(out.Address)
-		if err != nil {
-			return err
-		}
-		err = k.addCoins(ctx, outAddress, out.Coins)
+	for i, out := range outputs {
+		outAddress := outAddresses[i]
+		err := k.addCoins(ctx, outAddress, out.Coins)
 		if err != nil {
 			return err
 		}
@@ -112,7 +195,7 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeTransfer,
-				sdk.NewAttribute(types.AttributeKeyRecipient, out.Address),
+				sdk.NewAttribute(types.AttributeKeyRecipient, outAddress.String()),
 				sdk.NewAttribute(sdk.AttributeKeyAmount, out.Coins.String()),
 			),
 		)
@@ -126,15 +209,35 @@ func (k BaseSendKeeper) InputOutputCoins(ctx sdk.Context, inputs []types.Input,
 			defer telemetry.IncrCounter(1, "new", "account")
 			k.ak.SetAccount(ctx, k.ak.NewAccountWithAddress(ctx, outAddress))
 		}
+
+		if err := k.afterSend(ctx, inAddresses[0], outAddress, out.Coins); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 // SendCoins transfers amt coins from a sending account to a receiving account.
-// An error is returned upon failure.
+// The registered send restriction may veto the transfer or redirect it to
+// another receiver. The transfer fails if any of the coins are frozen for the
+// sender or, unless the sender is a module account, for the final receiver. An
+// error is returned upon failure.
 func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
-	err := k.subUnlockedCoins(ctx, fromAddr, amt)
+	toAddr, err := k.applySendRestriction(ctx, fromAddr, toAddr, amt)
+	if err != nil {
+		return err
+	}
+
+	if err := k.checkSendFrozen(ctx, amt, fromAddr, toAddr); err != nil {
+		return err
+	}
+
+	if err := k.beforeSend(ctx, fromAddr, toAddr, amt); err != nil {
+		return err
+	}
+
+	err = k.subUnlockedCoins(ctx, fromAddr, amt)
 	if err != nil {
 		return err
 	}
@@ -169,7 +272,7 @@ func (k BaseSendKeeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAd
 		),
 	})
 
-	return nil
+	return k.afterSend(ctx, fromAddr, toAddr, amt)
 }
 
 // subUnlockedCoins removes the unlocked amt coins of the given account. An error is
@@ -315,7 +418,101 @@ func (k BaseSendKeeper) IsSendEnabledCoins(ctx sdk.Context, coins ...sdk.Coin) e
 
 // IsSendEnabledCoin returns the current SendEnabled status of the provided coin's denom
 func (k BaseSendKeeper) IsSendEnabledCoin(ctx sdk.Context, coin sdk.Coin) bool {
-	return k.GetParams(ctx).SendEnabledDenom(coin.Denom)
+	return k.IsSendEnabledDenom(ctx, coin.Denom)
+}
+
+// IsSendEnabledDenom returns the current SendEnabled status of the provided
+// denom, falling back to the DefaultSendEnabled param when the denom has no
+// entry.
+func (k BaseSendKeeper) IsSendEnabledDenom(ctx sdk.Context, denom string) bool {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateSendEnabledKey(denom))
+	if bz == nil {
+		return k.GetParams(ctx).DefaultSendEnabled
+	}
+	return isTrueB(bz)
+}
+
+// GetSendEnabledEntry gets a SendEnabled entry for the given denom.
+// The second return argument is true iff a specific entry exists for the given denom.
+func (k BaseSendKeeper) GetSendEnabledEntry(ctx sdk.Context, denom string) (types.SendEnabled, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.CreateSendEnabledKey(denom))
+	if bz == nil {
+		return types.SendEnabled{}, false
+	}
+	return types.SendEnabled{Denom: denom, Enabled: isTrueB(bz)}, true
+}
+
+// SetSendEnabled sets the SendEnabled flag for a denom to the provided value.
+func (k BaseSendKeeper) SetSendEnabled(ctx sdk.Context, denom string, value bool) {
+	ctx.KVStore(k.storeKey).Set(types.CreateSendEnabledKey(denom), toBoolB(value))
+}
+
+// SetAllSendEnabled sets all the provided SendEnabled entries in the bank store.
+func (k BaseSendKeeper) SetAllSendEnabled(ctx sdk.Context, sendEnableds []*types.SendEnabled) {
+	store := ctx.KVStore(k.storeKey)
+	for _, se := range sendEnableds {
+		store.Set(types.CreateSendEnabledKey(se.Denom), toBoolB(se.Enabled))
+	}
+}
+
+// DeleteSendEnabled deletes the SendEnabled flags for one or more denoms.
+// If a denom is provided that doesn't have a SendEnabled flag, it is ignored.
+func (k BaseSendKeeper) DeleteSendEnabled(ctx sdk.Context, denoms ...string) {
+	store := ctx.KVStore(k.storeKey)
+	for _, denom := range denoms {
+		store.Delete(types.CreateSendEnabledKey(denom))
+	}
+}
+
+// getSendEnabledPrefixStore gets a prefix store for the SendEnabled entries.
+func (k BaseSendKeeper) getSendEnabledPrefixStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.SendEnabledPrefix)
+}
+
+// IterateSendEnabledEntries iterates over all the SendEnabled entries.
+func (k BaseSendKeeper) IterateSendEnabledEntries(ctx sdk.Context, cb func(denom string, sendEnabled bool) bool) {
+	seStore := k.getSendEnabledPrefixStore(ctx)
+
+	iterator := seStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key())
+		if cb(denom, isTrueB(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllSendEnabledEntries gets all the SendEnabled entries that are stored.
+// Any denominations not returned use the default value (set in Params).
+func (k BaseSendKeeper) GetAllSendEnabledEntries(ctx sdk.Context) []types.SendEnabled {
+	var rv []types.SendEnabled
+	k.IterateSendEnabledEntries(ctx, func(denom string, sendEnabled bool) bool {
+		rv = append(rv, types.SendEnabled{Denom: denom, Enabled: sendEnabled})
+		return false
+	})
+
+	return rv
+}
+
+// These are used for storing the SendEnabled flags in a single byte.
+var (
+	trueB  = []byte{0x01}
+	falseB = []byte{0x00}
+)
+
+// toBoolB converts a bool into a []byte.
+func toBoolB(value bool) []byte {
+	if value {
+		return trueB
+	}
+	return falseB
+}
+
+// isTrueB returns true if the provided []byte is equal to trueB.
+func isTrueB(bz []byte) bool {
+	return len(bz) == 1 && bz[0] == trueB[0]
 }
 
 // BlockedAddr checks if a given address is restricted from
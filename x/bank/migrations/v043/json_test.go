@@ -72,11 +72,13 @@ func TestMigrateJSON(t *testing.T) {
 			]
 		}
 	],
+	"denom_freezes": [],
 	"denom_metadata": [],
 	"params": {
 		"default_send_enabled": false,
 		"send_enabled": []
 	},
+	"send_enabled": [],
 	"supply": [
 		{
 			"amount": "20",
@@ -2,6 +2,8 @@ package v046
 
 var (
 	DenomAddressPrefix = []byte{0x03}
+	SendEnabledPrefix  = []byte{0x04}
+	ParamsKey          = []byte{0x05}
 )
 
 // CreateDenomAddressPrefix creates a prefix for a reverse index of denomination
@@ -14,3 +16,11 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateSendEnabledKey creates the key of the SendEnabled flag of a denom.
+func CreateSendEnabledKey(denom string) []byte {
+	key := make([]byte, len(SendEnabledPrefix)+len(denom))
+	copy(key, SendEnabledPrefix)
+	copy(key[len(SendEnabledPrefix):], denom)
+	return key
+}
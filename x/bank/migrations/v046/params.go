@@ -0,0 +1,62 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// MigrateParams performs in-place store migrations from v0.45 to v0.46. The
+// migration moves the x/bank params out of the legacy x/params subspace into
+// the x/bank module store.
+func MigrateParams(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	var params types.Params
+	legacySubspace.GetParamSet(ctx, &params)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(ParamsKey, bz)
+	return nil
+}
+
+// MigrateSendEnabled performs in-place store migrations from v0.45 to v0.46.
+// The migration moves the SendEnabled entries out of the x/bank params into
+// their own keyed store, leaving only DefaultSendEnabled in the params.
+//nolint:staticcheck
+func MigrateSendEnabled(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var params types.Params
+	bz := store.Get(ParamsKey)
+	if bz == nil {
+		return nil
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	for _, se := range params.SendEnabled {
+		value := []byte{0x00}
+		if se.Enabled {
+			value = []byte{0x01}
+		}
+		store.Set(CreateSendEnabledKey(se.Denom), value)
+	}
+
+	params.SendEnabled = nil
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(ParamsKey, bz)
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	v043 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v043"
 	v046 "github.com/cosmos/cosmos-sdk/x/bank/migrations/v046"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 func TestMigrateStore(t *testing.T) {
@@ -127,3 +128,47 @@ func assertMetaDataEqual(t *testing.T, expected, actual types.Metadata) {
 	require.Equal(t, expected.GetDenomUnits()[1].GetExponent(), actual.GetDenomUnits()[1].GetExponent())
 	require.Equal(t, expected.GetDenomUnits()[1].GetAliases(), actual.GetDenomUnits()[1].GetAliases())
 }
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	tBankKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(bankKey, tBankKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, bankKey, tBankKey, "bank").WithKeyTable(types.ParamKeyTable())
+
+	// Set the params in the legacy subspace.
+	params := types.NewParams(false, nil).SetSendEnabledParam("foo", true)
+	paramstore.SetParamSet(ctx, &params)
+
+	require.NoError(t, v046.MigrateParams(ctx, bankKey, paramstore, encCfg.Codec))
+
+	// Make sure the params were moved into the module store.
+	var res types.Params
+	bz := ctx.KVStore(bankKey).Get(v046.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
+
+func TestMigrateSendEnabled(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	bankKey := sdk.NewKVStoreKey("bank")
+	ctx := testutil.DefaultContext(bankKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(bankKey)
+
+	params := types.NewParams(false, types.SendEnabledParams{
+		types.NewSendEnabled("foo", true),
+		types.NewSendEnabled("bar", false),
+	})
+	store.Set(v046.ParamsKey, encCfg.Codec.MustMarshal(&params))
+
+	require.NoError(t, v046.MigrateSendEnabled(ctx, bankKey, encCfg.Codec))
+
+	// Make sure the entries were moved out of the params.
+	var res types.Params
+	require.NoError(t, encCfg.Codec.Unmarshal(store.Get(v046.ParamsKey), &res))
+	require.Equal(t, types.NewParams(false, nil), res)
+
+	require.Equal(t, []byte{0x01}, store.Get(v046.CreateSendEnabledKey("foo")))
+	require.Equal(t, []byte{0x00}, store.Get(v046.CreateSendEnabledKey("bar")))
+	require.Nil(t, store.Get(v046.CreateSendEnabledKey("baz")))
+}
@@ -29,7 +29,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the bank module.
 type Params struct {
-	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"`
+	// Deprecated: Use of SendEnabled in params is deprecated.
+	// For genesis, use the send_enabled field of the genesis state.
+	// Storage, lookup, and manipulation of this information is now in the keeper.
+	//
+	// It is only kept for backwards compatibility of genesis files and for the
+	// store migration moving the entries out of the params.
+	SendEnabled        []*SendEnabled `protobuf:"bytes,1,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled,omitempty"` // Deprecated: Do not use.
 	DefaultSendEnabled bool           `protobuf:"varint,2,opt,name=default_send_enabled,json=defaultSendEnabled,proto3" json:"default_send_enabled,omitempty"`
 }
 
@@ -65,6 +71,7 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *Params) GetSendEnabled() []*SendEnabled {
 	if m != nil {
 		return m.SendEnabled
@@ -350,6 +357,9 @@ type Metadata struct {
 	//
 	// Since: cosmos-sdk 0.46
 	URIHash string `protobuf:"bytes,8,opt,name=uri_hash,json=uriHash,proto3" json:"uri_hash,omitempty"`
+	// admin is the address allowed to freeze the holdings of the base denom and
+	// to claw them back. Optional, denoms without an admin are not managed.
+	Admin string `protobuf:"bytes,9,opt,name=admin,proto3" json:"admin,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -441,6 +451,13 @@ func (m *Metadata) GetURIHash() string {
 	return ""
 }
 
+func (m *Metadata) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "cosmos.bank.v1beta1.Params")
 	proto.RegisterType((*SendEnabled)(nil), "cosmos.bank.v1beta1.SendEnabled")
@@ -454,48 +471,49 @@ func init() {
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/bank.proto", fileDescriptor_dd052eee12edf988) }
 
 var fileDescriptor_dd052eee12edf988 = []byte{
-	// 649 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x4d, 0xb2, 0x9d, 0xe8, 0x65, 0x0c, 0x3a, 0xed, 0x61, 0x13, 0xf6, 0x20, 0xb1,
-	0xd0, 0x24, 0xad, 0x9e, 0x82, 0x20, 0xb6, 0x8a, 0x46, 0x10, 0x65, 0x4b, 0x11, 0xbc, 0x84, 0x49,
-	0x76, 0x4c, 0x86, 0xee, 0xce, 0x2c, 0x3b, 0xb3, 0xa5, 0xb9, 0x0a, 0x82, 0x78, 0xf2, 0xe8, 0xb1,
-	0x57, 0x3d, 0x79, 0x28, 0xf8, 0x17, 0x8a, 0xa7, 0xe2, 0xc9, 0x53, 0x95, 0xf4, 0xa0, 0x3f, 0x43,
-	0x66, 0x66, 0x37, 0x4d, 0xa1, 0x8a, 0x07, 0x0f, 0x9e, 0xf6, 0x7d, 0xef, 0x7b, 0xf3, 0xbd, 0xef,
-	0xed, 0xbc, 0x5d, 0xe8, 0x0e, 0x85, 0x8c, 0x84, 0x6c, 0x0f, 0x08, 0xdf, 0x6d, 0xef, 0xad, 0x0f,
-	0xa8, 0x22, 0xeb, 0x06, 0xb4, 0xe2, 0x44, 0x28, 0x81, 0xae, 0x58, 0xbe, 0x65, 0x52, 0x19, 0xbf,
-	0x52, 0x1b, 0x89, 0x91, 0x30, 0x7c, 0x5b, 0x47, 0xb6, 0x74, 0x65, 0xd9, 0x96, 0xf6, 0x2d, 0x91,
-	0x9d, 0xb3, 0xd4, 0x59, 0x17, 0x49, 0x67, 0x5d, 0x86, 0x82, 0xf1, 0x8c, 0xbf, 0x96, 0xf1, 0x91,
-	0x1c, 0xb5, 0xf7, 0xd6, 0xf5, 0xc3, 0x12, 0xde, 0x2b, 0x00, 0xcb, 0x4f, 0x49, 0x42, 0x22, 0x89,
-	0xb6, 0xe0, 0x25, 0x49, 0x79, 0xd0, 0xa7, 0x9c, 0x0c, 0x42, 0x1a, 0x60, 0xd0, 0x28, 0x36, 0xab,
-	0x1b, 0x8d, 0xd6, 0x05, 0x06, 0x5b, 0xdb, 0x94, 0x07, 0xf7, 0x6d, 0x9d, 0x5f, 0x95, 0x67, 0x00,
-	0x75, 0x60, 0x2d, 0xa0, 0x2f, 0x48, 0x1a, 0xaa, 0xfe, 0x39, 0xb1, 0x85, 0x06, 0x68, 0x3a, 0x3e,
-	0xca, 0xb8, 0xb9, 0xe3, 0xdd, 0xc5, 0x77, 0x07, 0xf5, 0x82, 0xf7, 0x00, 0x56, 0xe7, 0x92, 0xa8,
-	0x06, 0x4b, 0x01, 0xe5, 0x22, 0xc2, 0xa0, 0x01, 0x9a, 0x4b, 0xbe, 0x05, 0x08, 0xc3, 0xca, 0x79,
-	0xbd, 0x1c, 0x76, 0x1d, 0x2d, 0xf2, 0xf3, 0xa0, 0x0e, 0xbc, 0x43, 0x00, 0x4b, 0x3d, 0x1e, 0xa7,
-	0x0a, 0x6d, 0xc0, 0x0a, 0x09, 0x82, 0x84, 0x4a, 0x69, 0x55, 0x36, 0xf1, 0x97, 0xc3, 0xb5, 0x5a,
-	0x36, 0xcd, 0x5d, 0xcb, 0x6c, 0xab, 0x84, 0xf1, 0x91, 0x9f, 0x17, 0x22, 0x02, 0x4b, 0xfa, 0xad,
-	0x49, 0xbc, 0x60, 0x86, 0x5f, 0x3e, 0x1b, 0x5e, 0xd2, 0xd9, 0xf0, 0x5b, 0x82, 0xf1, 0xcd, 0xce,
-	0xd1, 0x49, 0xbd, 0xf0, 0xe1, 0x5b, 0xbd, 0x39, 0x62, 0x6a, 0x9c, 0x0e, 0x5a, 0x43, 0x11, 0x65,
-	0x57, 0x92, 0x3d, 0xd6, 0x64, 0xb0, 0xdb, 0x56, 0x93, 0x98, 0x4a, 0x73, 0x40, 0xfa, 0x56, 0xb9,
-	0x5b, 0x7b, 0x6d, 0xad, 0x16, 0x5e, 0xfe, 0xf8, 0xb8, 0x9a, 0x37, 0xf6, 0xde, 0x03, 0x58, 0x7e,
-	0x92, 0xaa, 0xff, 0xd8, 0xb7, 0x93, 0xfb, 0xf6, 0x3e, 0x01, 0x58, 0xde, 0x4e, 0xe3, 0x38, 0x9c,
-	0xe8, 0xbe, 0x4a, 0x28, 0x12, 0x66, 0xcb, 0xf2, 0x6f, 0xfb, 0x1a, 0xe5, 0xee, 0xa3, 0xac, 0x2f,
-	0xf8, 0x7c, 0xb8, 0x76, 0x7b, 0xf5, 0x8f, 0xa7, 0xf7, 0xed, 0x57, 0x16, 0xb1, 0x51, 0x42, 0x14,
-	0x13, 0x5c, 0xb6, 0xf7, 0x3a, 0xb7, 0x3a, 0x2d, 0xeb, 0xb5, 0x87, 0x81, 0xf7, 0x0c, 0x2e, 0xdd,
-	0xd3, 0x9b, 0xb4, 0xc3, 0x99, 0xfa, 0xcd, 0x8e, 0xad, 0x40, 0x87, 0xee, 0xc7, 0x82, 0x53, 0xae,
-	0xcc, 0x92, 0x5d, 0xf6, 0x67, 0x58, 0xef, 0x1f, 0x09, 0x19, 0x91, 0x54, 0xe2, 0x62, 0xa3, 0xd8,
-	0x5c, 0xf2, 0x73, 0xe8, 0xbd, 0x59, 0x80, 0xce, 0x63, 0xaa, 0x48, 0x40, 0x14, 0x41, 0x0d, 0x58,
-	0x0d, 0xa8, 0x1c, 0x26, 0x2c, 0xd6, 0x26, 0x32, 0xf9, 0xf9, 0x14, 0xba, 0xa3, 0x2b, 0xb8, 0x88,
-	0xfa, 0x29, 0x67, 0x2a, 0xbf, 0x34, 0xf7, 0xc2, 0x2f, 0x6d, 0xe6, 0xd7, 0x87, 0x41, 0x1e, 0x4a,
-	0x84, 0xe0, 0xa2, 0x7e, 0xc5, 0xb8, 0x68, 0xb4, 0x4d, 0xac, 0xdd, 0x05, 0x4c, 0xc6, 0x21, 0x99,
-	0xe0, 0x45, 0x93, 0xce, 0xa1, 0xae, 0xe6, 0x24, 0xa2, 0xb8, 0x64, 0xab, 0x75, 0x8c, 0xae, 0xc2,
-	0xb2, 0x9c, 0x44, 0x03, 0x11, 0xe2, 0xb2, 0xc9, 0x66, 0x08, 0x2d, 0xc3, 0x62, 0x9a, 0x30, 0x5c,
-	0x31, 0x9b, 0x57, 0x99, 0x9e, 0xd4, 0x8b, 0x3b, 0x7e, 0xcf, 0xd7, 0x39, 0x74, 0x1d, 0x3a, 0x69,
-	0xc2, 0xfa, 0x63, 0x22, 0xc7, 0xd8, 0x31, 0x7c, 0x75, 0x7a, 0x52, 0xaf, 0xec, 0xf8, 0xbd, 0x87,
-	0x44, 0x8e, 0xfd, 0x4a, 0x9a, 0x30, 0x1d, 0x6c, 0x6e, 0x1d, 0x4d, 0x5d, 0x70, 0x3c, 0x75, 0xc1,
-	0xf7, 0xa9, 0x0b, 0xde, 0x9e, 0xba, 0x85, 0xe3, 0x53, 0xb7, 0xf0, 0xf5, 0xd4, 0x2d, 0x3c, 0xbf,
-	0xf1, 0x37, 0xd7, 0x67, 0x76, 0x60, 0x50, 0x36, 0xff, 0xa7, 0x9b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x8f, 0x9f, 0xc3, 0x47, 0x40, 0x05, 0x00, 0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x3d, 0x6f, 0x13, 0x4b,
+	0x14, 0xf5, 0xd8, 0xf1, 0xd7, 0xf8, 0xbd, 0x66, 0x9e, 0xf5, 0x98, 0xa4, 0x58, 0x5b, 0x2e, 0x90,
+	0x89, 0x94, 0xb5, 0x13, 0xa8, 0x2c, 0x24, 0x84, 0x03, 0x0a, 0x46, 0x42, 0xa0, 0x8d, 0x22, 0x24,
+	0x1a, 0x6b, 0xec, 0x1d, 0xec, 0x51, 0x76, 0x67, 0x56, 0x3b, 0xb3, 0x51, 0xdc, 0x52, 0x41, 0x47,
+	0x49, 0x99, 0x16, 0x2a, 0x8a, 0x48, 0x48, 0xfc, 0x82, 0x88, 0x2a, 0xa2, 0xa2, 0x0a, 0xc8, 0x29,
+	0xe0, 0x67, 0xa0, 0x99, 0xd9, 0x75, 0x12, 0x29, 0x7c, 0x34, 0x48, 0x54, 0x7b, 0xef, 0x3d, 0x67,
+	0xce, 0x3d, 0xbe, 0x73, 0xc7, 0xd0, 0x19, 0x0b, 0x19, 0x0a, 0xd9, 0x19, 0x11, 0xbe, 0xdb, 0xd9,
+	0x5b, 0x1f, 0x51, 0x45, 0xd6, 0x4d, 0xe2, 0x46, 0xb1, 0x50, 0x02, 0xfd, 0x67, 0x71, 0xd7, 0x94,
+	0x52, 0x7c, 0xa5, 0x3e, 0x11, 0x13, 0x61, 0xf0, 0x8e, 0x8e, 0x2c, 0x75, 0x65, 0xd9, 0x52, 0x87,
+	0x16, 0x48, 0xcf, 0x59, 0xe8, 0xac, 0x8b, 0xa4, 0x8b, 0x2e, 0x63, 0xc1, 0x78, 0x8a, 0x5f, 0x49,
+	0xf1, 0x50, 0x4e, 0x3a, 0x7b, 0xeb, 0xfa, 0x63, 0x81, 0xd6, 0x0b, 0x00, 0x4b, 0x8f, 0x48, 0x4c,
+	0x42, 0x89, 0xb6, 0xe0, 0x3f, 0x92, 0x72, 0x7f, 0x48, 0x39, 0x19, 0x05, 0xd4, 0xc7, 0xa0, 0x59,
+	0x68, 0xd7, 0x36, 0x9a, 0xee, 0x25, 0x06, 0xdd, 0x6d, 0xca, 0xfd, 0xbb, 0x96, 0xd7, 0xcf, 0x63,
+	0xe0, 0xd5, 0xe4, 0x59, 0x01, 0x75, 0x61, 0xdd, 0xa7, 0x4f, 0x49, 0x12, 0xa8, 0xe1, 0x05, 0xc1,
+	0x7c, 0x13, 0xb4, 0x2b, 0x1e, 0x4a, 0xb1, 0x73, 0x12, 0xbd, 0xa5, 0x57, 0x07, 0x8d, 0x5c, 0x6b,
+	0x0b, 0xd6, 0xce, 0x15, 0x51, 0x1d, 0x16, 0x7d, 0xca, 0x45, 0x88, 0x41, 0x13, 0xb4, 0xab, 0x9e,
+	0x4d, 0x10, 0x86, 0xe5, 0x8b, 0x7a, 0x59, 0xda, 0xab, 0x68, 0x91, 0x6f, 0x07, 0x0d, 0xd0, 0x3a,
+	0x04, 0xb0, 0x38, 0xe0, 0x51, 0xa2, 0xd0, 0x06, 0x2c, 0x13, 0xdf, 0x8f, 0xa9, 0x94, 0x56, 0xa5,
+	0x8f, 0x3f, 0x1e, 0xae, 0xd5, 0xd3, 0x5f, 0x74, 0xdb, 0x22, 0xdb, 0x2a, 0x66, 0x7c, 0xe2, 0x65,
+	0x44, 0x44, 0x60, 0x51, 0x4f, 0x4e, 0xe2, 0xbc, 0x19, 0xc0, 0xf2, 0xd9, 0x00, 0x24, 0x5d, 0x0c,
+	0x60, 0x53, 0x30, 0xde, 0xef, 0x1e, 0x9d, 0x34, 0x72, 0x6f, 0x3e, 0x37, 0xda, 0x13, 0xa6, 0xa6,
+	0xc9, 0xc8, 0x1d, 0x8b, 0x30, 0xbd, 0x96, 0xf4, 0xb3, 0x26, 0xfd, 0xdd, 0x8e, 0x9a, 0x45, 0x54,
+	0x9a, 0x03, 0xd2, 0xb3, 0xca, 0xbd, 0xfa, 0x73, 0x6b, 0x35, 0xf7, 0xec, 0xeb, 0xdb, 0xd5, 0xac,
+	0x71, 0xeb, 0x35, 0x80, 0xa5, 0x87, 0x89, 0xfa, 0x8b, 0x7d, 0x57, 0x32, 0xdf, 0xad, 0x77, 0x00,
+	0x96, 0xb6, 0x93, 0x28, 0x0a, 0x66, 0xba, 0xaf, 0x12, 0x8a, 0x04, 0x18, 0xfc, 0x81, 0xbe, 0x46,
+	0xb9, 0x77, 0x3f, 0xed, 0x0b, 0x3e, 0x1c, 0xae, 0xdd, 0x5c, 0xfd, 0xe9, 0xe9, 0x7d, 0xfb, 0xd2,
+	0x42, 0x36, 0x89, 0x89, 0x62, 0x82, 0xcb, 0xce, 0x5e, 0xf7, 0x46, 0xd7, 0xb5, 0x5e, 0x07, 0x18,
+	0xb4, 0x1e, 0xc3, 0xea, 0x1d, 0xbd, 0x49, 0x3b, 0x9c, 0xa9, 0x1f, 0xec, 0xd8, 0x0a, 0xac, 0xd0,
+	0xfd, 0x48, 0x70, 0xca, 0x95, 0x59, 0xb2, 0x7f, 0xbd, 0x45, 0xae, 0xf7, 0x8f, 0x04, 0x8c, 0x48,
+	0x2a, 0x71, 0xa1, 0x59, 0x68, 0x57, 0xbd, 0x2c, 0x6d, 0xbd, 0xcf, 0xc3, 0xca, 0x03, 0xaa, 0x88,
+	0x4f, 0x14, 0x41, 0x4d, 0x58, 0xf3, 0xa9, 0x1c, 0xc7, 0x2c, 0xd2, 0x26, 0x52, 0xf9, 0xf3, 0x25,
+	0x74, 0x4b, 0x33, 0xb8, 0x08, 0x87, 0x09, 0x67, 0x2a, 0xbb, 0x34, 0xe7, 0xd2, 0xd7, 0xb6, 0xf0,
+	0xeb, 0x41, 0x3f, 0x0b, 0x25, 0x42, 0x70, 0x49, 0x8f, 0x18, 0x17, 0x8c, 0xb6, 0x89, 0xb5, 0x3b,
+	0x9f, 0xc9, 0x28, 0x20, 0x33, 0xbc, 0x64, 0xca, 0x59, 0xaa, 0xd9, 0x9c, 0x84, 0x14, 0x17, 0x2d,
+	0x5b, 0xc7, 0xe8, 0x7f, 0x58, 0x92, 0xb3, 0x70, 0x24, 0x02, 0x5c, 0x32, 0xd5, 0x34, 0x43, 0xcb,
+	0xb0, 0x90, 0xc4, 0x0c, 0x97, 0xcd, 0xe6, 0x95, 0xe7, 0x27, 0x8d, 0xc2, 0x8e, 0x37, 0xf0, 0x74,
+	0x0d, 0x5d, 0x85, 0x95, 0x24, 0x66, 0xc3, 0x29, 0x91, 0x53, 0x5c, 0x31, 0x78, 0x6d, 0x7e, 0xd2,
+	0x28, 0xef, 0x78, 0x83, 0x7b, 0x44, 0x4e, 0xbd, 0x72, 0x12, 0x33, 0x1d, 0x20, 0x17, 0x16, 0x89,
+	0x1f, 0x32, 0x8e, 0xab, 0xbf, 0x58, 0x5f, 0x4b, 0xeb, 0x6f, 0x1e, 0xcd, 0x1d, 0x70, 0x3c, 0x77,
+	0xc0, 0x97, 0xb9, 0x03, 0x5e, 0x9e, 0x3a, 0xb9, 0xe3, 0x53, 0x27, 0xf7, 0xe9, 0xd4, 0xc9, 0x3d,
+	0xb9, 0xf6, 0x3b, 0xd7, 0x6d, 0x76, 0x66, 0x54, 0x32, 0xff, 0x69, 0xd7, 0xbf, 0x0f, 0x00, 0x29,
+	0x0f, 0x4b, 0xee, 0x74, 0x05, 0x00, 0x00,
 }
 
 func (this *SendEnabled) Equal(that interface{}) bool {
@@ -830,6 +848,13 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintBank(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.URIHash) > 0 {
 		i -= len(m.URIHash)
 		copy(dAtA[i:], m.URIHash)
@@ -1056,6 +1081,10 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBank(uint64(l))
 	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovBank(uint64(l))
+	}
 	return n
 }
 
@@ -2007,6 +2036,38 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.URIHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBank
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBank
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBank(dAtA[iNdEx:])
@@ -14,12 +14,26 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSend{}, "cosmos-sdk/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgMultiSend{}, "cosmos-sdk/MsgMultiSend", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "cosmos-sdk/x/bank/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetSendEnabled{}, "cosmos-sdk/MsgSetSendEnabled", nil)
+	cdc.RegisterConcrete(&MsgSetDenomAdmin{}, "cosmos-sdk/MsgSetDenomAdmin", nil)
+	cdc.RegisterConcrete(&MsgFreeze{}, "cosmos-sdk/MsgFreeze", nil)
+	cdc.RegisterConcrete(&MsgUnfreeze{}, "cosmos-sdk/MsgUnfreeze", nil)
+	cdc.RegisterConcrete(&MsgSetGlobalFreeze{}, "cosmos-sdk/MsgSetGlobalFreeze", nil)
+	cdc.RegisterConcrete(&MsgClawback{}, "cosmos-sdk/MsgClawback", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
 		&MsgMultiSend{},
+		&MsgUpdateParams{},
+		&MsgSetSendEnabled{},
+		&MsgSetDenomAdmin{},
+		&MsgFreeze{},
+		&MsgUnfreeze{},
+		&MsgSetGlobalFreeze{},
+		&MsgClawback{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -23,6 +23,18 @@ const (
 	AttributeKeyReceiver = "receiver"
 	AttributeKeyMinter   = "minter"
 	AttributeKeyBurner   = "burner"
+
+	// denom admin events name and attributes
+	EventTypeSetDenomAdmin   = "set_denom_admin"
+	EventTypeFreeze          = "freeze"
+	EventTypeUnfreeze        = "unfreeze"
+	EventTypeSetGlobalFreeze = "set_global_freeze"
+	EventTypeClawback        = "clawback"
+
+	AttributeKeyDenom   = "denom"
+	AttributeKeyAdmin   = "admin"
+	AttributeKeyAddress = "address"
+	AttributeKeyFrozen  = "frozen"
 )
 
 // NewCoinSpentEvent constructs a new coin spent sdk.Event
@@ -26,3 +26,9 @@ type AccountKeeper interface {
 	GetModuleAccount(ctx sdk.Context, moduleName string) types.ModuleAccountI
 	SetModuleAccount(ctx sdk.Context, macc types.ModuleAccountI)
 }
+
+// BankHooks event hooks for transfers between accounts (noalias)
+type BankHooks interface {
+	BeforeSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error // Must be called before coins are moved from fromAddr to toAddr
+	AfterSend(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error  // Must be called after coins are moved from fromAddr to toAddr
+}
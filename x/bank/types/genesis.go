@@ -15,6 +15,23 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	seenSendEnabled := make(map[string]bool)
+	for _, p := range gs.Params.SendEnabled {
+		seenSendEnabled[p.Denom] = true
+	}
+
+	for _, se := range gs.SendEnabled {
+		if seenSendEnabled[se.Denom] {
+			return fmt.Errorf("duplicate send enabled found: '%s'", se.Denom)
+		}
+
+		if err := se.Validate(); err != nil {
+			return err
+		}
+
+		seenSendEnabled[se.Denom] = true
+	}
+
 	seenBalances := make(map[string]bool)
 	seenMetadatas := make(map[string]bool)
 
@@ -46,6 +63,19 @@ func (gs GenesisState) Validate() error {
 		seenMetadatas[metadata.Base] = true
 	}
 
+	seenFreezes := make(map[string]bool)
+	for _, freeze := range gs.DenomFreezes {
+		if seenFreezes[freeze.Denom] {
+			return fmt.Errorf("duplicate denom freeze for denom %s", freeze.Denom)
+		}
+
+		if err := freeze.Validate(); err != nil {
+			return err
+		}
+
+		seenFreezes[freeze.Denom] = true
+	}
+
 	if !gs.Supply.Empty() {
 		// NOTE: this errors if supply for any given coin is zero
 		err := gs.Supply.Validate()
@@ -61,19 +91,45 @@ func (gs GenesisState) Validate() error {
 	return nil
 }
 
+// Validate performs basic validation of a denom freeze.
+func (df DenomFreeze) Validate() error {
+	if err := sdk.ValidateDenom(df.Denom); err != nil {
+		return err
+	}
+
+	seenAddrs := make(map[string]bool)
+	for _, addr := range df.FrozenAddresses {
+		if seenAddrs[addr] {
+			return fmt.Errorf("duplicate frozen address %s for denom %s", addr, df.Denom)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid frozen address %s for denom %s: %w", addr, df.Denom, err)
+		}
+
+		seenAddrs[addr] = true
+	}
+
+	return nil
+}
+
 // NewGenesisState creates a new genesis state.
-func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata) *GenesisState {
+func NewGenesisState(params Params, balances []Balance, supply sdk.Coins, denomMetaData []Metadata, sendEnabled []SendEnabled) *GenesisState {
 	return &GenesisState{
 		Params:        params,
 		Balances:      balances,
 		Supply:        supply,
 		DenomMetadata: denomMetaData,
+		SendEnabled:   sendEnabled,
 	}
 }
 
 // DefaultGenesisState returns a default bank module genesis state.
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{})
+	genState := NewGenesisState(DefaultParams(), []Balance{}, sdk.Coins{}, []Metadata{}, []SendEnabled{})
+	genState.DenomFreezes = []DenomFreeze{}
+
+	return genState
 }
 
 // GetGenesisStateFromAppState returns x/bank GenesisState given raw application
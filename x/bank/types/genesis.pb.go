@@ -37,6 +37,10 @@ type GenesisState struct {
 	Supply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=supply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"supply"`
 	// denom_metadata defines the metadata of the differents coins.
 	DenomMetadata []Metadata `protobuf:"bytes,4,rep,name=denom_metadata,json=denomMetadata,proto3" json:"denom_metadata"`
+	// send_enabled defines the denoms where send is enabled or disabled.
+	SendEnabled []SendEnabled `protobuf:"bytes,5,rep,name=send_enabled,json=sendEnabled,proto3" json:"send_enabled"`
+	// denom_freezes defines the freezes set by the admins of the denoms.
+	DenomFreezes []DenomFreeze `protobuf:"bytes,6,rep,name=denom_freezes,json=denomFreezes,proto3" json:"denom_freezes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -100,6 +104,85 @@ func (m *GenesisState) GetDenomMetadata() []Metadata {
 	return nil
 }
 
+func (m *GenesisState) GetSendEnabled() []SendEnabled {
+	if m != nil {
+		return m.SendEnabled
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomFreezes() []DenomFreeze {
+	if m != nil {
+		return m.DenomFreezes
+	}
+	return nil
+}
+
+// DenomFreeze defines the frozen addresses of a denom, and whether it is
+// globally frozen, used in the bank module's genesis state.
+type DenomFreeze struct {
+	// denom is the frozen denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// global_frozen is true when all the transfers of the denom are frozen.
+	GlobalFrozen bool `protobuf:"varint,2,opt,name=global_frozen,json=globalFrozen,proto3" json:"global_frozen,omitempty"`
+	// frozen_addresses are the addresses whose holdings of the denom are frozen.
+	FrozenAddresses []string `protobuf:"bytes,3,rep,name=frozen_addresses,json=frozenAddresses,proto3" json:"frozen_addresses,omitempty"`
+}
+
+func (m *DenomFreeze) Reset()         { *m = DenomFreeze{} }
+func (m *DenomFreeze) String() string { return proto.CompactTextString(m) }
+func (*DenomFreeze) ProtoMessage()    {}
+func (*DenomFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f007de11b420c6e, []int{1}
+}
+func (m *DenomFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomFreeze.Merge(m, src)
+}
+func (m *DenomFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomFreeze proto.InternalMessageInfo
+
+func (m *DenomFreeze) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomFreeze) GetGlobalFrozen() bool {
+	if m != nil {
+		return m.GlobalFrozen
+	}
+	return false
+}
+
+func (m *DenomFreeze) GetFrozenAddresses() []string {
+	if m != nil {
+		return m.FrozenAddresses
+	}
+	return nil
+}
+
 // Balance defines an account address and balance pair used in the bank module's
 // genesis state.
 type Balance struct {
@@ -113,7 +196,7 @@ func (m *Balance) Reset()         { *m = Balance{} }
 func (m *Balance) String() string { return proto.CompactTextString(m) }
 func (*Balance) ProtoMessage()    {}
 func (*Balance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f007de11b420c6e, []int{1}
+	return fileDescriptor_8f007de11b420c6e, []int{2}
 }
 func (m *Balance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,38 +227,46 @@ var xxx_messageInfo_Balance proto.InternalMessageInfo
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.bank.v1beta1.GenesisState")
+	proto.RegisterType((*DenomFreeze)(nil), "cosmos.bank.v1beta1.DenomFreeze")
 	proto.RegisterType((*Balance)(nil), "cosmos.bank.v1beta1.Balance")
 }
 
 func init() { proto.RegisterFile("cosmos/bank/v1beta1/genesis.proto", fileDescriptor_8f007de11b420c6e) }
 
 var fileDescriptor_8f007de11b420c6e = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3f, 0x4f, 0xfa, 0x40,
-	0x18, 0xc7, 0x5b, 0xe0, 0x07, 0xfc, 0x0e, 0x75, 0xa8, 0x0c, 0x05, 0xb5, 0x45, 0x26, 0x1c, 0x68,
-	0x05, 0x27, 0x1d, 0x4c, 0x2c, 0x83, 0x89, 0x89, 0x89, 0x81, 0xcd, 0x85, 0x5c, 0xdb, 0x4b, 0x6d,
-	0xa0, 0xbd, 0xa6, 0x77, 0x18, 0x79, 0x07, 0x8e, 0xbe, 0x04, 0x66, 0x66, 0x27, 0x5f, 0x01, 0x23,
-	0x71, 0x72, 0x52, 0x03, 0x8b, 0x2f, 0xc3, 0xf4, 0xee, 0xa8, 0x26, 0x12, 0x27, 0xa7, 0xfe, 0xf9,
-	0x7e, 0x3f, 0xdf, 0xe7, 0x79, 0xee, 0x1e, 0xb0, 0xef, 0x60, 0x12, 0x60, 0x62, 0xda, 0x30, 0x1c,
-	0x98, 0xb7, 0x2d, 0x1b, 0x51, 0xd8, 0x32, 0x3d, 0x14, 0x22, 0xe2, 0x13, 0x23, 0x8a, 0x31, 0xc5,
-	0xca, 0x36, 0xb7, 0x18, 0x89, 0xc5, 0x10, 0x96, 0x6a, 0xd9, 0xc3, 0x1e, 0x66, 0xba, 0x99, 0xbc,
-	0x71, 0x6b, 0x55, 0x4b, 0xd3, 0x08, 0x4a, 0xd3, 0x1c, 0xec, 0x87, 0x3f, 0xf4, 0x6f, 0xd5, 0x58,
-	0x2e, 0xd7, 0x2b, 0x5c, 0xef, 0xf3, 0x60, 0x51, 0x97, 0x7d, 0xd4, 0x9f, 0x32, 0x60, 0xe3, 0x9c,
-	0xf7, 0xd5, 0xa3, 0x90, 0x22, 0xe5, 0x18, 0xe4, 0x23, 0x18, 0xc3, 0x80, 0xa8, 0x72, 0x4d, 0x6e,
-	0x94, 0xda, 0x3b, 0xc6, 0x9a, 0x3e, 0x8d, 0x2b, 0x66, 0xb1, 0x72, 0xb3, 0x57, 0x5d, 0xea, 0x0a,
-	0x40, 0x39, 0x05, 0x45, 0x1b, 0x0e, 0x61, 0xe8, 0x20, 0xa2, 0x66, 0x6a, 0xd9, 0x46, 0xa9, 0xbd,
-	0xbb, 0x16, 0xb6, 0xb8, 0x49, 0xd0, 0x29, 0xa3, 0x38, 0x20, 0x4f, 0x46, 0x51, 0x34, 0x1c, 0xab,
-	0x59, 0x46, 0x57, 0xbe, 0x68, 0x82, 0x52, 0xba, 0x83, 0xfd, 0xd0, 0x3a, 0x4c, 0xd0, 0xe9, 0x9b,
-	0xde, 0xf0, 0x7c, 0x7a, 0x33, 0xb2, 0x0d, 0x07, 0x07, 0x62, 0x2e, 0xf1, 0x68, 0x12, 0x77, 0x60,
-	0xd2, 0x71, 0x84, 0x08, 0x03, 0x48, 0x57, 0x44, 0x2b, 0x17, 0x60, 0xcb, 0x45, 0x21, 0x0e, 0xfa,
-	0x01, 0xa2, 0xd0, 0x85, 0x14, 0xaa, 0x39, 0x56, 0x6c, 0x6f, 0x6d, 0xab, 0x97, 0xc2, 0x24, 0x7a,
-	0xdd, 0x64, 0xe8, 0xea, 0x67, 0x7d, 0x2a, 0x83, 0x82, 0x18, 0x46, 0x69, 0x83, 0x02, 0x74, 0xdd,
-	0x18, 0x11, 0x7e, 0x70, 0xff, 0x2d, 0xf5, 0xf9, 0xb1, 0x59, 0x16, 0x99, 0x67, 0x5c, 0xe9, 0xd1,
-	0xd8, 0x0f, 0xbd, 0xee, 0xca, 0xa8, 0x40, 0xf0, 0x2f, 0xb9, 0xc5, 0xd5, 0x69, 0xfd, 0xe9, 0xbc,
-	0x3c, 0xf9, 0xa4, 0x78, 0x3f, 0xd1, 0xa5, 0x8f, 0x89, 0x2e, 0x59, 0x9d, 0xd9, 0x42, 0x93, 0xe7,
-	0x0b, 0x4d, 0x7e, 0x5f, 0x68, 0xf2, 0xc3, 0x52, 0x93, 0xe6, 0x4b, 0x4d, 0x7a, 0x59, 0x6a, 0xd2,
-	0xf5, 0xc1, 0xaf, 0xa1, 0x77, 0x7c, 0xad, 0x58, 0xb6, 0x9d, 0x67, 0x5b, 0x73, 0xf4, 0x19, 0x00,
-	0x00, 0xff, 0xff, 0x78, 0xd8, 0xf3, 0x7f, 0xe0, 0x02, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xb1, 0x6e, 0xd3, 0x40,
+	0x1c, 0xc6, 0xed, 0xa6, 0x49, 0xd3, 0x4b, 0x0a, 0xe8, 0xc8, 0xe0, 0x16, 0x70, 0x42, 0x58, 0xc2,
+	0x50, 0x87, 0x86, 0x09, 0x06, 0xa4, 0x26, 0x50, 0x04, 0x08, 0x09, 0x39, 0x1b, 0x8b, 0x75, 0xb6,
+	0xff, 0x35, 0x56, 0xed, 0x3b, 0xcb, 0x7f, 0x17, 0xd1, 0x3e, 0x01, 0x0b, 0x12, 0x8f, 0xd0, 0xb9,
+	0x33, 0x0f, 0xd1, 0xb1, 0xea, 0xc4, 0x04, 0x28, 0x59, 0x78, 0x0c, 0xe4, 0xbb, 0x4b, 0x52, 0x89,
+	0x28, 0x53, 0xa7, 0xe4, 0xfe, 0xdf, 0xf7, 0xfd, 0xee, 0xb3, 0xee, 0x8e, 0x3c, 0x0c, 0x04, 0xa6,
+	0x02, 0xfb, 0x3e, 0xe3, 0x47, 0xfd, 0xcf, 0x7b, 0x3e, 0x14, 0x6c, 0xaf, 0x1f, 0x01, 0x07, 0x8c,
+	0xd1, 0xc9, 0x72, 0x51, 0x08, 0x7a, 0x57, 0x59, 0x9c, 0xd2, 0xe2, 0x68, 0xcb, 0x4e, 0x2b, 0x12,
+	0x91, 0x90, 0x7a, 0xbf, 0xfc, 0xa7, 0xac, 0x3b, 0xf6, 0x9c, 0x86, 0x30, 0xa7, 0x05, 0x22, 0xe6,
+	0xff, 0xe9, 0xd7, 0x76, 0x93, 0x5c, 0xa5, 0x6f, 0x2b, 0xdd, 0x53, 0x60, 0xbd, 0xaf, 0x5c, 0x74,
+	0xaf, 0x2a, 0xa4, 0xf9, 0x5a, 0xf5, 0x1a, 0x17, 0xac, 0x00, 0xfa, 0x8c, 0xd4, 0x32, 0x96, 0xb3,
+	0x14, 0x2d, 0xb3, 0x63, 0xf6, 0x1a, 0x83, 0x7b, 0xce, 0x92, 0x9e, 0xce, 0x07, 0x69, 0x19, 0xae,
+	0x5f, 0xfc, 0x6a, 0x1b, 0xae, 0x0e, 0xd0, 0x17, 0xa4, 0xee, 0xb3, 0x84, 0xf1, 0x00, 0xd0, 0x5a,
+	0xeb, 0x54, 0x7a, 0x8d, 0xc1, 0xfd, 0xa5, 0xe1, 0xa1, 0x32, 0xe9, 0xf4, 0x3c, 0x43, 0x03, 0x52,
+	0xc3, 0xe3, 0x2c, 0x4b, 0x4e, 0xac, 0x8a, 0x4c, 0x6f, 0x2f, 0xd2, 0x08, 0xf3, 0xf4, 0x48, 0xc4,
+	0x7c, 0xf8, 0xa4, 0x8c, 0x9e, 0xff, 0x6e, 0xf7, 0xa2, 0xb8, 0xf8, 0x74, 0xec, 0x3b, 0x81, 0x48,
+	0xf5, 0x77, 0xe9, 0x9f, 0x5d, 0x0c, 0x8f, 0xfa, 0xc5, 0x49, 0x06, 0x28, 0x03, 0xe8, 0x6a, 0x34,
+	0x7d, 0x4b, 0x6e, 0x85, 0xc0, 0x45, 0xea, 0xa5, 0x50, 0xb0, 0x90, 0x15, 0xcc, 0x5a, 0x97, 0x9b,
+	0x3d, 0x58, 0x5a, 0xf5, 0xbd, 0x36, 0xe9, 0xae, 0x5b, 0x32, 0x3a, 0x1b, 0xd2, 0x37, 0xa4, 0x89,
+	0xc0, 0x43, 0x0f, 0x38, 0xf3, 0x13, 0x08, 0xad, 0xaa, 0x24, 0x75, 0x96, 0x92, 0xc6, 0xc0, 0xc3,
+	0x57, 0xca, 0xa7, 0x61, 0x0d, 0x5c, 0x8c, 0xe8, 0x3b, 0xa2, 0xd8, 0xde, 0x61, 0x0e, 0x70, 0x0a,
+	0x68, 0xd5, 0x56, 0xb0, 0x5e, 0x96, 0xce, 0x03, 0x69, 0xd4, 0xac, 0x66, 0xb8, 0x18, 0x61, 0xf7,
+	0x9b, 0x49, 0x1a, 0xd7, 0x3c, 0xb4, 0x45, 0xaa, 0x52, 0x97, 0x47, 0xba, 0xe9, 0xaa, 0x05, 0x7d,
+	0x44, 0xb6, 0xa2, 0x44, 0xf8, 0x2c, 0xf1, 0x0e, 0x73, 0x71, 0x0a, 0xdc, 0x5a, 0xeb, 0x98, 0xbd,
+	0xba, 0xdb, 0x54, 0xc3, 0x03, 0x39, 0xa3, 0x23, 0x72, 0x47, 0xa9, 0x1e, 0x0b, 0xc3, 0x1c, 0x10,
+	0x01, 0xe5, 0xe9, 0x6c, 0x0e, 0xad, 0xab, 0x1f, 0xbb, 0x2d, 0xdd, 0x6e, 0x5f, 0x69, 0xe3, 0x22,
+	0x8f, 0x79, 0xe4, 0xde, 0x56, 0x89, 0xfd, 0x59, 0xa0, 0x7b, 0x6e, 0x92, 0x0d, 0x7d, 0xe8, 0x74,
+	0x40, 0x36, 0x34, 0x49, 0xb5, 0x59, 0xc1, 0x99, 0x19, 0x29, 0x23, 0xd5, 0xf2, 0xb6, 0xcf, 0x6e,
+	0xd5, 0x8d, 0xde, 0x0b, 0x45, 0x7e, 0x5e, 0xff, 0x7a, 0xd6, 0x36, 0xfe, 0x9e, 0xb5, 0x8d, 0xe1,
+	0xe8, 0x62, 0x62, 0x9b, 0x97, 0x13, 0xdb, 0xfc, 0x33, 0xb1, 0xcd, 0xef, 0x53, 0xdb, 0xb8, 0x9c,
+	0xda, 0xc6, 0xcf, 0xa9, 0x6d, 0x7c, 0x7c, 0xbc, 0x12, 0xfa, 0x45, 0x3d, 0x3f, 0xc9, 0xf6, 0x6b,
+	0xf2, 0x75, 0x3d, 0xfd, 0x37, 0x00, 0xbe, 0x1c, 0x4b, 0x7d, 0x08, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +289,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomFreezes) > 0 {
+		for iNdEx := len(m.DenomFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SendEnabled) > 0 {
+		for iNdEx := len(m.SendEnabled) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendEnabled[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.DenomMetadata) > 0 {
 		for iNdEx := len(m.DenomMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -253,6 +372,55 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FrozenAddresses) > 0 {
+		for iNdEx := len(m.FrozenAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FrozenAddresses[iNdEx])
+			copy(dAtA[i:], m.FrozenAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.FrozenAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GlobalFrozen {
+		i--
+		if m.GlobalFrozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Balance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -334,6 +502,40 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.SendEnabled) > 0 {
+		for _, e := range m.SendEnabled {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomFreezes) > 0 {
+		for _, e := range m.DenomFreezes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.GlobalFrozen {
+		n += 2
+	}
+	if len(m.FrozenAddresses) > 0 {
+		for _, s := range m.FrozenAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -526,6 +728,208 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendEnabled", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendEnabled = append(m.SendEnabled, SendEnabled{})
+			if err := m.SendEnabled[len(m.SendEnabled)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomFreezes = append(m.DenomFreezes, DenomFreeze{})
+			if err := m.DenomFreezes[len(m.DenomFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalFrozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.GlobalFrozen = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenAddresses = append(m.FrozenAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
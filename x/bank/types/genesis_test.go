@@ -141,6 +141,67 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid send enabled",
+			GenesisState{
+				Params:      NewParams(true, SendEnabledParams{NewSendEnabled("foo", true)}),
+				SendEnabled: []SendEnabled{{Denom: "bar", Enabled: false}},
+			},
+			false,
+		},
+		{
+			"invalid send enabled denom",
+			GenesisState{
+				SendEnabled: []SendEnabled{{Denom: "", Enabled: false}},
+			},
+			true,
+		},
+		{
+			"duplicate send enabled",
+			GenesisState{
+				SendEnabled: []SendEnabled{{Denom: "foo", Enabled: true}, {Denom: "foo", Enabled: false}},
+			},
+			true,
+		},
+		{
+			"send enabled duplicated in params",
+			GenesisState{
+				Params:      NewParams(true, SendEnabledParams{NewSendEnabled("foo", true)}),
+				SendEnabled: []SendEnabled{{Denom: "foo", Enabled: false}},
+			},
+			true,
+		},
+		{
+			"valid denom freezes",
+			GenesisState{
+				DenomFreezes: []DenomFreeze{
+					{Denom: "foo", GlobalFrozen: true},
+					{Denom: "bar", FrozenAddresses: []string{sdk.AccAddress("addr1_______________").String()}},
+				},
+			},
+			false,
+		},
+		{
+			"duplicate denom freeze",
+			GenesisState{
+				DenomFreezes: []DenomFreeze{{Denom: "foo", GlobalFrozen: true}, {Denom: "foo"}},
+			},
+			true,
+		},
+		{
+			"invalid frozen address",
+			GenesisState{
+				DenomFreezes: []DenomFreeze{{Denom: "foo", FrozenAddresses: []string{"invalid"}}},
+			},
+			true,
+		},
+		{
+			"duplicate frozen address",
+			GenesisState{
+				DenomFreezes: []DenomFreeze{{Denom: "foo", FrozenAddresses: []string{sdk.AccAddress("addr1_______________").String(), sdk.AccAddress("addr1_______________").String()}}},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -25,6 +25,10 @@ var (
 	SupplyKey           = []byte{0x00}
 	DenomMetadataPrefix = []byte{0x1}
 	DenomAddressPrefix  = []byte{0x03}
+	SendEnabledPrefix   = []byte{0x04}
+	ParamsKey           = []byte{0x05}
+	FrozenPrefix        = []byte{0x06}
+	GlobalFreezePrefix  = []byte{0x07}
 
 	// BalancesPrefix is the prefix for the account balances store. We use a byte
 	// (instead of `[]byte("balances")` to save some disk space).
@@ -67,3 +71,51 @@ func CreateDenomAddressPrefix(denom string) []byte {
 	copy(key[len(DenomAddressPrefix):], denom)
 	return key
 }
+
+// CreateSendEnabledKey creates the key of the SendEnabled flag of a denom.
+func CreateSendEnabledKey(denom string) []byte {
+	key := make([]byte, len(SendEnabledPrefix)+len(denom))
+	copy(key, SendEnabledPrefix)
+	copy(key[len(SendEnabledPrefix):], denom)
+	return key
+}
+
+// CreateFrozenDenomPrefix creates the prefix of the frozen addresses of a denom.
+func CreateFrozenDenomPrefix(denom string) []byte {
+	// like the denom address index, a null byte terminates the denom to allow
+	// prefix scans of a single denom.
+	key := make([]byte, len(FrozenPrefix)+len(denom)+1)
+	copy(key, FrozenPrefix)
+	copy(key[len(FrozenPrefix):], denom)
+	return key
+}
+
+// CreateFrozenKey creates the key marking the holdings of a denom of an
+// address as frozen.
+func CreateFrozenKey(denom string, addr []byte) []byte {
+	return append(CreateFrozenDenomPrefix(denom), address.MustLengthPrefix(addr)...)
+}
+
+// AddressFromFrozenDenomStore returns an account address from a frozen denom
+// prefix store. The key must not contain the prefix CreateFrozenDenomPrefix as
+// the prefix store iterator discards the actual prefix.
+func AddressFromFrozenDenomStore(key []byte) (sdk.AccAddress, error) {
+	if len(key) == 0 {
+		return nil, ErrInvalidKey
+	}
+
+	addrBound := int(key[0])
+	if len(key)-1 != addrBound {
+		return nil, ErrInvalidKey
+	}
+
+	return key[1:], nil
+}
+
+// CreateGlobalFreezeKey creates the key of the global freeze flag of a denom.
+func CreateGlobalFreezeKey(denom string) []byte {
+	key := make([]byte, len(GlobalFreezePrefix)+len(denom))
+	copy(key, GlobalFreezePrefix)
+	copy(key[len(GlobalFreezePrefix):], denom)
+	return key
+}
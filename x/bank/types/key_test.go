@@ -68,3 +68,21 @@ func TestCreateDenomAddressPrefix(t *testing.T) {
 	require.Len(key, len(types.DenomAddressPrefix)+4)
 	require.Equal(append(types.DenomAddressPrefix, 'a', 'b', 'c', 0), key)
 }
+
+func TestCreateFrozenKey(t *testing.T) {
+	require := require.New(t)
+	addr := sdk.AccAddress("addr1_______________")
+
+	key := types.CreateFrozenKey("abc", addr)
+	require.Equal(append(types.FrozenPrefix, 'a', 'b', 'c', 0), key[:len(types.FrozenPrefix)+4])
+
+	prefix := types.CreateFrozenDenomPrefix("abc")
+	got, err := types.AddressFromFrozenDenomStore(key[len(prefix):])
+	require.NoError(err)
+	require.Equal(addr, got)
+
+	_, err = types.AddressFromFrozenDenomStore(nil)
+	require.ErrorIs(err, types.ErrInvalidKey)
+	_, err = types.AddressFromFrozenDenomStore(key[len(prefix) : len(key)-1])
+	require.ErrorIs(err, types.ErrInvalidKey)
+}
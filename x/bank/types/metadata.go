@@ -15,6 +15,7 @@ import (
 //  - Base denomination has exponent 0
 //  - Denomination units are sorted in ascending order
 //  - Denomination units not duplicated
+//  - Admin, if set, is a valid address
 func (m Metadata) Validate() error {
 	if strings.TrimSpace(m.Name) == "" {
 		return errors.New("name field cannot be blank")
@@ -74,6 +75,12 @@ func (m Metadata) Validate() error {
 		return fmt.Errorf("metadata must contain a denomination unit with display denom '%s'", m.Display)
 	}
 
+	if m.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+			return fmt.Errorf("invalid metadata admin address: %w", err)
+		}
+	}
+
 	return nil
 }
 
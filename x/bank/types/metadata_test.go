@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank/types"
 )
 
@@ -213,6 +214,34 @@ func TestMetadataValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid admin",
+			types.Metadata{
+				Name:   "Cosmos Hub Atom",
+				Symbol: "ATOM",
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), nil},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+				Admin:   sdk.AccAddress("admin_______________").String(),
+			},
+			false,
+		},
+		{
+			"invalid admin",
+			types.Metadata{
+				Name:   "Cosmos Hub Atom",
+				Symbol: "ATOM",
+				DenomUnits: []*types.DenomUnit{
+					{"uatom", uint32(0), nil},
+				},
+				Base:    "uatom",
+				Display: "uatom",
+				Admin:   "invalid",
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -181,3 +181,321 @@ func ValidateInputsOutputs(inputs []Input, outputs []Output) error {
 
 	return nil
 }
+
+var _ sdk.Msg = &MsgUpdateParams{}
+
+// NewMsgUpdateParams - construct a msg to update the bank module parameters.
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{Authority: authority.String(), Params: params}
+}
+
+// Route Implements Msg.
+func (msg MsgUpdateParams) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUpdateParams) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgSetSendEnabled{}
+
+// NewMsgSetSendEnabled - construct a msg to set the SendEnabled flag of the
+// given denoms, or to reset them to the default.
+//nolint:interfacer
+func NewMsgSetSendEnabled(authority sdk.AccAddress, sendEnabled []*SendEnabled, useDefaultFor []string) *MsgSetSendEnabled {
+	return &MsgSetSendEnabled{
+		Authority:     authority.String(),
+		SendEnabled:   sendEnabled,
+		UseDefaultFor: useDefaultFor,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSetSendEnabled) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetSendEnabled) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetSendEnabled) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	seen := make(map[string]bool)
+	for _, se := range msg.SendEnabled {
+		if se == nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("send enabled entry cannot be nil")
+		}
+		if seen[se.Denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", se.Denom)
+		}
+		if err := se.Validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid SendEnabled denom %q: %s", se.Denom, err)
+		}
+		seen[se.Denom] = true
+	}
+
+	for _, denom := range msg.UseDefaultFor {
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("duplicate denom entries found for %q", denom)
+		}
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("invalid UseDefaultFor denom %q: %s", denom, err)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetSendEnabled) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetSendEnabled) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+var _ sdk.Msg = &MsgSetDenomAdmin{}
+
+// NewMsgSetDenomAdmin - construct a msg to set the admin of a denom. An empty
+// admin removes it.
+//nolint:interfacer
+func NewMsgSetDenomAdmin(sender sdk.AccAddress, denom string, admin sdk.AccAddress) *MsgSetDenomAdmin {
+	msg := &MsgSetDenomAdmin{Sender: sender.String(), Denom: denom}
+	if !admin.Empty() {
+		msg.Admin = admin.String()
+	}
+	return msg
+}
+
+// Route Implements Msg.
+func (msg MsgSetDenomAdmin) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetDenomAdmin) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetDenomAdmin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom %q: %s", msg.Denom, err)
+	}
+
+	if msg.Admin != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetDenomAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetDenomAdmin) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgFreeze{}
+
+// NewMsgFreeze - construct a msg to freeze the holdings of a denom of an address.
+//nolint:interfacer
+func NewMsgFreeze(admin sdk.AccAddress, denom string, addr sdk.AccAddress) *MsgFreeze {
+	return &MsgFreeze{Admin: admin.String(), Denom: denom, Address: addr.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgFreeze) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgFreeze) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic Implements Msg.
+func (msg MsgFreeze) ValidateBasic() error {
+	return validateFreezeMsg(msg.Admin, msg.Denom, msg.Address)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgFreeze) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+var _ sdk.Msg = &MsgUnfreeze{}
+
+// NewMsgUnfreeze - construct a msg to unfreeze the holdings of a denom of an address.
+//nolint:interfacer
+func NewMsgUnfreeze(admin sdk.AccAddress, denom string, addr sdk.AccAddress) *MsgUnfreeze {
+	return &MsgUnfreeze{Admin: admin.String(), Denom: denom, Address: addr.String()}
+}
+
+// Route Implements Msg.
+func (msg MsgUnfreeze) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgUnfreeze) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic Implements Msg.
+func (msg MsgUnfreeze) ValidateBasic() error {
+	return validateFreezeMsg(msg.Admin, msg.Denom, msg.Address)
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgUnfreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgUnfreeze) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+func validateFreezeMsg(admin, denom, addr string) error {
+	if _, err := sdk.AccAddressFromBech32(admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom %q: %s", denom, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid address: %s", err)
+	}
+
+	return nil
+}
+
+var _ sdk.Msg = &MsgSetGlobalFreeze{}
+
+// NewMsgSetGlobalFreeze - construct a msg to freeze or unfreeze all the
+// transfers of a denom.
+//nolint:interfacer
+func NewMsgSetGlobalFreeze(admin sdk.AccAddress, denom string, frozen bool) *MsgSetGlobalFreeze {
+	return &MsgSetGlobalFreeze{Admin: admin.String(), Denom: denom, Frozen: frozen}
+}
+
+// Route Implements Msg.
+func (msg MsgSetGlobalFreeze) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSetGlobalFreeze) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSetGlobalFreeze) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid denom %q: %s", msg.Denom, err)
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSetGlobalFreeze) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSetGlobalFreeze) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
+
+var _ sdk.Msg = &MsgClawback{}
+
+// NewMsgClawback - construct a msg to claw back coins of a denom from an
+// address. An empty toAddr sends the coins to the admin.
+//nolint:interfacer
+func NewMsgClawback(admin, fromAddr, toAddr sdk.AccAddress, amount sdk.Coin) *MsgClawback {
+	msg := &MsgClawback{Admin: admin.String(), FromAddress: fromAddr.String(), Amount: amount}
+	if !toAddr.Empty() {
+		msg.ToAddress = toAddr.String()
+	}
+	return msg
+}
+
+// Route Implements Msg.
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgClawback) Type() string { return sdk.MsgTypeURL(&msg) }
+
+// ValidateBasic Implements Msg.
+func (msg MsgClawback) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid admin address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid from address: %s", err)
+	}
+
+	if msg.ToAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.ToAddress); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid to address: %s", err)
+		}
+	}
+
+	if !msg.Amount.IsValid() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	if !msg.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrap(msg.Amount.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{admin}
+}
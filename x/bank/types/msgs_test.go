@@ -252,3 +252,96 @@ func TestMsgSendGetSigners(t *testing.T) {
 	require.Equal(t, 1, len(res))
 	require.True(t, from.Equals(res[0]))
 }
+
+func TestMsgSetSendEnabledValidation(t *testing.T) {
+	authority := sdk.AccAddress([]byte("authority___________")).String()
+
+	cases := []struct {
+		name   string
+		msg    *MsgSetSendEnabled
+		expErr string
+	}{
+		{
+			"valid",
+			&MsgSetSendEnabled{
+				Authority:     authority,
+				SendEnabled:   []*SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("barcoin", false)},
+				UseDefaultFor: []string{"bazcoin"},
+			},
+			"",
+		},
+		{"invalid authority", &MsgSetSendEnabled{Authority: "invalid"}, "invalid authority address"},
+		{
+			"invalid denom",
+			&MsgSetSendEnabled{Authority: authority, SendEnabled: []*SendEnabled{NewSendEnabled("0foo", true)}},
+			"invalid SendEnabled denom",
+		},
+		{
+			"duplicate denom",
+			&MsgSetSendEnabled{Authority: authority, SendEnabled: []*SendEnabled{NewSendEnabled("foocoin", true), NewSendEnabled("foocoin", false)}},
+			"duplicate denom entries",
+		},
+		{
+			"invalid use default for denom",
+			&MsgSetSendEnabled{Authority: authority, UseDefaultFor: []string{"0foo"}},
+			"invalid UseDefaultFor denom",
+		},
+		{
+			"denom both set and reset",
+			&MsgSetSendEnabled{Authority: authority, SendEnabled: []*SendEnabled{NewSendEnabled("foocoin", true)}, UseDefaultFor: []string{"foocoin"}},
+			"duplicate denom entries",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgDenomAdminValidation(t *testing.T) {
+	admin := sdk.AccAddress([]byte("admin_______________"))
+	addr := sdk.AccAddress([]byte("addr________________"))
+
+	cases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr string
+	}{
+		{"set admin", NewMsgSetDenomAdmin(admin, "foocoin", addr), ""},
+		{"remove admin", NewMsgSetDenomAdmin(admin, "foocoin", nil), ""},
+		{"set admin invalid sender", &MsgSetDenomAdmin{Sender: "invalid", Denom: "foocoin"}, "invalid sender address"},
+		{"set admin invalid denom", NewMsgSetDenomAdmin(admin, "0foo", addr), "invalid denom"},
+		{"set admin invalid admin", &MsgSetDenomAdmin{Sender: admin.String(), Denom: "foocoin", Admin: "invalid"}, "invalid admin address"},
+		{"freeze", NewMsgFreeze(admin, "foocoin", addr), ""},
+		{"freeze invalid address", &MsgFreeze{Admin: admin.String(), Denom: "foocoin", Address: "invalid"}, "invalid address"},
+		{"unfreeze", NewMsgUnfreeze(admin, "foocoin", addr), ""},
+		{"unfreeze invalid admin", &MsgUnfreeze{Admin: "invalid", Denom: "foocoin", Address: addr.String()}, "invalid admin address"},
+		{"global freeze", NewMsgSetGlobalFreeze(admin, "foocoin", true), ""},
+		{"global freeze invalid denom", NewMsgSetGlobalFreeze(admin, "0foo", true), "invalid denom"},
+		{"clawback", NewMsgClawback(admin, addr, nil, sdk.NewInt64Coin("foocoin", 10)), ""},
+		{"clawback invalid from", &MsgClawback{Admin: admin.String(), FromAddress: "invalid", Amount: sdk.NewInt64Coin("foocoin", 10)}, "invalid from address"},
+		{"clawback invalid to", &MsgClawback{Admin: admin.String(), FromAddress: addr.String(), ToAddress: "invalid", Amount: sdk.NewInt64Coin("foocoin", 10)}, "invalid to address"},
+		{"clawback zero amount", NewMsgClawback(admin, addr, admin, sdk.NewInt64Coin("foocoin", 0)), "invalid coins"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr == "" {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{admin}, tc.msg.GetSigners())
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErr)
+			}
+		})
+	}
+}
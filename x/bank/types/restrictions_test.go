@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func TestComposeSendRestrictions(t *testing.T) {
+	addr1 := sdk.AccAddress("addr1_______________")
+	addr2 := sdk.AccAddress("addr2_______________")
+	addr3 := sdk.AccAddress("addr3_______________")
+	amt := sdk.NewCoins(sdk.NewInt64Coin("foo", 10))
+
+	var calls []string
+	redirect := func(name string, to sdk.AccAddress) types.SendRestrictionFn {
+		return func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+			calls = append(calls, name)
+			return to, nil
+		}
+	}
+	veto := func(_ sdk.Context, _, _ sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+		calls = append(calls, "veto")
+		return nil, sdkerrors.ErrUnauthorized
+	}
+
+	require.Nil(t, types.ComposeSendRestrictions())
+	require.Nil(t, types.ComposeSendRestrictions(nil, nil))
+
+	testCases := []struct {
+		name     string
+		fn       types.SendRestrictionFn
+		expAddr  sdk.AccAddress
+		expCalls []string
+		expErr   error
+	}{
+		{
+			"no-op",
+			types.ComposeSendRestrictions(nil, types.NoOpSendRestrictionFn),
+			addr1,
+			nil,
+			nil,
+		},
+		{
+			"run in order",
+			types.ComposeSendRestrictions(redirect("first", addr2), nil, redirect("second", addr3)),
+			addr3,
+			[]string{"first", "second"},
+			nil,
+		},
+		{
+			"then",
+			types.SendRestrictionFn(redirect("first", addr3)).Then(redirect("second", addr2)),
+			addr2,
+			[]string{"first", "second"},
+			nil,
+		},
+		{
+			"stop at first error",
+			types.ComposeSendRestrictions(redirect("first", addr2), veto, redirect("second", addr3)),
+			nil,
+			[]string{"first", "veto"},
+			sdkerrors.ErrUnauthorized,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = nil
+			addr, err := tc.fn(sdk.Context{}, addr2, addr1, amt)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expAddr, addr)
+			}
+			require.Equal(t, tc.expCalls, calls)
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// GetTxCmd returns the transaction commands for the circuit module.
+func GetTxCmd() *cobra.Command {
+	circuitTxCmd := &cobra.Command{
+		Use:                        circuit.ModuleName,
+		Short:                      "Circuit breaker transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	circuitTxCmd.AddCommand(
+		NewAuthorizeCmd(),
+		NewTripCmd(),
+		NewResetCmd(),
+	)
+
+	return circuitTxCmd
+}
+
+func NewAuthorizeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authorize [grantee] [level] [msg-type-urls]",
+		Args:  cobra.RangeArgs(2, 3),
+		Short: "Grant circuit breaker permissions to an account",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Grant circuit breaker permissions to an account, replacing its previous ones.
+The level is one of none, some-msgs, all-msgs and super-admin. The some-msgs level requires
+a comma separated list of the message types whose circuit breakers the grantee can trip and reset,
+and the none level revokes the permissions of the grantee.
+
+Example:
+$ %s tx %s authorize cosmos1l2rsakp388kuv9k8qzq6lrm9taddae7fpx59wm some-msgs /cosmos.bank.v1beta1.MsgSend,/cosmos.bank.v1beta1.MsgMultiSend --from mykey
+`,
+				version.AppName, circuit.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			level, err := parseLevel(args[1])
+			if err != nil {
+				return err
+			}
+			var limitTypeURLs []string
+			if len(args) > 2 {
+				limitTypeURLs = strings.Split(args[2], ",")
+			}
+
+			msg := circuit.NewMsgAuthorizeCircuitBreaker(clientCtx.GetFromAddress(), grantee, circuit.NewPermissions(level, limitTypeURLs))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewTripCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trip [msg-type-url]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Trip the circuit breakers of the given message types, disabling them",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Trip the circuit breakers of the given message types, disabling them.
+The sender must have the permissions to trip the circuit breakers of all of them.
+
+Example:
+$ %s tx %s trip /cosmos.bank.v1beta1.MsgSend /cosmos.bank.v1beta1.MsgMultiSend --from mykey
+`,
+				version.AppName, circuit.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.NewMsgTripCircuitBreaker(clientCtx.GetFromAddress(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewResetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset [msg-type-url]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Reset the circuit breakers of the given message types, enabling them again",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reset the circuit breakers of the given message types, enabling them again.
+The sender must have the permissions to reset the circuit breakers of all of them.
+
+Example:
+$ %s tx %s reset /cosmos.bank.v1beta1.MsgSend --from mykey
+`,
+				version.AppName, circuit.ModuleName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := circuit.NewMsgResetCircuitBreaker(clientCtx.GetFromAddress(), args)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseLevel parses the permissions level given on the command line
+func parseLevel(s string) (circuit.Permissions_Level, error) {
+	switch s {
+	case "none":
+		return circuit.Permissions_LEVEL_NONE_UNSPECIFIED, nil
+	case "some-msgs":
+		return circuit.Permissions_LEVEL_SOME_MSGS, nil
+	case "all-msgs":
+		return circuit.Permissions_LEVEL_ALL_MSGS, nil
+	case "super-admin":
+		return circuit.Permissions_LEVEL_SUPER_ADMIN, nil
+	default:
+		return 0, fmt.Errorf("invalid level %q, expected one of none, some-msgs, all-msgs and super-admin", s)
+	}
+}
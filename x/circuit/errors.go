@@ -0,0 +1,13 @@
+package circuit
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/circuit module sentinel errors
+var (
+	ErrInvalidMsgTypeURL  = sdkerrors.Register(ModuleName, 2, "invalid message type URL")
+	ErrInvalidPermissions = sdkerrors.Register(ModuleName, 3, "invalid circuit breaker permissions")
+	ErrCircuitTripped     = sdkerrors.Register(ModuleName, 4, "circuit breaker is tripped")
+	ErrCircuitNotTripped  = sdkerrors.Register(ModuleName, 5, "circuit breaker is not tripped")
+)
@@ -0,0 +1,42 @@
+package circuit
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(accountPermissions []*AccountPermissions, disabledTypeURLs []string) *GenesisState {
+	return &GenesisState{
+		AccountPermissions: accountPermissions,
+		DisabledTypeUrls:   disabledTypeURLs,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the circuit module,
+// with no permissions granted and no circuit breaker tripped
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(nil, nil)
+}
+
+// ValidateGenesis checks the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	seen := make(map[string]bool, len(data.AccountPermissions))
+	for i, account := range data.AccountPermissions {
+		if account == nil || account.Permissions == nil {
+			return fmt.Errorf("account permissions %d: permissions cannot be empty", i)
+		}
+		if _, err := sdk.AccAddressFromBech32(account.Address); err != nil {
+			return fmt.Errorf("account permissions %d: %w", i, err)
+		}
+		if seen[account.Address] {
+			return fmt.Errorf("account permissions %d: duplicate address %s", i, account.Address)
+		}
+		seen[account.Address] = true
+		if err := account.Permissions.Validate(); err != nil {
+			return fmt.Errorf("account permissions %d: %w", i, err)
+		}
+	}
+	return ValidateMsgTypeURLs(data.DisabledTypeUrls)
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// InitGenesis initializes the circuit module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *circuit.GenesisState) {
+	for _, account := range data.AccountPermissions {
+		addr, err := sdk.AccAddressFromBech32(account.Address)
+		if err != nil {
+			panic(err)
+		}
+		k.SetPermissions(ctx, addr, *account.Permissions)
+	}
+
+	for _, msgTypeURL := range data.DisabledTypeUrls {
+		k.DisableMsg(ctx, msgTypeURL)
+	}
+}
+
+// ExportGenesis returns the circuit module's genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *circuit.GenesisState {
+	var accounts []*circuit.AccountPermissions
+	k.IteratePermissions(ctx, func(addr sdk.AccAddress, perms circuit.Permissions) bool {
+		accounts = append(accounts, &circuit.AccountPermissions{
+			Address:     addr.String(),
+			Permissions: &perms,
+		})
+		return false
+	})
+	return circuit.NewGenesisState(accounts, k.GetDisabledMsgs(ctx))
+}
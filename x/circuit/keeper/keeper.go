@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// Keeper of the circuit store
+type Keeper struct {
+	storeKey  storetypes.StoreKey
+	cdc       codec.BinaryCodec
+	router    *authmiddleware.MsgServiceRouter
+	authority string
+}
+
+// NewKeeper creates a new circuit Keeper instance. authority is the address of
+// the account, usually the governance module account, which can grant permissions
+// and trip or reset all the circuit breakers. The message types are checked
+// against router before their circuit breakers are tripped.
+func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, router *authmiddleware.MsgServiceRouter, authority string) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid circuit authority address: %s", err))
+	}
+
+	return Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		router:    router,
+		authority: authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", circuit.ModuleName))
+}
+
+// GetAuthority returns the address of the circuit authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetPermissions returns the permissions of an account, with the
+// LEVEL_NONE_UNSPECIFIED level if none were granted
+func (k Keeper) GetPermissions(ctx sdk.Context, addr sdk.AccAddress) circuit.Permissions {
+	var perms circuit.Permissions
+	bz := ctx.KVStore(k.storeKey).Get(accountPermissionsKey(addr))
+	if bz == nil {
+		return perms
+	}
+	k.cdc.MustUnmarshal(bz, &perms)
+	return perms
+}
+
+// SetPermissions sets the permissions of an account. Setting the
+// LEVEL_NONE_UNSPECIFIED level removes its permissions.
+func (k Keeper) SetPermissions(ctx sdk.Context, addr sdk.AccAddress, perms circuit.Permissions) {
+	store := ctx.KVStore(k.storeKey)
+	if perms.Level == circuit.Permissions_LEVEL_NONE_UNSPECIFIED {
+		store.Delete(accountPermissionsKey(addr))
+		return
+	}
+	store.Set(accountPermissionsKey(addr), k.cdc.MustMarshal(&perms))
+}
+
+// IteratePermissions iterates over the accounts with permissions, until cb returns true
+func (k Keeper) IteratePermissions(ctx sdk.Context, cb func(addr sdk.AccAddress, perms circuit.Permissions) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), AccountPermissionsPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var perms circuit.Permissions
+		k.cdc.MustUnmarshal(iterator.Value(), &perms)
+		// skip the prefix and the address length
+		if cb(iterator.Key()[len(AccountPermissionsPrefix)+1:], perms) {
+			break
+		}
+	}
+}
+
+// CanTrip returns whether addr can trip and reset the circuit breaker of the given
+// message type: the authority can trip all of them, the other accounts only the
+// ones allowed by their permissions
+func (k Keeper) CanTrip(ctx sdk.Context, addr sdk.AccAddress, msgTypeURL string) bool {
+	if addr.String() == k.authority {
+		return true
+	}
+	return k.GetPermissions(ctx, addr).CanTrip(msgTypeURL)
+}
+
+// IsAllowed returns whether the messages of the given type can be executed, i.e.
+// whether their circuit breaker is not tripped
+func (k Keeper) IsAllowed(ctx sdk.Context, msgTypeURL string) bool {
+	return !ctx.KVStore(k.storeKey).Has(disabledMsgKey(msgTypeURL))
+}
+
+// DisableMsg trips the circuit breaker of the given message type
+func (k Keeper) DisableMsg(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Set(disabledMsgKey(msgTypeURL), []byte{})
+}
+
+// EnableMsg resets the circuit breaker of the given message type
+func (k Keeper) EnableMsg(ctx sdk.Context, msgTypeURL string) {
+	ctx.KVStore(k.storeKey).Delete(disabledMsgKey(msgTypeURL))
+}
+
+// IterateDisabledMsgs iterates over the type URLs of the disabled messages in
+// lexicographic order, until cb returns true
+func (k Keeper) IterateDisabledMsgs(ctx sdk.Context, cb func(msgTypeURL string) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), DisabledMsgPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(string(iterator.Key())) {
+			break
+		}
+	}
+}
+
+// GetDisabledMsgs returns the type URLs of the disabled messages
+func (k Keeper) GetDisabledMsgs(ctx sdk.Context) []string {
+	var msgTypeURLs []string
+	k.IterateDisabledMsgs(ctx, func(msgTypeURL string) bool {
+		msgTypeURLs = append(msgTypeURLs, msgTypeURL)
+		return false
+	})
+	return msgTypeURLs
+}
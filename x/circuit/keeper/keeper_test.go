@@ -0,0 +1,137 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	msgSendURL      = "/cosmos.bank.v1beta1.MsgSend"
+	msgMultiSendURL = "/cosmos.bank.v1beta1.MsgMultiSend"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	authority   sdk.AccAddress
+	addrs       []sdk.AccAddress
+	queryClient circuit.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	circuit.RegisterQueryServer(queryHelper, app.CircuitKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = circuit.NewQueryClient(queryHelper)
+	s.authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(30000000))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) authorize(granter, grantee sdk.AccAddress, level circuit.Permissions_Level, limitTypeURLs ...string) error {
+	msg := circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, circuit.NewPermissions(level, limitTypeURLs))
+	_, err := s.app.CircuitKeeper.AuthorizeCircuitBreaker(sdk.WrapSDKContext(s.ctx), msg)
+	return err
+}
+
+func (s *TestSuite) trip(authority sdk.AccAddress, msgTypeURLs ...string) error {
+	_, err := s.app.CircuitKeeper.TripCircuitBreaker(sdk.WrapSDKContext(s.ctx), circuit.NewMsgTripCircuitBreaker(authority, msgTypeURLs))
+	return err
+}
+
+func (s *TestSuite) reset(authority sdk.AccAddress, msgTypeURLs ...string) error {
+	_, err := s.app.CircuitKeeper.ResetCircuitBreaker(sdk.WrapSDKContext(s.ctx), circuit.NewMsgResetCircuitBreaker(authority, msgTypeURLs))
+	return err
+}
+
+func (s *TestSuite) TestAuthorize() {
+	admin, operator, other := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	// only the authority and the super admins can grant permissions
+	s.Require().ErrorIs(s.authorize(admin, operator, circuit.Permissions_LEVEL_ALL_MSGS), sdkerrors.ErrUnauthorized)
+	s.Require().NoError(s.authorize(s.authority, admin, circuit.Permissions_LEVEL_SUPER_ADMIN))
+	s.Require().NoError(s.authorize(admin, operator, circuit.Permissions_LEVEL_SOME_MSGS, msgSendURL))
+	s.Require().ErrorIs(s.authorize(operator, other, circuit.Permissions_LEVEL_ALL_MSGS), sdkerrors.ErrUnauthorized)
+
+	res, err := s.queryClient.Account(s.ctx, &circuit.QueryAccountRequest{Address: operator.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(circuit.NewPermissions(circuit.Permissions_LEVEL_SOME_MSGS, []string{msgSendURL}), *res.Permissions)
+
+	accounts, err := s.queryClient.Accounts(s.ctx, &circuit.QueryAccountsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	s.Require().NoError(err)
+	s.Require().Len(accounts.Accounts, 2)
+	s.Require().Equal(uint64(2), accounts.Pagination.Total)
+
+	// granting the none level revokes the permissions
+	s.Require().NoError(s.authorize(admin, operator, circuit.Permissions_LEVEL_NONE_UNSPECIFIED))
+	res, err = s.queryClient.Account(s.ctx, &circuit.QueryAccountRequest{Address: operator.String()})
+	s.Require().NoError(err)
+	s.Require().Equal(circuit.Permissions_LEVEL_NONE_UNSPECIFIED, res.Permissions.Level)
+	accounts, err = s.queryClient.Accounts(s.ctx, &circuit.QueryAccountsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(accounts.Accounts, 1)
+	s.Require().Equal(admin.String(), accounts.Accounts[0].Address)
+}
+
+func (s *TestSuite) TestTripAndReset() {
+	operator, other := s.addrs[0], s.addrs[1]
+	s.Require().NoError(s.authorize(s.authority, operator, circuit.Permissions_LEVEL_SOME_MSGS, msgSendURL, "/foo.MsgBar"))
+
+	// the accounts can only trip the circuit breakers they have permissions for
+	s.Require().ErrorIs(s.trip(other, msgSendURL), sdkerrors.ErrUnauthorized)
+	s.Require().ErrorIs(s.trip(operator, msgMultiSendURL), sdkerrors.ErrUnauthorized)
+	s.Require().ErrorIs(s.trip(operator, "/foo.MsgBar"), circuit.ErrInvalidMsgTypeURL)
+	s.Require().NoError(s.trip(operator, msgSendURL))
+	s.Require().ErrorIs(s.trip(operator, msgSendURL), circuit.ErrCircuitTripped)
+	s.Require().NoError(s.trip(s.authority, msgMultiSendURL))
+
+	s.Require().False(s.app.CircuitKeeper.IsAllowed(s.ctx, msgSendURL))
+	s.Require().False(s.app.CircuitKeeper.IsAllowed(s.ctx, msgMultiSendURL))
+	res, err := s.queryClient.DisabledList(s.ctx, &circuit.QueryDisabledListRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{msgMultiSendURL, msgSendURL}, res.DisabledList)
+
+	s.Require().ErrorIs(s.reset(operator, msgMultiSendURL), sdkerrors.ErrUnauthorized)
+	s.Require().NoError(s.reset(operator, msgSendURL))
+	s.Require().ErrorIs(s.reset(operator, msgSendURL), circuit.ErrCircuitNotTripped)
+	s.Require().True(s.app.CircuitKeeper.IsAllowed(s.ctx, msgSendURL))
+	s.Require().Equal([]string{msgMultiSendURL}, s.app.CircuitKeeper.GetDisabledMsgs(s.ctx))
+}
+
+func (s *TestSuite) TestGenesis() {
+	operator := s.addrs[0]
+	s.Require().NoError(s.authorize(s.authority, operator, circuit.Permissions_LEVEL_SOME_MSGS, msgSendURL))
+	s.Require().NoError(s.trip(operator, msgSendURL))
+
+	genesis := s.app.CircuitKeeper.ExportGenesis(s.ctx)
+	s.Require().NoError(circuit.ValidateGenesis(*genesis))
+	s.Require().Equal([]string{msgSendURL}, genesis.DisabledTypeUrls)
+	s.Require().Len(genesis.AccountPermissions, 1)
+	s.Require().Equal(operator.String(), genesis.AccountPermissions[0].Address)
+
+	s.SetupTest()
+	s.Require().True(s.app.CircuitKeeper.IsAllowed(s.ctx, msgSendURL))
+	s.app.CircuitKeeper.InitGenesis(s.ctx, genesis)
+	s.Require().False(s.app.CircuitKeeper.IsAllowed(s.ctx, msgSendURL))
+	s.Require().Equal(genesis, s.app.CircuitKeeper.ExportGenesis(s.ctx))
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	AccountPermissionsPrefix = []byte{0x01}
+	DisabledMsgPrefix        = []byte{0x02}
+)
+
+// accountPermissionsKey returns the key of the permissions of an account
+func accountPermissionsKey(addr sdk.AccAddress) []byte {
+	return append(append([]byte{}, AccountPermissionsPrefix...), address.MustLengthPrefix(addr)...)
+}
+
+// disabledMsgKey returns the key marking the messages of the given type as disabled
+func disabledMsgKey(msgTypeURL string) []byte {
+	return append(append([]byte{}, DisabledMsgPrefix...), msgTypeURL...)
+}
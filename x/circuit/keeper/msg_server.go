@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var _ circuit.MsgServer = Keeper{}
+
+// AuthorizeCircuitBreaker grants permissions to an account, replacing its previous ones
+func (k Keeper) AuthorizeCircuitBreaker(goCtx context.Context, msg *circuit.MsgAuthorizeCircuitBreaker) (*circuit.MsgAuthorizeCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	grantee, err := sdk.AccAddressFromBech32(msg.Grantee)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Granter != k.authority && k.GetPermissions(ctx, granter).Level != circuit.Permissions_LEVEL_SUPER_ADMIN {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("%s is neither the authority nor a super admin", msg.Granter)
+	}
+
+	k.SetPermissions(ctx, grantee, *msg.Permissions)
+
+	err = ctx.EventManager().EmitTypedEvent(&circuit.EventAuthorizeCircuitBreaker{
+		Granter:     msg.Granter,
+		Grantee:     msg.Grantee,
+		Permissions: msg.Permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &circuit.MsgAuthorizeCircuitBreakerResponse{}, nil
+}
+
+// TripCircuitBreaker disables the given message types
+func (k Keeper) TripCircuitBreaker(goCtx context.Context, msg *circuit.MsgTripCircuitBreaker) (*circuit.MsgTripCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		if !k.CanTrip(ctx, authority, msgTypeURL) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s cannot trip the circuit breaker of %s", msg.Authority, msgTypeURL)
+		}
+		if k.router.HandlerByTypeURL(msgTypeURL) == nil {
+			return nil, sdkerrors.Wrapf(circuit.ErrInvalidMsgTypeURL, "no message handler found for %s", msgTypeURL)
+		}
+		if !k.IsAllowed(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrap(circuit.ErrCircuitTripped, msgTypeURL)
+		}
+
+		k.DisableMsg(ctx, msgTypeURL)
+
+		err := ctx.EventManager().EmitTypedEvent(&circuit.EventTripCircuitBreaker{
+			Authority:  msg.Authority,
+			MsgTypeUrl: msgTypeURL,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &circuit.MsgTripCircuitBreakerResponse{}, nil
+}
+
+// ResetCircuitBreaker enables again the given message types
+func (k Keeper) ResetCircuitBreaker(goCtx context.Context, msg *circuit.MsgResetCircuitBreaker) (*circuit.MsgResetCircuitBreakerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, msgTypeURL := range msg.MsgTypeUrls {
+		if !k.CanTrip(ctx, authority, msgTypeURL) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s cannot reset the circuit breaker of %s", msg.Authority, msgTypeURL)
+		}
+		if k.IsAllowed(ctx, msgTypeURL) {
+			return nil, sdkerrors.Wrap(circuit.ErrCircuitNotTripped, msgTypeURL)
+		}
+
+		k.EnableMsg(ctx, msgTypeURL)
+
+		err := ctx.EventManager().EmitTypedEvent(&circuit.EventResetCircuitBreaker{
+			Authority:  msg.Authority,
+			MsgTypeUrl: msgTypeURL,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &circuit.MsgResetCircuitBreakerResponse{}, nil
+}
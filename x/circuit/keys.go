@@ -0,0 +1,15 @@
+package circuit
+
+const (
+	// ModuleName is the name of the circuit module
+	ModuleName = "circuit"
+
+	// StoreKey is the default store key for the circuit module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the circuit module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the circuit module
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,137 @@
+package circuit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+var (
+	_ sdk.Msg            = &MsgAuthorizeCircuitBreaker{}
+	_ sdk.Msg            = &MsgTripCircuitBreaker{}
+	_ sdk.Msg            = &MsgResetCircuitBreaker{}
+	_ legacytx.LegacyMsg = &MsgAuthorizeCircuitBreaker{}
+	_ legacytx.LegacyMsg = &MsgTripCircuitBreaker{}
+	_ legacytx.LegacyMsg = &MsgResetCircuitBreaker{}
+)
+
+// validateMsgTypeURLs checks that at least one message type is given, and that
+// all of them are valid
+func validateMsgTypeURLs(msgTypeURLs []string) error {
+	if len(msgTypeURLs) == 0 {
+		return sdkerrors.Wrap(ErrInvalidMsgTypeURL, "message types cannot be empty")
+	}
+	return ValidateMsgTypeURLs(msgTypeURLs)
+}
+
+// mustAccAddress returns the account address of a signer, or panics if it is invalid
+func mustAccAddress(address string) sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+// NewMsgAuthorizeCircuitBreaker creates a new MsgAuthorizeCircuitBreaker instance
+func NewMsgAuthorizeCircuitBreaker(granter, grantee sdk.AccAddress, permissions Permissions) *MsgAuthorizeCircuitBreaker {
+	return &MsgAuthorizeCircuitBreaker{
+		Granter:     granter.String(),
+		Grantee:     grantee.String(),
+		Permissions: &permissions,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgAuthorizeCircuitBreaker) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgAuthorizeCircuitBreaker) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgAuthorizeCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the granter.
+func (m MsgAuthorizeCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Granter)}
+}
+
+// ValidateBasic does a sanity check on the addresses and the permissions.
+func (m MsgAuthorizeCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Granter); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid granter address: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Grantee); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid grantee address: %s", err)
+	}
+	if m.Permissions == nil {
+		return sdkerrors.Wrap(ErrInvalidPermissions, "permissions cannot be empty")
+	}
+	return m.Permissions.Validate()
+}
+
+// NewMsgTripCircuitBreaker creates a new MsgTripCircuitBreaker instance
+func NewMsgTripCircuitBreaker(authority sdk.AccAddress, msgTypeURLs []string) *MsgTripCircuitBreaker {
+	return &MsgTripCircuitBreaker{
+		Authority:   authority.String(),
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgTripCircuitBreaker) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgTripCircuitBreaker) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgTripCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the authority.
+func (m MsgTripCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Authority)}
+}
+
+// ValidateBasic does a sanity check on the authority and the message types.
+func (m MsgTripCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
+
+// NewMsgResetCircuitBreaker creates a new MsgResetCircuitBreaker instance
+func NewMsgResetCircuitBreaker(authority sdk.AccAddress, msgTypeURLs []string) *MsgResetCircuitBreaker {
+	return &MsgResetCircuitBreaker{
+		Authority:   authority.String(),
+		MsgTypeUrls: msgTypeURLs,
+	}
+}
+
+// Route Implements Msg.
+func (m MsgResetCircuitBreaker) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgResetCircuitBreaker) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgResetCircuitBreaker) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the authority.
+func (m MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{mustAccAddress(m.Authority)}
+}
+
+// ValidateBasic does a sanity check on the authority and the message types.
+func (m MsgResetCircuitBreaker) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	return validateMsgTypeURLs(m.MsgTypeUrls)
+}
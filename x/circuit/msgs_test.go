@@ -0,0 +1,125 @@
+package circuit_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+var (
+	granter    = sdk.AccAddress("granter")
+	grantee    = sdk.AccAddress("grantee")
+	msgSendURL = "/cosmos.bank.v1beta1.MsgSend"
+)
+
+func TestMsgAuthorizeCircuitBreaker(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *circuit.MsgAuthorizeCircuitBreaker
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid grantee",
+			&circuit.MsgAuthorizeCircuitBreaker{Granter: granter.String(), Grantee: "foo", Permissions: &circuit.Permissions{}},
+			true,
+			"invalid grantee address",
+		},
+		{
+			"empty permissions",
+			&circuit.MsgAuthorizeCircuitBreaker{Granter: granter.String(), Grantee: grantee.String()},
+			true,
+			"permissions cannot be empty",
+		},
+		{
+			"unknown level",
+			circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, circuit.NewPermissions(42, nil)),
+			true,
+			"unknown level",
+		},
+		{
+			"some msgs without message types",
+			circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, circuit.NewPermissions(circuit.Permissions_LEVEL_SOME_MSGS, nil)),
+			true,
+			"message types must be limited",
+		},
+		{
+			"all msgs with message types",
+			circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, circuit.NewPermissions(circuit.Permissions_LEVEL_ALL_MSGS, []string{msgSendURL})),
+			true,
+			"message types cannot be limited",
+		},
+		{
+			"some msgs",
+			circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, circuit.NewPermissions(circuit.Permissions_LEVEL_SOME_MSGS, []string{msgSendURL})),
+			false,
+			"",
+		},
+		{
+			"revoke",
+			circuit.NewMsgAuthorizeCircuitBreaker(granter, grantee, circuit.NewPermissions(circuit.Permissions_LEVEL_NONE_UNSPECIFIED, nil)),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{granter}, tc.msg.GetSigners())
+			}
+		})
+	}
+}
+
+func TestMsgTripCircuitBreaker(t *testing.T) {
+	testCases := []struct {
+		name        string
+		msgTypeURLs []string
+		expErr      bool
+		errMsg      string
+	}{
+		{"empty message types", nil, true, "message types cannot be empty"},
+		{"missing slash", []string{"cosmos.bank.v1beta1.MsgSend"}, true, "must start with a slash"},
+		{"spaces", []string{"/cosmos.bank.v1beta1.MsgSend "}, true, "must start with a slash and have no spaces"},
+		{"duplicate", []string{msgSendURL, msgSendURL}, true, "duplicate"},
+		{"circuit message", []string{"/cosmos.circuit.v1beta1.MsgResetCircuitBreaker"}, true, "cannot be tripped"},
+		{"valid", []string{msgSendURL, "/cosmos.bank.v1beta1.MsgMultiSend"}, false, ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, msg := range []sdk.Msg{
+				circuit.NewMsgTripCircuitBreaker(granter, tc.msgTypeURLs),
+				circuit.NewMsgResetCircuitBreaker(granter, tc.msgTypeURLs),
+			} {
+				err := msg.ValidateBasic()
+				if tc.expErr {
+					require.Error(t, err)
+					require.Contains(t, err.Error(), tc.errMsg)
+				} else {
+					require.NoError(t, err)
+					require.Equal(t, []sdk.AccAddress{granter}, msg.GetSigners())
+				}
+			}
+		})
+	}
+}
+
+func TestPermissionsCanTrip(t *testing.T) {
+	other := "/cosmos.bank.v1beta1.MsgMultiSend"
+	require.False(t, circuit.NewPermissions(circuit.Permissions_LEVEL_NONE_UNSPECIFIED, nil).CanTrip(msgSendURL))
+	some := circuit.NewPermissions(circuit.Permissions_LEVEL_SOME_MSGS, []string{msgSendURL})
+	require.True(t, some.CanTrip(msgSendURL))
+	require.False(t, some.CanTrip(other))
+	require.True(t, circuit.NewPermissions(circuit.Permissions_LEVEL_ALL_MSGS, nil).CanTrip(other))
+	require.True(t, circuit.NewPermissions(circuit.Permissions_LEVEL_SUPER_ADMIN, nil).CanTrip(other))
+}
@@ -0,0 +1,69 @@
+package circuit
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewPermissions creates a new Permissions instance
+func NewPermissions(level Permissions_Level, limitTypeURLs []string) Permissions {
+	return Permissions{
+		Level:         level,
+		LimitTypeUrls: limitTypeURLs,
+	}
+}
+
+// Validate checks that the level is known, and that the message types are only
+// listed, and valid, for the LEVEL_SOME_MSGS level.
+func (p Permissions) Validate() error {
+	if _, ok := Permissions_Level_name[int32(p.Level)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidPermissions, "unknown level %d", p.Level)
+	}
+	if p.Level != Permissions_LEVEL_SOME_MSGS {
+		if len(p.LimitTypeUrls) != 0 {
+			return sdkerrors.Wrapf(ErrInvalidPermissions, "message types cannot be limited for level %s", p.Level)
+		}
+		return nil
+	}
+	if len(p.LimitTypeUrls) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidPermissions, "message types must be limited for level %s", p.Level)
+	}
+	return ValidateMsgTypeURLs(p.LimitTypeUrls)
+}
+
+// CanTrip returns whether the permissions allow to trip and reset the circuit
+// breaker of the given message type.
+func (p Permissions) CanTrip(msgTypeURL string) bool {
+	switch p.Level {
+	case Permissions_LEVEL_ALL_MSGS, Permissions_LEVEL_SUPER_ADMIN:
+		return true
+	case Permissions_LEVEL_SOME_MSGS:
+		for _, typeURL := range p.LimitTypeUrls {
+			if typeURL == msgTypeURL {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ValidateMsgTypeURLs checks that the message type URLs are well formed and
+// unique. The messages of the circuit module cannot be listed, so that their
+// circuit breakers can never be tripped.
+func ValidateMsgTypeURLs(msgTypeURLs []string) error {
+	seen := make(map[string]bool, len(msgTypeURLs))
+	for _, typeURL := range msgTypeURLs {
+		if !strings.HasPrefix(typeURL, "/") || strings.ContainsAny(typeURL, " \t\r\n") {
+			return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "%q must start with a slash and have no spaces", typeURL)
+		}
+		if strings.HasPrefix(typeURL, "/cosmos.circuit.") {
+			return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "the circuit breaker of %s cannot be tripped", typeURL)
+		}
+		if seen[typeURL] {
+			return sdkerrors.Wrapf(ErrInvalidMsgTypeURL, "duplicate %s", typeURL)
+		}
+		seen[typeURL] = true
+	}
+	return nil
+}
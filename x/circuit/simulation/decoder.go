@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+	"github.com/cosmos/cosmos-sdk/x/circuit/keeper"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding circuit type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], keeper.AccountPermissionsPrefix):
+			var permsA, permsB circuit.Permissions
+			cdc.MustUnmarshal(kvA.Value, &permsA)
+			cdc.MustUnmarshal(kvB.Value, &permsB)
+			return fmt.Sprintf("%v\n%v", permsA, permsB)
+		case bytes.Equal(kvA.Key[:1], keeper.DisabledMsgPrefix):
+			return fmt.Sprintf("%s\n%s", kvA.Key[1:], kvB.Key[1:])
+		default:
+			panic(fmt.Sprintf("invalid circuit key %X", kvA.Key))
+		}
+	}
+}
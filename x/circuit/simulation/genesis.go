@@ -0,0 +1,14 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/circuit"
+)
+
+// RandomizedGenState generates a GenesisState for circuit. No circuit breaker is
+// tripped, so that the messages of the other modules can be simulated.
+func RandomizedGenState(simState *module.SimulationState) {
+	simState.GenState[circuit.ModuleName] = simState.Cdc.MustMarshalJSON(circuit.DefaultGenesisState())
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -14,18 +16,24 @@ import (
 // Keeper - crisis keeper
 type Keeper struct {
 	routes         []types.InvarRoute
+	storeKey       storetypes.StoreKey
+	cdc            codec.BinaryCodec
 	paramSpace     paramtypes.Subspace
 	invCheckPeriod uint
 
 	supplyKeeper types.SupplyKeeper
 
 	feeCollectorName string // name of the FeeCollector ModuleAccount
+
+	authority string // address allowed to update the constant fee
 }
 
-// NewKeeper creates a new Keeper object
+// NewKeeper creates a new Keeper object. paramSpace is the legacy params subspace
+// the constant fee is migrated from, and authority the address of the account,
+// usually the governance module account, allowed to update it.
 func NewKeeper(
-	paramSpace paramtypes.Subspace, invCheckPeriod uint, supplyKeeper types.SupplyKeeper,
-	feeCollectorName string,
+	cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	invCheckPeriod uint, supplyKeeper types.SupplyKeeper, feeCollectorName string, authority string,
 ) Keeper {
 
 	// set KeyTable if it has not already been set
@@ -33,12 +41,19 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid crisis authority address: %s", err))
+	}
+
 	return Keeper{
 		routes:           make([]types.InvarRoute, 0),
+		storeKey:         storeKey,
+		cdc:              cdc,
 		paramSpace:       paramSpace,
 		invCheckPeriod:   invCheckPeriod,
 		supplyKeeper:     supplyKeeper,
 		feeCollectorName: feeCollectorName,
+		authority:        authority,
 	}
 }
 
@@ -47,6 +62,11 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
+// GetAuthority returns the address allowed to update the constant fee.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // RegisterRoute register the routes for each of the invariants
 func (k *Keeper) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
 	invarRoute := types.NewInvarRoute(moduleName, route, invar)
@@ -5,13 +5,17 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/crisis/types"
 )
 
-// GetConstantFee get's the constant fee from the paramSpace
+// GetConstantFee get's the constant fee from the store
 func (k Keeper) GetConstantFee(ctx sdk.Context) (constantFee sdk.Coin) {
-	k.paramSpace.Get(ctx, types.ParamStoreKeyConstantFee, &constantFee)
-	return
+	bz := ctx.KVStore(k.storeKey).Get(types.ConstantFeeKey)
+	if bz == nil {
+		return constantFee
+	}
+	k.cdc.MustUnmarshal(bz, &constantFee)
+	return constantFee
 }
 
-// GetConstantFee set's the constant fee in the paramSpace
+// GetConstantFee set's the constant fee in the store
 func (k Keeper) SetConstantFee(ctx sdk.Context, constantFee sdk.Coin) {
-	k.paramSpace.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+	ctx.KVStore(k.storeKey).Set(types.ConstantFeeKey, k.cdc.MustMarshal(&constantFee))
 }
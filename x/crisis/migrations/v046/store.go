@@ -0,0 +1,30 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ConstantFeeKey is the key of the x/crisis constant fee in the module store.
+var ConstantFeeKey = []byte{0x01}
+
+// MigrateStore performs in-place store migrations from v0.45 to v0.46. The
+// migration includes:
+//
+// - Moving the x/crisis constant fee out of the legacy x/params subspace into
+// the newly added x/crisis module store.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace paramtypes.Subspace, cdc codec.BinaryCodec) error {
+	var constantFee sdk.Coin
+	legacySubspace.Get(ctx, types.ParamStoreKeyConstantFee, &constantFee)
+
+	bz, err := cdc.Marshal(&constantFee)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(ConstantFeeKey, bz)
+	return nil
+}
@@ -0,0 +1,36 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046crisis "github.com/cosmos/cosmos-sdk/x/crisis/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/crisis/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	crisisKey := sdk.NewKVStoreKey("crisis")
+	tCrisisKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(crisisKey, tCrisisKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, crisisKey, tCrisisKey, "crisis").WithKeyTable(types.ParamKeyTable())
+
+	// Set the constant fee in the legacy subspace.
+	constantFee := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	paramstore.Set(ctx, types.ParamStoreKeyConstantFee, constantFee)
+
+	// Run migrations.
+	err := v046crisis.MigrateStore(ctx, crisisKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the constant fee was moved into the module store.
+	var res sdk.Coin
+	bz := ctx.KVStore(crisisKey).Get(v046crisis.ConstantFeeKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, constantFee, res)
+}
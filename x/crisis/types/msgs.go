@@ -6,7 +6,7 @@ import (
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgVerifyInvariant{}
+var _, _ sdk.Msg = &MsgVerifyInvariant{}, &MsgUpdateParams{}
 
 // NewMsgVerifyInvariant creates a new MsgVerifyInvariant object
 //nolint:interfacer
@@ -45,3 +45,38 @@ func (msg MsgVerifyInvariant) ValidateBasic() error {
 func (msg MsgVerifyInvariant) FullInvariantRoute() string {
 	return msg.InvariantModuleName + "/" + msg.InvariantRoute
 }
+
+// NewMsgUpdateParams creates a new MsgUpdateParams object
+//nolint:interfacer
+func NewMsgUpdateParams(authority sdk.AccAddress, constantFee sdk.Coin) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority:   authority.String(),
+		ConstantFee: constantFee,
+	}
+}
+
+func (msg MsgUpdateParams) Route() string { return ModuleName }
+func (msg MsgUpdateParams) Type() string  { return "update_params" }
+
+// get the bytes for the message signer to sign on
+func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{authority}
+}
+
+// GetSignBytes gets the sign bytes for the msg MsgUpdateParams
+func (msg MsgUpdateParams) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// quick validity check
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+	if !msg.ConstantFee.IsValid() || !msg.ConstantFee.IsPositive() {
+		return sdkerrors.ErrInvalidCoins.Wrapf("invalid constant fee: %s", msg.ConstantFee)
+	}
+	return nil
+}
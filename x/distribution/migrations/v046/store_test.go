@@ -0,0 +1,36 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	v046distr "github.com/cosmos/cosmos-sdk/x/distribution/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/distribution/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	distrKey := sdk.NewKVStoreKey("distribution")
+	tDistrKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(distrKey, tDistrKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, distrKey, tDistrKey, "distribution").WithKeyTable(types.ParamKeyTable())
+
+	// Set the params in the legacy subspace.
+	params := types.DefaultParams()
+	paramstore.SetParamSet(ctx, &params)
+
+	// Run migrations.
+	err := v046distr.MigrateStore(ctx, distrKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params were moved into the module store.
+	var res types.Params
+	bz := ctx.KVStore(distrKey).Get(v046distr.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
@@ -341,6 +341,102 @@ func (m *MsgFundCommunityPoolResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFundCommunityPoolResponse proto.InternalMessageInfo
 
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the account allowed to update the params, the
+	// governance module account by default.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/distribution parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{8}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed4f433d965e58ca, []int{9}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetWithdrawAddress)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddress")
 	proto.RegisterType((*MsgSetWithdrawAddressResponse)(nil), "cosmos.distribution.v1beta1.MsgSetWithdrawAddressResponse")
@@ -350,6 +446,8 @@ func init() {
 	proto.RegisterType((*MsgWithdrawValidatorCommissionResponse)(nil), "cosmos.distribution.v1beta1.MsgWithdrawValidatorCommissionResponse")
 	proto.RegisterType((*MsgFundCommunityPool)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPool")
 	proto.RegisterType((*MsgFundCommunityPoolResponse)(nil), "cosmos.distribution.v1beta1.MsgFundCommunityPoolResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.distribution.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.distribution.v1beta1.MsgUpdateParamsResponse")
 }
 
 func init() {
@@ -357,44 +455,49 @@ func init() {
 }
 
 var fileDescriptor_ed4f433d965e58ca = []byte{
-	// 581 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x6b, 0x13, 0x4f,
-	0x18, 0xc6, 0x77, 0xfe, 0xfd, 0x53, 0xe8, 0x78, 0x30, 0x59, 0xa2, 0x4d, 0xb7, 0x3a, 0x29, 0x4b,
-	0x91, 0x20, 0x74, 0xd7, 0x44, 0x50, 0x8c, 0x07, 0x31, 0xb1, 0xde, 0x82, 0x92, 0x82, 0x82, 0x97,
-	0xb2, 0xc9, 0x0c, 0xd3, 0xc1, 0xec, 0x4e, 0xd8, 0x99, 0x4d, 0xda, 0xa3, 0x22, 0xe8, 0x51, 0xf0,
-	0x03, 0xd8, 0xa3, 0x78, 0xf2, 0xe0, 0x37, 0xf0, 0x12, 0xf4, 0x52, 0x3c, 0x79, 0x52, 0x49, 0x0e,
-	0xfa, 0x31, 0x24, 0xd9, 0xd9, 0x6d, 0x42, 0x36, 0xd9, 0xd6, 0x7a, 0x9a, 0x30, 0xef, 0xfb, 0xfc,
-	0xe6, 0x79, 0xc8, 0xbc, 0x3b, 0x70, 0xb3, 0xc5, 0x85, 0xcb, 0x85, 0x8d, 0x99, 0x90, 0x3e, 0x6b,
-	0x06, 0x92, 0x71, 0xcf, 0xee, 0x96, 0x9a, 0x44, 0x3a, 0x25, 0x5b, 0xee, 0x5b, 0x1d, 0x9f, 0x4b,
-	0xae, 0xaf, 0x87, 0x5d, 0xd6, 0x64, 0x97, 0xa5, 0xba, 0x8c, 0x1c, 0xe5, 0x94, 0x8f, 0xfb, 0xec,
-	0xd1, 0xaf, 0x50, 0x62, 0x20, 0x05, 0x6e, 0x3a, 0x82, 0xc4, 0xc0, 0x16, 0x67, 0x9e, 0xaa, 0xaf,
-	0x85, 0xf5, 0xdd, 0x50, 0xa8, 0xf8, 0x61, 0x69, 0x55, 0x49, 0x5d, 0x41, 0xed, 0x6e, 0x69, 0xb4,
-	0x84, 0x05, 0xf3, 0x13, 0x80, 0x17, 0xea, 0x82, 0xee, 0x10, 0xf9, 0x98, 0xc9, 0x3d, 0xec, 0x3b,
-	0xbd, 0xbb, 0x18, 0xfb, 0x44, 0x08, 0x7d, 0x1b, 0x66, 0x31, 0x69, 0x13, 0xea, 0x48, 0xee, 0xef,
-	0x3a, 0xe1, 0x66, 0x1e, 0x6c, 0x80, 0xe2, 0x4a, 0x35, 0xff, 0xf5, 0xe3, 0x56, 0x4e, 0xf1, 0x55,
-	0xfb, 0x8e, 0xf4, 0x99, 0x47, 0x1b, 0x99, 0x58, 0x12, 0x61, 0x6a, 0x30, 0xd3, 0x53, 0xe4, 0x98,
-	0xf2, 0x5f, 0x0a, 0xe5, 0x7c, 0x6f, 0xda, 0x4b, 0x05, 0xbd, 0x3a, 0x2c, 0x68, 0xbf, 0x0f, 0x0b,
-	0xda, 0xf3, 0x5f, 0x1f, 0xae, 0xce, 0xda, 0x32, 0x0b, 0xf0, 0x72, 0x62, 0x88, 0x06, 0x11, 0x1d,
-	0xee, 0x09, 0x62, 0x7e, 0x06, 0xd0, 0xa8, 0x0b, 0x1a, 0x95, 0xef, 0x45, 0x84, 0x06, 0xe9, 0x39,
-	0x3e, 0xfe, 0x57, 0x59, 0xb7, 0x61, 0xb6, 0xeb, 0xb4, 0x19, 0x9e, 0xc2, 0xa4, 0x85, 0xcd, 0xc4,
-	0x92, 0x93, 0xa6, 0xdd, 0x84, 0xe6, 0xfc, 0x2c, 0x71, 0xe4, 0x97, 0x00, 0xa2, 0x89, 0xb6, 0x47,
-	0xd1, 0x29, 0x35, 0xee, 0xba, 0x4c, 0x08, 0xc6, 0xbd, 0x64, 0xbf, 0xe0, 0x8c, 0x7e, 0x67, 0x88,
-	0x66, 0x11, 0x5e, 0x59, 0x6c, 0x24, 0xf6, 0xfc, 0x05, 0xc0, 0x5c, 0x5d, 0xd0, 0xfb, 0x81, 0x87,
-	0x47, 0xd5, 0xc0, 0x63, 0xf2, 0xe0, 0x21, 0xe7, 0x6d, 0xbd, 0x05, 0x97, 0x1d, 0x97, 0x07, 0x9e,
-	0xcc, 0x83, 0x8d, 0xa5, 0xe2, 0xb9, 0xf2, 0x9a, 0xa5, 0xbc, 0x8d, 0x66, 0x21, 0x1a, 0x1b, 0xab,
-	0xc6, 0x99, 0x57, 0xbd, 0xd6, 0xff, 0x5e, 0xd0, 0xde, 0xff, 0x28, 0x14, 0x29, 0x93, 0x7b, 0x41,
-	0xd3, 0x6a, 0x71, 0x57, 0xcd, 0x82, 0x5a, 0xb6, 0x04, 0x7e, 0x6a, 0xcb, 0x83, 0x0e, 0x11, 0x63,
-	0x81, 0x68, 0x28, 0xb4, 0x7e, 0x03, 0xae, 0x60, 0xd2, 0xe1, 0x82, 0x49, 0xee, 0xa7, 0xfe, 0x6d,
-	0xc7, 0xad, 0x95, 0x8b, 0x93, 0xf9, 0x8f, 0xf7, 0x4d, 0x04, 0x2f, 0x25, 0x85, 0x89, 0xd2, 0x96,
-	0xfb, 0xff, 0xc3, 0xa5, 0xba, 0xa0, 0xfa, 0x0b, 0x00, 0xf5, 0x84, 0x01, 0x2c, 0x5b, 0x0b, 0x3e,
-	0x11, 0x56, 0xe2, 0x7d, 0x37, 0x2a, 0xa7, 0xd7, 0x44, 0x76, 0xf4, 0x37, 0x00, 0xae, 0xce, 0x1b,
-	0x90, 0x9b, 0x69, 0xdc, 0x39, 0x42, 0xe3, 0xce, 0x5f, 0x0a, 0x63, 0x57, 0x6f, 0x01, 0x5c, 0x5f,
-	0x74, 0x87, 0x6f, 0x9f, 0xf4, 0x80, 0x04, 0xb1, 0x51, 0x3b, 0x83, 0x38, 0x76, 0xf8, 0x0c, 0xc0,
-	0xec, 0xec, 0x8d, 0x2d, 0xa5, 0xa1, 0x67, 0x24, 0xc6, 0xad, 0x53, 0x4b, 0x22, 0x0f, 0xd5, 0x07,
-	0xef, 0x06, 0x08, 0xf4, 0x07, 0x08, 0x1c, 0x0d, 0x10, 0xf8, 0x39, 0x40, 0xe0, 0xf5, 0x10, 0x69,
-	0x47, 0x43, 0xa4, 0x7d, 0x1b, 0x22, 0xed, 0x49, 0x69, 0xe1, 0x28, 0xec, 0x4f, 0xbf, 0x54, 0xe3,
-	0xc9, 0x68, 0x2e, 0x8f, 0x9f, 0x87, 0xeb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7f, 0x24, 0x06,
-	0x31, 0xcd, 0x06, 0x00, 0x00,
+	// 667 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xb1, 0x6f, 0xd3, 0x4c,
+	0x18, 0xc6, 0x7d, 0x5f, 0x3f, 0x2a, 0xf5, 0x8a, 0x68, 0x6b, 0x15, 0xda, 0xba, 0xe0, 0x54, 0xa6,
+	0x42, 0x11, 0xa2, 0x36, 0x09, 0x08, 0x44, 0x18, 0x50, 0x13, 0xca, 0x16, 0x51, 0xa5, 0x02, 0x24,
+	0x96, 0xea, 0x12, 0x9f, 0xdc, 0x13, 0xb5, 0xcf, 0xf2, 0x9d, 0x9b, 0x76, 0x04, 0x21, 0xc1, 0x88,
+	0xc4, 0x8a, 0x44, 0x47, 0xc4, 0xc4, 0xc0, 0xc8, 0xc6, 0x52, 0xc1, 0x52, 0x31, 0x31, 0x01, 0x4a,
+	0x07, 0xf8, 0x33, 0x90, 0xed, 0xb3, 0x9b, 0x10, 0xc7, 0x6e, 0x28, 0x93, 0x23, 0xbf, 0xcf, 0xf3,
+	0xbc, 0xbf, 0x37, 0xd6, 0x7b, 0x07, 0x17, 0x5b, 0x94, 0xd9, 0x94, 0x19, 0x26, 0x61, 0xdc, 0x23,
+	0x4d, 0x9f, 0x13, 0xea, 0x18, 0x5b, 0xa5, 0x26, 0xe6, 0xa8, 0x64, 0xf0, 0x6d, 0xdd, 0xf5, 0x28,
+	0xa7, 0xf2, 0x7c, 0xa4, 0xd2, 0xbb, 0x55, 0xba, 0x50, 0x29, 0xd3, 0x16, 0xb5, 0x68, 0xa8, 0x33,
+	0x82, 0x5f, 0x91, 0x45, 0x51, 0x45, 0x70, 0x13, 0x31, 0x9c, 0x04, 0xb6, 0x28, 0x71, 0x44, 0x7d,
+	0x2e, 0xaa, 0xaf, 0x47, 0x46, 0x91, 0x1f, 0x95, 0xf4, 0x2c, 0xa6, 0x1e, 0x84, 0x48, 0x3f, 0x23,
+	0xf4, 0x36, 0xb3, 0x8c, 0xad, 0x52, 0xf0, 0x88, 0x0a, 0xda, 0x47, 0x00, 0x4f, 0xd7, 0x99, 0xb5,
+	0x86, 0xf9, 0x03, 0xc2, 0x37, 0x4c, 0x0f, 0xb5, 0x97, 0x4d, 0xd3, 0xc3, 0x8c, 0xc9, 0x2b, 0x70,
+	0xca, 0xc4, 0x9b, 0xd8, 0x42, 0x9c, 0x7a, 0xeb, 0x28, 0x7a, 0x39, 0x0b, 0x16, 0x40, 0x71, 0xac,
+	0x3a, 0xfb, 0xe5, 0xfd, 0xd2, 0xb4, 0xe0, 0x11, 0xf2, 0x35, 0xee, 0x11, 0xc7, 0x6a, 0x4c, 0x26,
+	0x96, 0x38, 0xa6, 0x06, 0x27, 0xdb, 0x22, 0x39, 0x49, 0xf9, 0x2f, 0x27, 0x65, 0xa2, 0xdd, 0xcb,
+	0x52, 0x51, 0x9f, 0xef, 0x16, 0xa4, 0x5f, 0xbb, 0x05, 0xe9, 0xc9, 0xcf, 0x77, 0x17, 0xfb, 0xb1,
+	0xb4, 0x02, 0x3c, 0x97, 0x3a, 0x44, 0x03, 0x33, 0x97, 0x3a, 0x0c, 0x6b, 0x9f, 0x00, 0x54, 0xea,
+	0xcc, 0x8a, 0xcb, 0xb7, 0xe3, 0x84, 0x06, 0x6e, 0x23, 0xcf, 0xfc, 0x57, 0xb3, 0xae, 0xc0, 0xa9,
+	0x2d, 0xb4, 0x49, 0xcc, 0x9e, 0x98, 0xbc, 0x61, 0x27, 0x13, 0xcb, 0x51, 0xa7, 0x5d, 0x84, 0xda,
+	0xe0, 0x59, 0x92, 0x91, 0x9f, 0x01, 0xa8, 0x76, 0xc9, 0xee, 0xc7, 0x5d, 0x6a, 0xd4, 0xb6, 0x09,
+	0x63, 0x84, 0x3a, 0xe9, 0xbc, 0xe0, 0x98, 0xbc, 0x7d, 0x89, 0x5a, 0x11, 0x5e, 0xc8, 0x06, 0x49,
+	0x98, 0x3f, 0x03, 0x38, 0x5d, 0x67, 0xd6, 0x1d, 0xdf, 0x31, 0x83, 0xaa, 0xef, 0x10, 0xbe, 0xb3,
+	0x4a, 0xe9, 0xa6, 0xdc, 0x82, 0xa3, 0xc8, 0xa6, 0xbe, 0xc3, 0x67, 0xc1, 0xc2, 0x48, 0x71, 0xbc,
+	0x3c, 0x27, 0x16, 0x40, 0x0f, 0x76, 0x27, 0x5e, 0x33, 0xbd, 0x46, 0x89, 0x53, 0xbd, 0xbc, 0xf7,
+	0xad, 0x20, 0xbd, 0xfd, 0x5e, 0x28, 0x5a, 0x84, 0x6f, 0xf8, 0x4d, 0xbd, 0x45, 0x6d, 0xb1, 0x3b,
+	0xe2, 0xb1, 0xc4, 0xcc, 0x47, 0x06, 0xdf, 0x71, 0x31, 0x0b, 0x0d, 0xac, 0x21, 0xa2, 0xe5, 0x6b,
+	0x70, 0xcc, 0xc4, 0x2e, 0x65, 0x84, 0x53, 0x2f, 0xf7, 0xb3, 0x1d, 0x4a, 0x2b, 0x67, 0xba, 0xe7,
+	0x3f, 0x7c, 0xaf, 0xa9, 0xf0, 0x6c, 0xda, 0x30, 0xc9, 0xb4, 0xaf, 0x00, 0x9c, 0xa8, 0x33, 0xeb,
+	0x9e, 0x6b, 0x22, 0x8e, 0x57, 0x91, 0x87, 0x6c, 0x16, 0x30, 0x20, 0x9f, 0x6f, 0x50, 0x8f, 0xf0,
+	0x9d, 0xdc, 0x4f, 0x71, 0x28, 0x95, 0x97, 0xe1, 0xa8, 0x1b, 0x26, 0x84, 0xe0, 0xe3, 0xe5, 0xf3,
+	0x7a, 0xc6, 0x79, 0xa4, 0x47, 0xcd, 0xaa, 0xff, 0x07, 0x7f, 0x55, 0x43, 0x18, 0x2b, 0xa7, 0x42,
+	0xfc, 0x24, 0x52, 0x9b, 0x83, 0x33, 0x7f, 0xd0, 0xc5, 0xe4, 0xe5, 0x0f, 0x27, 0xe0, 0x48, 0x9d,
+	0x59, 0xf2, 0x53, 0x00, 0xe5, 0x94, 0xa3, 0xa3, 0x9c, 0xd9, 0x3c, 0x75, 0x53, 0x95, 0xca, 0xf0,
+	0x9e, 0x18, 0x47, 0x7e, 0x09, 0xe0, 0xcc, 0xa0, 0xd5, 0xbe, 0x9e, 0x97, 0x3b, 0xc0, 0xa8, 0xdc,
+	0xfa, 0x4b, 0x63, 0x42, 0xf5, 0x1a, 0xc0, 0xf9, 0xac, 0xed, 0xbb, 0x79, 0xd4, 0x06, 0x29, 0x66,
+	0xa5, 0x76, 0x0c, 0x73, 0x42, 0xf8, 0x18, 0xc0, 0xa9, 0xfe, 0x5d, 0x2b, 0xe5, 0x45, 0xf7, 0x59,
+	0x94, 0x1b, 0x43, 0x5b, 0x12, 0x06, 0x0f, 0x9e, 0xec, 0x59, 0x80, 0x4b, 0x79, 0x51, 0xdd, 0x6a,
+	0xe5, 0xea, 0x30, 0xea, 0xb8, 0x67, 0xf5, 0xee, 0x9b, 0x8e, 0x0a, 0xf6, 0x3a, 0x2a, 0xd8, 0xef,
+	0xa8, 0xe0, 0x47, 0x47, 0x05, 0x2f, 0x0e, 0x54, 0x69, 0xff, 0x40, 0x95, 0xbe, 0x1e, 0xa8, 0xd2,
+	0xc3, 0x52, 0xe6, 0xc1, 0xb1, 0xdd, 0x7b, 0xe7, 0x86, 0xe7, 0x48, 0x73, 0x34, 0xbc, 0x4c, 0xaf,
+	0xfc, 0x1e, 0x00, 0xad, 0x57, 0x9a, 0xa8, 0x2b, 0x08, 0x00, 0x00,
 }
 
 func (this *MsgSetWithdrawAddressResponse) Equal(that interface{}) bool {
@@ -481,6 +584,54 @@ func (this *MsgFundCommunityPoolResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -506,6 +657,9 @@ type MsgClient interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(ctx context.Context, in *MsgFundCommunityPool, opts ...grpc.CallOption) (*MsgFundCommunityPoolResponse, error)
+	// UpdateParams defines a governance operation for updating the x/distribution module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -552,6 +706,15 @@ func (c *msgClient) FundCommunityPool(ctx context.Context, in *MsgFundCommunityP
 	return out, nil
 }
 
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.distribution.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetWithdrawAddress defines a method to change the withdraw address
@@ -566,6 +729,9 @@ type MsgServer interface {
 	// FundCommunityPool defines a method to allow an account to directly
 	// fund the community pool.
 	FundCommunityPool(context.Context, *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error)
+	// UpdateParams defines a governance operation for updating the x/distribution module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -584,6 +750,9 @@ func (*UnimplementedMsgServer) WithdrawValidatorCommission(ctx context.Context,
 func (*UnimplementedMsgServer) FundCommunityPool(ctx context.Context, req *MsgFundCommunityPool) (*MsgFundCommunityPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FundCommunityPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -661,6 +830,24 @@ func _Msg_FundCommunityPool_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.distribution.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.distribution.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -681,6 +868,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FundCommunityPool",
 			Handler:    _Msg_FundCommunityPool_Handler,
 		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/distribution/v1beta1/tx.proto",
@@ -926,6 +1117,69 @@ func (m *MsgFundCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1039,6 +1293,30 @@ func (m *MsgFundCommunityPoolResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1671,6 +1949,171 @@ func (m *MsgFundCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,48 @@
+package epoching
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers all the necessary epoching module concrete
+// types with the provided codec reference.
+// These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgWrappedCreateValidator{}, "cosmos-sdk/MsgWrappedCreateValidator", nil)
+	cdc.RegisterConcrete(&MsgWrappedEditValidator{}, "cosmos-sdk/MsgWrappedEditValidator", nil)
+	cdc.RegisterConcrete(&MsgWrappedDelegate{}, "cosmos-sdk/MsgWrappedDelegate", nil)
+	cdc.RegisterConcrete(&MsgWrappedBeginRedelegate{}, "cosmos-sdk/MsgWrappedBeginRedelegate", nil)
+	cdc.RegisterConcrete(&MsgWrappedUndelegate{}, "cosmos-sdk/MsgWrappedUndelegate", nil)
+	cdc.RegisterConcrete(&MsgWrappedCancelUnbondingDelegation{}, "cosmos-sdk/MsgWrappedCancelUnbondingDelegation", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWrappedCreateValidator{},
+		&MsgWrappedEditValidator{},
+		&MsgWrappedDelegate{},
+		&MsgWrappedBeginRedelegate{},
+		&MsgWrappedUndelegate{},
+		&MsgWrappedCancelUnbondingDelegation{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	amino = codec.NewLegacyAmino()
+
+	// ModuleCdc references the global x/epoching module codec, used for the Amino
+	// JSON sign bytes of the wrapped messages.
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+func init() {
+	RegisterLegacyAminoCodec(amino)
+	cryptocodec.RegisterCrypto(amino)
+	amino.Seal()
+}
@@ -0,0 +1,13 @@
+package epoching
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/epoching module sentinel errors
+var (
+	ErrInvalidMsg        = sdkerrors.Register(ModuleName, 2, "invalid wrapped message")
+	ErrInvalidDenom      = sdkerrors.Register(ModuleName, 3, "invalid coin denomination")
+	ErrValidatorNotFound = sdkerrors.Register(ModuleName, 4, "validator does not exist")
+	ErrUnwrappedMsg      = sdkerrors.Register(ModuleName, 5, "staking message must be wrapped in an epoching message")
+)
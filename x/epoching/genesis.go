@@ -0,0 +1,50 @@
+package epoching
+
+import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, epochNumber uint64, queuedMsgs []*codectypes.Any) *GenesisState {
+	return &GenesisState{
+		Params:         params,
+		EpochNumber:    epochNumber,
+		QueuedMessages: queuedMsgs,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the epoching module
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), 0, nil)
+}
+
+// ValidateGenesis checks the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	for i, any := range data.QueuedMessages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return fmt.Errorf("queued message %d: cannot unpack %s as sdk.Msg", i, any.GetTypeUrl())
+		}
+		if err := msg.ValidateBasic(); err != nil {
+			return fmt.Errorf("queued message %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (data GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, any := range data.QueuedMessages {
+		var msg sdk.Msg
+		if err := unpacker.UnpackAny(any, &msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+// InitGenesis initializes the epoching module's state from a genesis state. The current epoch
+// starts at the genesis block, and the queued messages are queued again in it. Their escrowed
+// coins are expected to be held by the module account in the bank genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *epoching.GenesisState) {
+	k.SetParams(ctx, data.Params)
+
+	startHeight := ctx.BlockHeight()
+	if startHeight < 1 {
+		// InitChain runs at height 0 when the chain starts at the default initial height
+		startHeight = 1
+	}
+	k.SetEpoch(ctx, epoching.Epoch{Number: data.EpochNumber, StartHeight: startHeight})
+
+	for _, any := range data.QueuedMessages {
+		msg, ok := any.GetCachedValue().(sdk.Msg)
+		if !ok {
+			panic("cannot unpack queued message " + any.GetTypeUrl())
+		}
+		if _, err := k.QueueMsg(ctx, msg); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// ExportGenesis returns the epoching module's genesis state. The IDs and queued heights of the
+// queued messages are not exported.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *epoching.GenesisState {
+	epoch := k.GetEpoch(ctx)
+	var queuedMsgs []*codectypes.Any
+	k.IterateQueuedMessages(ctx, epoch.Number, func(queued epoching.QueuedMessage) bool {
+		queuedMsgs = append(queuedMsgs, queued.Msg)
+		return false
+	})
+	return epoching.NewGenesisState(k.GetParams(ctx), epoch.Number, queuedMsgs)
+}
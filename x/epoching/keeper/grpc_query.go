@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+var _ epoching.QueryServer = Keeper{}
+
+// Params returns the parameters of the epoching module
+func (k Keeper) Params(goCtx context.Context, r *epoching.QueryParamsRequest) (*epoching.QueryParamsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &epoching.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// CurrentEpoch returns the current epoch and the height at which it ends
+func (k Keeper) CurrentEpoch(goCtx context.Context, r *epoching.QueryCurrentEpochRequest) (*epoching.QueryCurrentEpochResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &epoching.QueryCurrentEpochResponse{
+		Epoch:     k.GetEpoch(ctx),
+		EndHeight: k.GetEpochEndHeight(ctx),
+	}, nil
+}
+
+// QueuedMessages returns the messages queued in the current epoch, optionally filtered by type
+func (k Keeper) QueuedMessages(goCtx context.Context, r *epoching.QueryQueuedMessagesRequest) (*epoching.QueryQueuedMessagesResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), queuedMessagesKey(k.GetEpoch(ctx).Number))
+	var msgs []*epoching.QueuedMessage
+	pageRes, err := query.FilteredPaginate(store, r.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+		var queued epoching.QueuedMessage
+		if err := k.cdc.Unmarshal(value, &queued); err != nil {
+			return false, err
+		}
+		if r.MsgTypeUrl != "" && queued.Msg.GetTypeUrl() != r.MsgTypeUrl {
+			return false, nil
+		}
+		if accumulate {
+			msgs = append(msgs, &queued)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &epoching.QueryQueuedMessagesResponse{Messages: msgs, Pagination: pageRes}, nil
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+const (
+	escrowInvariant = "escrow-balance"
+	queueInvariant  = "queue-epoch"
+)
+
+// RegisterInvariants registers all epoching invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, ak epoching.AccountKeeper) {
+	ir.RegisterRoute(epoching.ModuleName, escrowInvariant, EscrowBalanceInvariant(keeper, ak))
+	ir.RegisterRoute(epoching.ModuleName, queueInvariant, QueueEpochInvariant(keeper))
+}
+
+// EscrowBalanceInvariant checks that the balance of the module account equals the sum of
+// the coins escrowed for the queued delegations and validator creations, and thus is zero
+// when the queue is empty.
+func EscrowBalanceInvariant(keeper Keeper, ak epoching.AccountKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			escrowed sdk.Coins
+			msg      string
+		)
+		keeper.IterateQueuedMessages(ctx, keeper.GetEpoch(ctx).Number, func(queued epoching.QueuedMessage) bool {
+			_, coins, err := EscrowedCoins(queued.UnpackedMsg())
+			if err != nil {
+				msg += fmt.Sprintf("\tqueued message %d: %s\n", queued.Id, err)
+				return false
+			}
+			escrowed = escrowed.Add(coins...)
+			return false
+		})
+
+		balance := keeper.bankKeeper.GetAllBalances(ctx, ak.GetModuleAddress(epoching.ModuleName))
+		broken := msg != "" || !balance.IsEqual(escrowed)
+		return sdk.FormatInvariant(epoching.ModuleName, escrowInvariant, fmt.Sprintf(
+			"\tmodule account balance: %s\n\tescrowed for queued messages: %s\n%s", balance, escrowed, msg,
+		)), broken
+	}
+}
+
+// QueueEpochInvariant checks that all the queued messages belong to the current epoch, i.e.
+// that the queue of every past epoch was fully executed.
+func QueueEpochInvariant(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		current := keeper.GetEpoch(ctx).Number
+		var stale int
+		iterator := sdk.KVStorePrefixIterator(ctx.KVStore(keeper.storeKey), QueuedMessagePrefix)
+		defer iterator.Close()
+		for ; iterator.Valid(); iterator.Next() {
+			var queued epoching.QueuedMessage
+			keeper.cdc.MustUnmarshal(iterator.Value(), &queued)
+			if queued.EpochNumber != current {
+				stale++
+			}
+		}
+
+		return sdk.FormatInvariant(epoching.ModuleName, queueInvariant, fmt.Sprintf(
+			"\tfound %d messages queued in an epoch other than the current epoch %d\n", stale, current,
+		)), stale != 0
+	}
+}
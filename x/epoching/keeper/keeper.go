@@ -1,192 +1,269 @@
 package keeper
 
 import (
-	"time"
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	db "github.com/tendermint/tm-db"
-)
-
-const (
-	DefaultEpochActionID = 1
-	DefaultEpochNumber   = 0
-)
-
-var (
-	NextEpochActionID      = []byte{0x11}
-	EpochNumberID          = []byte{0x12}
-	EpochActionQueuePrefix = []byte{0x13} // prefix for the epoch
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
-// Keeper of the store
+// Keeper of the epoching store
 type Keeper struct {
-	storeKey storetypes.StoreKey
-	cdc      codec.BinaryCodec
-	// Used to calculate the estimated next epoch time.
-	// This is local to every node
-	// TODO: remove in favor of consensus param when its added
-	commitTimeout time.Duration
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	paramSpace    paramtypes.Subspace
+	router        *authmiddleware.MsgServiceRouter
+	bankKeeper    epoching.BankKeeper
+	stakingKeeper epoching.StakingKeeper
 }
 
-// NewKeeper creates a epoch queue manager
-func NewKeeper(cdc codec.BinaryCodec, key storetypes.StoreKey, commitTimeout time.Duration) Keeper {
+// NewKeeper creates a new epoching Keeper instance. The queued messages are
+// executed through router at the end of each epoch.
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace, router *authmiddleware.MsgServiceRouter,
+	ak epoching.AccountKeeper, bk epoching.BankKeeper, sk epoching.StakingKeeper,
+) Keeper {
+	// ensure the epoching module account, which escrows the queued delegations, is set
+	if addr := ak.GetModuleAddress(epoching.ModuleName); addr == nil {
+		panic("the epoching module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(epoching.ParamKeyTable())
+	}
+
 	return Keeper{
 		storeKey:      key,
 		cdc:           cdc,
-		commitTimeout: commitTimeout,
+		paramSpace:    paramSpace,
+		router:        router,
+		bankKeeper:    bk,
+		stakingKeeper: sk,
 	}
 }
 
-// GetNewActionID returns ID to be used for next epoch
-func (k Keeper) GetNewActionID(ctx sdk.Context) uint64 {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(NextEpochActionID)
-	if bz == nil {
-		// return default action ID to 1
-		return DefaultEpochActionID
-	}
-	id := sdk.BigEndianToUint64(bz)
-
-	// increment next action ID
-	store.Set(NextEpochActionID, sdk.Uint64ToBigEndian(id+1))
-
-	return id
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", epoching.ModuleName))
 }
 
-// ActionStoreKey returns action store key from ID
-func ActionStoreKey(epochNumber int64, actionID uint64) []byte {
-	return append(EpochActionQueuePrefix, byte(epochNumber), byte(actionID))
+// GetParams returns the total set of epoching parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params epoching.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
 }
 
-// QueueMsgForEpoch save the actions that need to be executed on next epoch
-func (k Keeper) QueueMsgForEpoch(ctx sdk.Context, epochNumber int64, msg sdk.Msg) {
-	store := ctx.KVStore(k.storeKey)
+// SetParams sets the total set of epoching parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params epoching.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
 
-	bz, err := k.cdc.MarshalInterface(msg)
-	if err != nil {
-		panic(err)
+// GetEpoch returns the current epoch
+func (k Keeper) GetEpoch(ctx sdk.Context) epoching.Epoch {
+	var epoch epoching.Epoch
+	bz := ctx.KVStore(k.storeKey).Get(EpochKey)
+	if bz == nil {
+		return epoch
 	}
-
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+	k.cdc.MustUnmarshal(bz, &epoch)
+	return epoch
 }
 
-// RestoreEpochAction restore the actions that need to be executed on next epoch
-func (k Keeper) RestoreEpochAction(ctx sdk.Context, epochNumber int64, action *codectypes.Any) {
-	store := ctx.KVStore(k.storeKey)
-
-	// reference from TestMarshalAny(t *testing.T)
-	bz, err := k.cdc.MarshalInterface(action)
-	if err != nil {
-		panic(err)
-	}
+// SetEpoch sets the current epoch
+func (k Keeper) SetEpoch(ctx sdk.Context, epoch epoching.Epoch) {
+	ctx.KVStore(k.storeKey).Set(EpochKey, k.cdc.MustMarshal(&epoch))
+}
 
-	actionID := k.GetNewActionID(ctx)
-	store.Set(ActionStoreKey(epochNumber, actionID), bz)
+// GetEpochEndHeight returns the height of the last block of the current epoch,
+// at the end of which the queued messages are executed
+func (k Keeper) GetEpochEndHeight(ctx sdk.Context) int64 {
+	return k.GetEpoch(ctx).StartHeight + int64(k.GetParams(ctx).EpochLength) - 1
 }
 
-// GetEpochMsg gets a msg by ID
-func (k Keeper) GetEpochMsg(ctx sdk.Context, epochNumber int64, actionID uint64) sdk.Msg {
+// nextMessageID returns the ID to be used for the next queued message and increments it
+func (k Keeper) nextMessageID(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(ActionStoreKey(epochNumber, actionID))
-	if bz == nil {
-		return nil
+	id := uint64(1)
+	if bz := store.Get(NextMessageIDKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
 	}
-
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
-
-	return action
+	store.Set(NextMessageIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
 }
 
-// GetEpochActions get all actions
-func (k Keeper) GetEpochActions(ctx sdk.Context) []sdk.Msg {
-	actions := []sdk.Msg{}
-	iterator := k.GetEpochActionsIterator(ctx)
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		var action sdk.Msg
-		bz := iterator.Value()
-		k.cdc.UnmarshalInterface(bz, &action)
-		actions = append(actions, action)
+// QueueMsg queues msg for execution at the end of the current epoch and returns its ID.
+// The coins escrowed for the message, if any, must already be held by the module account.
+func (k Keeper) QueueMsg(ctx sdk.Context, msg sdk.Msg) (uint64, error) {
+	any, err := codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return 0, err
 	}
-
-	return actions
-}
-
-// GetEpochActionsIterator returns iterator for EpochActions
-func (k Keeper) GetEpochActionsIterator(ctx sdk.Context) db.Iterator {
-	return sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), EpochActionQueuePrefix)
+	epoch := k.GetEpoch(ctx)
+	queued := epoching.QueuedMessage{
+		Id:           k.nextMessageID(ctx),
+		EpochNumber:  epoch.Number,
+		QueuedHeight: ctx.BlockHeight(),
+		Msg:          any,
+	}
+	bz, err := k.cdc.Marshal(&queued)
+	if err != nil {
+		return 0, err
+	}
+	ctx.KVStore(k.storeKey).Set(queuedMessageKey(queued.EpochNumber, queued.Id), bz)
+
+	err = ctx.EventManager().EmitTypedEvent(&epoching.EventMessageQueued{
+		Id:          queued.Id,
+		EpochNumber: queued.EpochNumber,
+		MsgTypeUrl:  sdk.MsgTypeURL(msg),
+	})
+	return queued.Id, err
 }
 
-// DequeueEpochActions dequeue all the actions store on epoch
-func (k Keeper) DequeueEpochActions(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, EpochActionQueuePrefix)
+// IterateQueuedMessages iterates over the messages queued in the given epoch in execution order,
+// until cb returns true
+func (k Keeper) IterateQueuedMessages(ctx sdk.Context, epochNumber uint64, cb func(queued epoching.QueuedMessage) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), queuedMessagesKey(epochNumber))
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-		store.Delete(key)
+		var queued epoching.QueuedMessage
+		k.cdc.MustUnmarshal(iterator.Value(), &queued)
+		if cb(queued) {
+			break
+		}
 	}
 }
 
-// DeleteByKey delete item by key
-func (k Keeper) DeleteByKey(ctx sdk.Context, key []byte) {
-	store := ctx.KVStore(k.storeKey)
-	store.Delete(key)
+// GetQueuedMessages returns the messages queued in the given epoch in execution order
+func (k Keeper) GetQueuedMessages(ctx sdk.Context, epochNumber uint64) []epoching.QueuedMessage {
+	var msgs []epoching.QueuedMessage
+	k.IterateQueuedMessages(ctx, epochNumber, func(queued epoching.QueuedMessage) bool {
+		msgs = append(msgs, queued)
+		return false
+	})
+	return msgs
 }
 
-// GetEpochActionByIterator get action by iterator
-func (k Keeper) GetEpochActionByIterator(iterator db.Iterator) sdk.Msg {
-	bz := iterator.Value()
-
-	var action sdk.Msg
-	k.cdc.UnmarshalInterface(bz, &action)
+// EndEpoch executes the messages queued in the current epoch and starts the next epoch
+// at the following block
+func (k Keeper) EndEpoch(ctx sdk.Context) error {
+	epoch := k.GetEpoch(ctx)
+	for _, queued := range k.GetQueuedMessages(ctx, epoch.Number) {
+		if err := k.executeQueuedMessage(ctx, queued); err != nil {
+			return err
+		}
+	}
 
-	return action
-}
+	k.SetEpoch(ctx, epoching.Epoch{
+		Number:      epoch.Number + 1,
+		StartHeight: ctx.BlockHeight() + 1,
+	})
 
-// SetEpochNumber set epoch number
-func (k Keeper) SetEpochNumber(ctx sdk.Context, epochNumber int64) {
-	store := ctx.KVStore(k.storeKey)
-	store.Set(EpochNumberID, sdk.Uint64ToBigEndian(uint64(epochNumber)))
+	return ctx.EventManager().EmitTypedEvent(&epoching.EventEpochEnded{
+		EpochNumber: epoch.Number,
+		EndHeight:   ctx.BlockHeight(),
+	})
 }
 
-// GetEpochNumber fetches epoch number
-func (k Keeper) GetEpochNumber(ctx sdk.Context) int64 {
-	store := ctx.KVStore(k.storeKey)
-
-	bz := store.Get(EpochNumberID)
-	if bz == nil {
-		return DefaultEpochNumber
+// executeQueuedMessage releases the coins escrowed for a queued message back to its signer
+// and executes the message. A failing message leaves the state untouched, except for the
+// released escrow, and only results in an error being reported in the emitted event. A
+// message whose escrow can't be released isn't executed, and fails the same way.
+func (k Keeper) executeQueuedMessage(ctx sdk.Context, queued epoching.QueuedMessage) error {
+	ctx.KVStore(k.storeKey).Delete(queuedMessageKey(queued.EpochNumber, queued.Id))
+
+	var execErr error
+	msg := queued.UnpackedMsg()
+	if msg == nil {
+		execErr = sdkerrors.Wrapf(epoching.ErrInvalidMsg, "cannot unpack queued message %d", queued.Id)
+	} else if err := k.releaseEscrow(ctx, msg); err != nil {
+		execErr = sdkerrors.Wrap(err, "failed to release the escrow")
+	} else {
+		execErr = k.execute(ctx, msg)
 	}
 
-	return int64(sdk.BigEndianToUint64(bz))
+	event := epoching.EventMessageExecuted{
+		Id:          queued.Id,
+		EpochNumber: queued.EpochNumber,
+		MsgTypeUrl:  queued.Msg.GetTypeUrl(),
+	}
+	if execErr != nil {
+		event.Error = execErr.Error()
+		k.Logger(ctx).Info("queued message failed", "id", queued.Id, "type", event.MsgTypeUrl, "err", execErr)
+	}
+	return ctx.EventManager().EmitTypedEvent(&event)
 }
 
-// IncreaseEpochNumber increases epoch number
-func (k Keeper) IncreaseEpochNumber(ctx sdk.Context) {
-	epochNumber := k.GetEpochNumber(ctx)
-	k.SetEpochNumber(ctx, epochNumber+1)
+// execute routes msg to its handler, and only commits its state changes if it succeeds
+func (k Keeper) execute(ctx sdk.Context, msg sdk.Msg) error {
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return sdkerrors.Wrapf(epoching.ErrInvalidMsg, "no message handler found for %q", sdk.MsgTypeURL(msg))
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	if _, err := handler(cacheCtx, msg); err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
 }
 
-// GetNextEpochHeight returns next epoch block height
-func (k Keeper) GetNextEpochHeight(ctx sdk.Context, epochInterval int64) int64 {
-	currentHeight := ctx.BlockHeight()
-	return currentHeight + (epochInterval - currentHeight%epochInterval)
+// EscrowedCoins returns the coins escrowed by the module for a queued message, and the account
+// they are released to before the message is executed
+func EscrowedCoins(msg sdk.Msg) (sdk.AccAddress, sdk.Coins, error) {
+	var (
+		owner  string
+		amount sdk.Coin
+	)
+	switch msg := msg.(type) {
+	case *stakingtypes.MsgDelegate:
+		owner, amount = msg.DelegatorAddress, msg.Amount
+	case *stakingtypes.MsgCreateValidator:
+		owner, amount = msg.DelegatorAddress, msg.Value
+	default:
+		return nil, nil, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return nil, nil, err
+	}
+	return addr, sdk.NewCoins(amount), nil
 }
 
-// GetNextEpochTime returns estimated next epoch time
-func (k Keeper) GetNextEpochTime(ctx sdk.Context, epochInterval int64) time.Time {
-	currentTime := ctx.BlockTime()
-	currentHeight := ctx.BlockHeight()
+// escrow delegates the coins needed to execute msg from its signer to the module account. The
+// coins are escrowed as a delegation, so that a vesting account can queue the delegation of its
+// locked coins, as it can delegate them with a MsgDelegate.
+func (k Keeper) escrow(ctx sdk.Context, msg sdk.Msg) error {
+	owner, coins, err := EscrowedCoins(msg)
+	if err != nil || coins.IsZero() {
+		return err
+	}
+	return k.bankKeeper.DelegateCoinsFromAccountToModule(ctx, owner, epoching.ModuleName, coins)
+}
 
-	return currentTime.Add(k.commitTimeout * time.Duration(k.GetNextEpochHeight(ctx, epochInterval)-currentHeight))
+// releaseEscrow undelegates the coins escrowed for msg back to its signer, right before the
+// message delegates them again. The state is only changed if the release succeeds.
+func (k Keeper) releaseEscrow(ctx sdk.Context, msg sdk.Msg) error {
+	owner, coins, err := EscrowedCoins(msg)
+	if err != nil || coins.IsZero() {
+		return err
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(cacheCtx, epoching.ModuleName, owner, coins); err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
 }
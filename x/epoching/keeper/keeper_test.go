@@ -0,0 +1,370 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+	epochingmodule "github.com/cosmos/cosmos-sdk/x/epoching/module"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+const testEpochLength = 5
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	addrs       []sdk.AccAddress
+	validator   stakingtypes.Validator
+	bondDenom   string
+	queryClient epoching.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	epoching.RegisterQueryServer(queryHelper, app.EpochingKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = epoching.NewQueryClient(queryHelper)
+	s.addrs = simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(30000000))
+	s.validator = app.StakingKeeper.GetAllValidators(ctx)[0]
+	s.bondDenom = app.StakingKeeper.BondDenom(ctx)
+	app.EpochingKeeper.SetParams(ctx, epoching.NewParams(testEpochLength))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) moduleBalance() sdk.Coins {
+	return s.app.BankKeeper.GetAllBalances(s.ctx, s.app.AccountKeeper.GetModuleAddress(epoching.ModuleName))
+}
+
+func (s *TestSuite) requireInvariants() {
+	for _, invariant := range []sdk.Invariant{
+		keeper.EscrowBalanceInvariant(s.app.EpochingKeeper, s.app.AccountKeeper),
+		keeper.QueueEpochInvariant(s.app.EpochingKeeper),
+	} {
+		msg, broken := invariant(s.ctx)
+		s.Require().False(broken, msg)
+	}
+}
+
+func (s *TestSuite) TestGenesisEpoch() {
+	epoch := s.app.EpochingKeeper.GetEpoch(s.ctx)
+	s.Require().Equal(epoching.Epoch{Number: 0, StartHeight: 1}, epoch)
+	s.Require().Equal(int64(testEpochLength), s.app.EpochingKeeper.GetEpochEndHeight(s.ctx))
+
+	res, err := s.queryClient.CurrentEpoch(s.ctx, &epoching.QueryCurrentEpochRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(epoch, res.Epoch)
+	s.Require().Equal(int64(testEpochLength), res.EndHeight)
+
+	params, err := s.queryClient.Params(s.ctx, &epoching.QueryParamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(epoching.NewParams(testEpochLength), params.Params)
+}
+
+func (s *TestSuite) TestQueueAndExecute() {
+	delegator := s.addrs[0]
+	valAddr := s.validator.GetOperator()
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+	balance := s.app.BankKeeper.GetBalance(s.ctx, delegator, s.bondDenom)
+
+	delegateRes, err := s.app.EpochingKeeper.WrappedDelegate(sdk.WrapSDKContext(s.ctx),
+		epoching.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delegator, valAddr, amount)))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), delegateRes.Id)
+	undelegateRes, err := s.app.EpochingKeeper.WrappedUndelegate(sdk.WrapSDKContext(s.ctx),
+		epoching.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(delegator, valAddr, sdk.NewCoin(s.bondDenom, sdk.NewInt(400)))))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), undelegateRes.Id)
+
+	// the delegated amount is escrowed until the end of the epoch
+	s.Require().Equal(balance.Sub(amount), s.app.BankKeeper.GetBalance(s.ctx, delegator, s.bondDenom))
+	s.Require().Equal(sdk.NewCoins(amount), s.moduleBalance())
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, valAddr)
+	s.Require().False(found)
+	s.requireInvariants()
+
+	res, err := s.queryClient.QueuedMessages(s.ctx, &epoching.QueryQueuedMessagesRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.Messages, 2)
+	s.Require().Equal(uint64(1), res.Messages[0].Id)
+	s.Require().Equal(int64(2), res.Messages[0].QueuedHeight)
+	s.Require().Equal(stakingtypes.NewMsgDelegate(delegator, valAddr, amount), res.Messages[0].UnpackedMsg())
+	res, err = s.queryClient.QueuedMessages(s.ctx, &epoching.QueryQueuedMessagesRequest{
+		MsgTypeUrl: sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Messages, 1)
+	s.Require().Equal(uint64(2), res.Messages[0].Id)
+
+	// nothing happens before the last block of the epoch
+	s.ctx = s.ctx.WithBlockHeight(testEpochLength - 1)
+	epochingmodule.EndBlocker(s.ctx, s.app.EpochingKeeper)
+	s.Require().Len(s.app.EpochingKeeper.GetQueuedMessages(s.ctx, 0), 2)
+
+	s.ctx = s.ctx.WithBlockHeight(testEpochLength)
+	epochingmodule.EndBlocker(s.ctx, s.app.EpochingKeeper)
+	s.Require().Empty(s.app.EpochingKeeper.GetQueuedMessages(s.ctx, 0))
+	s.Require().Equal(epoching.Epoch{Number: 1, StartHeight: testEpochLength + 1}, s.app.EpochingKeeper.GetEpoch(s.ctx))
+	s.Require().Equal(int64(2*testEpochLength), s.app.EpochingKeeper.GetEpochEndHeight(s.ctx))
+
+	// the messages were executed in order
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, valAddr)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(600), s.validator.TokensFromShares(delegation.Shares).TruncateInt())
+	s.Require().Equal(balance.Sub(amount), s.app.BankKeeper.GetBalance(s.ctx, delegator, s.bondDenom))
+	s.Require().True(s.moduleBalance().IsZero())
+	s.requireInvariants()
+}
+
+func (s *TestSuite) TestQueueCancelUnbondingDelegation() {
+	delegator := s.addrs[0]
+	valAddr := s.validator.GetOperator()
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+
+	stakingMsgServer := stakingkeeper.NewMsgServerImpl(s.app.StakingKeeper)
+	_, err := stakingMsgServer.Delegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgDelegate(delegator, valAddr, amount))
+	s.Require().NoError(err)
+	_, err = stakingMsgServer.Undelegate(sdk.WrapSDKContext(s.ctx), stakingtypes.NewMsgUndelegate(delegator, valAddr, amount))
+	s.Require().NoError(err)
+
+	cancelMsg := stakingtypes.NewMsgCancelUnbondingDelegation(delegator, valAddr, s.ctx.BlockHeight(), sdk.NewCoin(s.bondDenom, sdk.NewInt(400)))
+	res, err := s.app.EpochingKeeper.WrappedCancelUnbondingDelegation(sdk.WrapSDKContext(s.ctx),
+		epoching.NewMsgWrappedCancelUnbondingDelegation(cancelMsg))
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), res.Id)
+
+	// the unbonding delegation is untouched until the end of the epoch
+	ubd, found := s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, delegator, valAddr)
+	s.Require().True(found)
+	s.Require().Equal(amount.Amount, ubd.Entries[0].Balance)
+	s.requireInvariants()
+
+	s.ctx = s.ctx.WithBlockHeight(testEpochLength)
+	epochingmodule.EndBlocker(s.ctx, s.app.EpochingKeeper)
+	s.Require().Empty(s.app.EpochingKeeper.GetQueuedMessages(s.ctx, 0))
+
+	ubd, found = s.app.StakingKeeper.GetUnbondingDelegation(s.ctx, delegator, valAddr)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(600), ubd.Entries[0].Balance)
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, valAddr)
+	s.Require().True(found)
+	s.Require().Equal(sdk.NewInt(400), s.validator.TokensFromShares(delegation.Shares).TruncateInt())
+	s.requireInvariants()
+}
+
+func (s *TestSuite) TestQueueVestingDelegation() {
+	delegator := sdk.AccAddress("vesting_delegator___")
+	valAddr := s.validator.GetOperator()
+	vesting := sdk.NewCoins(sdk.NewCoin(s.bondDenom, sdk.NewInt(1000)))
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(600))
+
+	s.Require().NoError(testutil.FundAccount(s.app.BankKeeper, s.ctx, delegator, vesting))
+	baseAcc := authtypes.NewBaseAccountWithAddress(delegator)
+	baseAcc.AccountNumber = s.app.AccountKeeper.GetAccount(s.ctx, delegator).GetAccountNumber()
+	s.app.AccountKeeper.SetAccount(s.ctx, vestingtypes.NewDelayedVestingAccount(baseAcc, vesting, s.ctx.BlockTime().Add(time.Hour).Unix()))
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, delegator).IsZero())
+
+	// the locked coins are escrowed as a delegation
+	_, err := s.app.EpochingKeeper.WrappedDelegate(sdk.WrapSDKContext(s.ctx),
+		epoching.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delegator, valAddr, amount)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(amount), s.moduleBalance())
+	acc := s.app.AccountKeeper.GetAccount(s.ctx, delegator).(*vestingtypes.DelayedVestingAccount)
+	s.Require().Equal(sdk.NewCoins(amount), acc.DelegatedVesting)
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, delegator).IsZero())
+	s.requireInvariants()
+
+	s.ctx = s.ctx.WithBlockHeight(testEpochLength)
+	epochingmodule.EndBlocker(s.ctx, s.app.EpochingKeeper)
+
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, valAddr)
+	s.Require().True(found)
+	s.Require().Equal(amount.Amount, s.validator.TokensFromShares(delegation.Shares).TruncateInt())
+	acc = s.app.AccountKeeper.GetAccount(s.ctx, delegator).(*vestingtypes.DelayedVestingAccount)
+	s.Require().Equal(sdk.NewCoins(amount), acc.DelegatedVesting)
+	s.Require().True(acc.DelegatedFree.IsZero())
+	s.Require().True(s.app.BankKeeper.SpendableCoins(s.ctx, delegator).IsZero())
+	s.Require().True(s.moduleBalance().IsZero())
+	s.requireInvariants()
+}
+
+func (s *TestSuite) TestFailedMessageReleasesEscrow() {
+	creator := s.addrs[1]
+	value := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+	balance := s.app.BankKeeper.GetBalance(s.ctx, creator, s.bondDenom)
+
+	// the consensus key is already used by the genesis validator, so the creation fails
+	pubKey, err := s.validator.ConsPubKey()
+	s.Require().NoError(err)
+	createMsg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(creator), pubKey, value, stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	s.Require().NoError(err)
+	_, err = s.app.EpochingKeeper.WrappedCreateValidator(sdk.WrapSDKContext(s.ctx), epoching.NewMsgWrappedCreateValidator(createMsg))
+	s.Require().NoError(err)
+	s.Require().Equal(balance.Sub(value), s.app.BankKeeper.GetBalance(s.ctx, creator, s.bondDenom))
+	s.requireInvariants()
+
+	s.ctx = s.ctx.WithBlockHeight(testEpochLength).WithEventManager(sdk.NewEventManager())
+	epochingmodule.EndBlocker(s.ctx, s.app.EpochingKeeper)
+
+	_, found := s.app.StakingKeeper.GetValidator(s.ctx, sdk.ValAddress(creator))
+	s.Require().False(found)
+	s.Require().Equal(balance, s.app.BankKeeper.GetBalance(s.ctx, creator, s.bondDenom))
+	s.Require().True(s.moduleBalance().IsZero())
+	s.requireInvariants()
+
+	var executed *epoching.EventMessageExecuted
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type != "cosmos.epoching.v1beta1.EventMessageExecuted" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		s.Require().NoError(err)
+		executed = msg.(*epoching.EventMessageExecuted)
+	}
+	s.Require().NotNil(executed)
+	s.Require().Equal(uint64(1), executed.Id)
+	s.Require().Equal(sdk.MsgTypeURL(createMsg), executed.MsgTypeUrl)
+	s.Require().Contains(executed.Error, stakingtypes.ErrValidatorPubKeyExists.Error())
+}
+
+func (s *TestSuite) TestFailedEscrowReleaseDoesNotHalt() {
+	delegator := s.addrs[0]
+	valAddr := s.validator.GetOperator()
+	amount := sdk.NewCoin(s.bondDenom, sdk.NewInt(1000))
+
+	delegateMsg := stakingtypes.NewMsgDelegate(delegator, valAddr, amount)
+	_, err := s.app.EpochingKeeper.WrappedDelegate(sdk.WrapSDKContext(s.ctx), epoching.NewMsgWrappedDelegate(delegateMsg))
+	s.Require().NoError(err)
+	otherAmount := sdk.NewCoin(s.bondDenom, sdk.NewInt(500))
+	_, err = s.app.EpochingKeeper.WrappedDelegate(sdk.WrapSDKContext(s.ctx),
+		epoching.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(s.addrs[1], valAddr, otherAmount)))
+	s.Require().NoError(err)
+
+	// drain the escrow, so that the first one can't be released
+	s.Require().NoError(s.app.BankKeeper.UndelegateCoinsFromModuleToAccount(s.ctx, epoching.ModuleName, s.addrs[1], sdk.NewCoins(amount)))
+
+	s.ctx = s.ctx.WithBlockHeight(testEpochLength).WithEventManager(sdk.NewEventManager())
+	s.Require().NotPanics(func() { epochingmodule.EndBlocker(s.ctx, s.app.EpochingKeeper) })
+	s.Require().Empty(s.app.EpochingKeeper.GetQueuedMessages(s.ctx, 0))
+	s.Require().Equal(uint64(1), s.app.EpochingKeeper.GetEpoch(s.ctx).Number)
+
+	var executed []*epoching.EventMessageExecuted
+	for _, event := range s.ctx.EventManager().Events() {
+		if event.Type != "cosmos.epoching.v1beta1.EventMessageExecuted" {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		s.Require().NoError(err)
+		executed = append(executed, msg.(*epoching.EventMessageExecuted))
+	}
+	s.Require().Len(executed, 2)
+	s.Require().Equal(sdk.MsgTypeURL(delegateMsg), executed[0].MsgTypeUrl)
+	s.Require().Contains(executed[0].Error, "failed to release the escrow")
+	_, found := s.app.StakingKeeper.GetDelegation(s.ctx, delegator, valAddr)
+	s.Require().False(found)
+
+	// the next messages are still executed
+	s.Require().Equal(uint64(2), executed[1].Id)
+	s.Require().Empty(executed[1].Error)
+	delegation, found := s.app.StakingKeeper.GetDelegation(s.ctx, s.addrs[1], valAddr)
+	s.Require().True(found)
+	s.Require().Equal(otherAmount.Amount, s.validator.TokensFromShares(delegation.Shares).TruncateInt())
+}
+
+func (s *TestSuite) TestMsgServerValidation() {
+	delegator := s.addrs[0]
+	valAddr := s.validator.GetOperator()
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.app.EpochingKeeper.WrappedDelegate(goCtx, epoching.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(delegator, valAddr, sdk.NewCoin("foo", sdk.NewInt(1)))))
+	s.Require().ErrorIs(err, epoching.ErrInvalidDenom)
+
+	_, err = s.app.EpochingKeeper.WrappedDelegate(goCtx, epoching.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(delegator, sdk.ValAddress(delegator), sdk.NewCoin(s.bondDenom, sdk.NewInt(1)))))
+	s.Require().ErrorIs(err, epoching.ErrValidatorNotFound)
+
+	_, err = s.app.EpochingKeeper.WrappedBeginRedelegate(goCtx, epoching.NewMsgWrappedBeginRedelegate(
+		stakingtypes.NewMsgBeginRedelegate(delegator, valAddr, sdk.ValAddress(delegator), sdk.NewCoin(s.bondDenom, sdk.NewInt(1)))))
+	s.Require().ErrorIs(err, epoching.ErrValidatorNotFound)
+
+	_, err = s.app.EpochingKeeper.WrappedEditValidator(goCtx, epoching.NewMsgWrappedEditValidator(
+		stakingtypes.NewMsgEditValidator(sdk.ValAddress(delegator), stakingtypes.Description{}, nil, nil)))
+	s.Require().ErrorIs(err, epoching.ErrValidatorNotFound)
+
+	_, err = s.app.EpochingKeeper.WrappedCancelUnbondingDelegation(goCtx, epoching.NewMsgWrappedCancelUnbondingDelegation(
+		stakingtypes.NewMsgCancelUnbondingDelegation(delegator, valAddr, s.ctx.BlockHeight(), sdk.NewCoin("foo", sdk.NewInt(1)))))
+	s.Require().ErrorIs(err, epoching.ErrInvalidDenom)
+
+	// the delegated amount must be spendable
+	_, err = s.app.EpochingKeeper.WrappedDelegate(goCtx, epoching.NewMsgWrappedDelegate(
+		stakingtypes.NewMsgDelegate(delegator, valAddr, sdk.NewCoin(s.bondDenom, sdk.NewInt(1_000_000_000)))))
+	s.Require().Error(err)
+
+	s.Require().Empty(s.app.EpochingKeeper.GetQueuedMessages(s.ctx, 0))
+	s.Require().True(s.moduleBalance().IsZero())
+}
+
+func (s *TestSuite) TestGenesis() {
+	delegator := s.addrs[0]
+	msg := stakingtypes.NewMsgDelegate(delegator, s.validator.GetOperator(), sdk.NewCoin(s.bondDenom, sdk.NewInt(1000)))
+	_, err := s.app.EpochingKeeper.WrappedDelegate(sdk.WrapSDKContext(s.ctx), epoching.NewMsgWrappedDelegate(msg))
+	s.Require().NoError(err)
+	s.ctx = s.ctx.WithBlockHeight(testEpochLength)
+	epochingmodule.EndBlocker(s.ctx, s.app.EpochingKeeper)
+	_, err = s.app.EpochingKeeper.WrappedDelegate(sdk.WrapSDKContext(s.ctx), epoching.NewMsgWrappedDelegate(msg))
+	s.Require().NoError(err)
+
+	genState := s.app.EpochingKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(epoching.NewParams(testEpochLength), genState.Params)
+	s.Require().Equal(uint64(1), genState.EpochNumber)
+	s.Require().Len(genState.QueuedMessages, 1)
+	s.Require().NoError(epoching.ValidateGenesis(*genState))
+
+	// the queued messages are queued again in the current epoch, starting at the genesis block
+	ctx := s.ctx.WithBlockHeight(100)
+	s.app.EpochingKeeper.InitGenesis(ctx, genState)
+	s.Require().Equal(epoching.Epoch{Number: 1, StartHeight: 100}, s.app.EpochingKeeper.GetEpoch(ctx))
+	queued := s.app.EpochingKeeper.GetQueuedMessages(ctx, 1)
+	s.Require().Len(queued, 2)
+	s.Require().Equal(msg, queued[1].UnpackedMsg())
+	s.Require().Equal(int64(100), queued[1].QueuedHeight)
+}
+
+func (s *TestSuite) TestInvariants() {
+	msg := stakingtypes.NewMsgDelegate(s.addrs[0], s.validator.GetOperator(), sdk.NewCoin(s.bondDenom, sdk.NewInt(1000)))
+	// queued without escrowing the delegated amount
+	_, err := s.app.EpochingKeeper.QueueMsg(s.ctx, msg)
+	s.Require().NoError(err)
+	_, broken := keeper.EscrowBalanceInvariant(s.app.EpochingKeeper, s.app.AccountKeeper)(s.ctx)
+	s.Require().True(broken)
+
+	// the queue of the epoch was not executed
+	s.app.EpochingKeeper.SetEpoch(s.ctx, epoching.Epoch{Number: 1, StartHeight: testEpochLength + 1})
+	_, broken = keeper.QueueEpochInvariant(s.app.EpochingKeeper)(s.ctx)
+	s.Require().True(broken)
+}
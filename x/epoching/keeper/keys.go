@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	NextMessageIDKey    = []byte{0x11}
+	EpochKey            = []byte{0x12}
+	QueuedMessagePrefix = []byte{0x13}
+)
+
+// queuedMessagesKey returns the prefix of the messages queued in the given epoch
+func queuedMessagesKey(epochNumber uint64) []byte {
+	key := make([]byte, len(QueuedMessagePrefix), len(QueuedMessagePrefix)+8)
+	copy(key, QueuedMessagePrefix)
+	return append(key, sdk.Uint64ToBigEndian(epochNumber)...)
+}
+
+// queuedMessageKey returns the key of a queued message from its epoch number and ID
+func queuedMessageKey(epochNumber, id uint64) []byte {
+	return append(queuedMessagesKey(epochNumber), sdk.Uint64ToBigEndian(id)...)
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ epoching.MsgServer = Keeper{}
+
+// WrappedCreateValidator queues a MsgCreateValidator and escrows its self-delegation
+func (k Keeper) WrappedCreateValidator(goCtx context.Context, msg *epoching.MsgWrappedCreateValidator) (*epoching.MsgWrappedCreateValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	valAddr, err := sdk.ValAddressFromBech32(msg.Msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); found {
+		return nil, stakingtypes.ErrValidatorOwnerExists
+	}
+	if err := k.validateBondDenom(ctx, msg.Msg.Value); err != nil {
+		return nil, err
+	}
+
+	id, err := k.escrowAndQueue(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return &epoching.MsgWrappedCreateValidatorResponse{Id: id}, nil
+}
+
+// WrappedEditValidator queues a MsgEditValidator
+func (k Keeper) WrappedEditValidator(goCtx context.Context, msg *epoching.MsgWrappedEditValidator) (*epoching.MsgWrappedEditValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+
+	id, err := k.QueueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return &epoching.MsgWrappedEditValidatorResponse{Id: id}, nil
+}
+
+// WrappedDelegate queues a MsgDelegate and escrows the delegated amount
+func (k Keeper) WrappedDelegate(goCtx context.Context, msg *epoching.MsgWrappedDelegate) (*epoching.MsgWrappedDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, err := k.escrowAndQueue(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return &epoching.MsgWrappedDelegateResponse{Id: id}, nil
+}
+
+// WrappedBeginRedelegate queues a MsgBeginRedelegate
+func (k Keeper) WrappedBeginRedelegate(goCtx context.Context, msg *epoching.MsgWrappedBeginRedelegate) (*epoching.MsgWrappedBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorSrcAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorDstAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, err := k.QueueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return &epoching.MsgWrappedBeginRedelegateResponse{Id: id}, nil
+}
+
+// WrappedUndelegate queues a MsgUndelegate
+func (k Keeper) WrappedUndelegate(goCtx context.Context, msg *epoching.MsgWrappedUndelegate) (*epoching.MsgWrappedUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, err := k.QueueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return &epoching.MsgWrappedUndelegateResponse{Id: id}, nil
+}
+
+// WrappedCancelUnbondingDelegation queues a MsgCancelUnbondingDelegation
+func (k Keeper) WrappedCancelUnbondingDelegation(goCtx context.Context, msg *epoching.MsgWrappedCancelUnbondingDelegation) (*epoching.MsgWrappedCancelUnbondingDelegationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.validateValidator(ctx, msg.Msg.ValidatorAddress); err != nil {
+		return nil, err
+	}
+	if err := k.validateBondDenom(ctx, msg.Msg.Amount); err != nil {
+		return nil, err
+	}
+
+	id, err := k.QueueMsg(ctx, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return &epoching.MsgWrappedCancelUnbondingDelegationResponse{Id: id}, nil
+}
+
+// escrowAndQueue escrows the coins needed to execute msg and queues it
+func (k Keeper) escrowAndQueue(ctx sdk.Context, msg sdk.Msg) (uint64, error) {
+	if err := k.escrow(ctx, msg); err != nil {
+		return 0, err
+	}
+	return k.QueueMsg(ctx, msg)
+}
+
+// validateValidator checks that the validator exists
+func (k Keeper) validateValidator(ctx sdk.Context, address string) error {
+	valAddr, err := sdk.ValAddressFromBech32(address)
+	if err != nil {
+		return err
+	}
+	if _, found := k.stakingKeeper.GetValidator(ctx, valAddr); !found {
+		return sdkerrors.Wrap(epoching.ErrValidatorNotFound, address)
+	}
+	return nil
+}
+
+// validateBondDenom checks that coin is denominated in the bond denom
+func (k Keeper) validateBondDenom(ctx sdk.Context, coin sdk.Coin) error {
+	if bondDenom := k.stakingKeeper.BondDenom(ctx); coin.Denom != bondDenom {
+		return sdkerrors.Wrapf(epoching.ErrInvalidDenom, "got %s, expected %s", coin.Denom, bondDenom)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package epoching
+
+const (
+	// ModuleName is the name of the epoching module
+	ModuleName = "epoching"
+
+	// StoreKey is the default store key for the epoching module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the epoching module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the epoching module
+	QuerierRoute = ModuleName
+)
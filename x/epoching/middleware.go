@@ -0,0 +1,132 @@
+package epoching
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// nestedMsgsExecutor is implemented by the messages executing other messages on
+// behalf of their signer, e.g. the authz MsgExec.
+type nestedMsgsExecutor interface {
+	GetMessages() ([]sdk.Msg, error)
+}
+
+// nestedMsgsProposal is implemented by the messages proposing other messages for
+// execution, e.g. the group MsgCreateProposal.
+type nestedMsgsProposal interface {
+	GetMsgs() []sdk.Msg
+}
+
+// nestedMsgsGovProposal is implemented by the governance MsgSubmitProposal, whose
+// messages are unpacked with an error.
+type nestedMsgsGovProposal interface {
+	GetMsgs() ([]sdk.Msg, error)
+}
+
+type rejectUnwrappedStakingMsgsTxHandler struct {
+	next      tx.Handler
+	txDecoder sdk.TxDecoder
+}
+
+// RejectUnwrappedStakingMsgsMiddleware rejects the transactions which contain staking
+// messages that are not wrapped in an epoching message, including the messages nested
+// in an authz MsgExec or proposed in a group or governance proposal, so that all the
+// changes to the validator set are applied at the end of an epoch. Applications using the epoching
+// module should wrap their tx handler with it, e.g.:
+//
+//	txHandler, err := middleware.NewDefaultTxHandler(options)
+//	...
+//	app.SetTxHandler(epoching.RejectUnwrappedStakingMsgsMiddleware(txConfig.TxDecoder())(txHandler))
+//
+// txDecoder is used to decode the transactions which have not been decoded yet. The
+// genesis transactions are not checked.
+func RejectUnwrappedStakingMsgsMiddleware(txDecoder sdk.TxDecoder) tx.Middleware {
+	return func(txh tx.Handler) tx.Handler {
+		return rejectUnwrappedStakingMsgsTxHandler{
+			next:      txh,
+			txDecoder: txDecoder,
+		}
+	}
+}
+
+var _ tx.Handler = rejectUnwrappedStakingMsgsTxHandler{}
+
+func (txh rejectUnwrappedStakingMsgsTxHandler) checkUnwrappedStakingMsgs(ctx context.Context, req tx.Request) error {
+	// the genesis transactions create the initial validator set
+	if sdk.UnwrapSDKContext(ctx).BlockHeight() == 0 {
+		return nil
+	}
+
+	sdkTx := req.Tx
+	if sdkTx == nil {
+		var err error
+		// leave the decoding errors to the rest of the stack
+		if sdkTx, err = txh.txDecoder(req.TxBytes); err != nil {
+			return nil
+		}
+	}
+
+	return checkUnwrappedStakingMsgs(sdkTx.GetMsgs())
+}
+
+// checkUnwrappedStakingMsgs returns an error if one of msgs, or of the messages they
+// nest, is an unwrapped staking message.
+func checkUnwrappedStakingMsgs(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		var nested []sdk.Msg
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgCreateValidator, *stakingtypes.MsgEditValidator, *stakingtypes.MsgDelegate,
+			*stakingtypes.MsgBeginRedelegate, *stakingtypes.MsgUndelegate,
+			*stakingtypes.MsgCancelUnbondingDelegation:
+			return sdkerrors.Wrap(ErrUnwrappedMsg, sdk.MsgTypeURL(msg))
+		case nestedMsgsExecutor:
+			var err error
+			if nested, err = msg.GetMessages(); err != nil {
+				return err
+			}
+		case nestedMsgsProposal:
+			nested = msg.GetMsgs()
+		case nestedMsgsGovProposal:
+			var err error
+			if nested, err = msg.GetMsgs(); err != nil {
+				return err
+			}
+		}
+		if err := checkUnwrappedStakingMsgs(nested); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckTx implements tx.Handler.CheckTx.
+func (txh rejectUnwrappedStakingMsgsTxHandler) CheckTx(ctx context.Context, req tx.Request, checkReq tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	if err := txh.checkUnwrappedStakingMsgs(ctx, req); err != nil {
+		return tx.Response{}, tx.ResponseCheckTx{}, err
+	}
+
+	return txh.next.CheckTx(ctx, req, checkReq)
+}
+
+// DeliverTx implements tx.Handler.DeliverTx.
+func (txh rejectUnwrappedStakingMsgsTxHandler) DeliverTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkUnwrappedStakingMsgs(ctx, req); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.DeliverTx(ctx, req)
+}
+
+// SimulateTx implements tx.Handler.SimulateTx method.
+func (txh rejectUnwrappedStakingMsgsTxHandler) SimulateTx(ctx context.Context, req tx.Request) (tx.Response, error) {
+	if err := txh.checkUnwrappedStakingMsgs(ctx, req); err != nil {
+		return tx.Response{}, err
+	}
+
+	return txh.next.SimulateTx(ctx, req)
+}
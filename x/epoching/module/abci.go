@@ -0,0 +1,25 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+)
+
+// EndBlocker ends the current epoch at its last block, executing the messages queued
+// during the epoch. It must run before the staking EndBlocker, so that the resulting
+// changes are included in the validator set updates of the block.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(epoching.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if ctx.BlockHeight() < k.GetEpochEndHeight(ctx) {
+		return
+	}
+
+	if err := k.EndEpoch(ctx); err != nil {
+		panic(err)
+	}
+}
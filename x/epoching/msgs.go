@@ -0,0 +1,247 @@
+package epoching
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	_ sdk.Msg            = &MsgWrappedCreateValidator{}
+	_ sdk.Msg            = &MsgWrappedEditValidator{}
+	_ sdk.Msg            = &MsgWrappedDelegate{}
+	_ sdk.Msg            = &MsgWrappedBeginRedelegate{}
+	_ sdk.Msg            = &MsgWrappedUndelegate{}
+	_ sdk.Msg            = &MsgWrappedCancelUnbondingDelegation{}
+	_ legacytx.LegacyMsg = &MsgWrappedCreateValidator{}
+	_ legacytx.LegacyMsg = &MsgWrappedEditValidator{}
+	_ legacytx.LegacyMsg = &MsgWrappedDelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedBeginRedelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedUndelegate{}
+	_ legacytx.LegacyMsg = &MsgWrappedCancelUnbondingDelegation{}
+
+	_ codectypes.UnpackInterfacesMessage = (*MsgWrappedCreateValidator)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*QueuedMessage)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*GenesisState)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*QueryQueuedMessagesResponse)(nil)
+)
+
+// validateWrapped checks that the wrapped message is set and valid
+func validateWrapped(msg sdk.Msg, isNil bool) error {
+	if isNil {
+		return sdkerrors.Wrap(ErrInvalidMsg, "wrapped message cannot be empty")
+	}
+	return msg.ValidateBasic()
+}
+
+// NewMsgWrappedCreateValidator wraps msg to be executed at the end of the epoch
+func NewMsgWrappedCreateValidator(msg *stakingtypes.MsgCreateValidator) *MsgWrappedCreateValidator {
+	return &MsgWrappedCreateValidator{Msg: msg}
+}
+
+// Route Implements Msg.
+func (m MsgWrappedCreateValidator) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgWrappedCreateValidator) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgWrappedCreateValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (m MsgWrappedCreateValidator) GetSigners() []sdk.AccAddress {
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.GetSigners()
+}
+
+// ValidateBasic does a sanity check on the wrapped message.
+func (m MsgWrappedCreateValidator) ValidateBasic() error {
+	return validateWrapped(m.Msg, m.Msg == nil)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgWrappedCreateValidator) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.UnpackInterfaces(unpacker)
+}
+
+// NewMsgWrappedEditValidator wraps msg to be executed at the end of the epoch
+func NewMsgWrappedEditValidator(msg *stakingtypes.MsgEditValidator) *MsgWrappedEditValidator {
+	return &MsgWrappedEditValidator{Msg: msg}
+}
+
+// Route Implements Msg.
+func (m MsgWrappedEditValidator) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgWrappedEditValidator) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgWrappedEditValidator) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (m MsgWrappedEditValidator) GetSigners() []sdk.AccAddress {
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.GetSigners()
+}
+
+// ValidateBasic does a sanity check on the wrapped message.
+func (m MsgWrappedEditValidator) ValidateBasic() error {
+	return validateWrapped(m.Msg, m.Msg == nil)
+}
+
+// NewMsgWrappedDelegate wraps msg to be executed at the end of the epoch
+func NewMsgWrappedDelegate(msg *stakingtypes.MsgDelegate) *MsgWrappedDelegate {
+	return &MsgWrappedDelegate{Msg: msg}
+}
+
+// Route Implements Msg.
+func (m MsgWrappedDelegate) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgWrappedDelegate) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgWrappedDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (m MsgWrappedDelegate) GetSigners() []sdk.AccAddress {
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.GetSigners()
+}
+
+// ValidateBasic does a sanity check on the wrapped message.
+func (m MsgWrappedDelegate) ValidateBasic() error {
+	return validateWrapped(m.Msg, m.Msg == nil)
+}
+
+// NewMsgWrappedBeginRedelegate wraps msg to be executed at the end of the epoch
+func NewMsgWrappedBeginRedelegate(msg *stakingtypes.MsgBeginRedelegate) *MsgWrappedBeginRedelegate {
+	return &MsgWrappedBeginRedelegate{Msg: msg}
+}
+
+// Route Implements Msg.
+func (m MsgWrappedBeginRedelegate) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgWrappedBeginRedelegate) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgWrappedBeginRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (m MsgWrappedBeginRedelegate) GetSigners() []sdk.AccAddress {
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.GetSigners()
+}
+
+// ValidateBasic does a sanity check on the wrapped message.
+func (m MsgWrappedBeginRedelegate) ValidateBasic() error {
+	return validateWrapped(m.Msg, m.Msg == nil)
+}
+
+// NewMsgWrappedUndelegate wraps msg to be executed at the end of the epoch
+func NewMsgWrappedUndelegate(msg *stakingtypes.MsgUndelegate) *MsgWrappedUndelegate {
+	return &MsgWrappedUndelegate{Msg: msg}
+}
+
+// Route Implements Msg.
+func (m MsgWrappedUndelegate) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgWrappedUndelegate) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgWrappedUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (m MsgWrappedUndelegate) GetSigners() []sdk.AccAddress {
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.GetSigners()
+}
+
+// ValidateBasic does a sanity check on the wrapped message.
+func (m MsgWrappedUndelegate) ValidateBasic() error {
+	return validateWrapped(m.Msg, m.Msg == nil)
+}
+
+// NewMsgWrappedCancelUnbondingDelegation wraps msg to be executed at the end of the epoch
+func NewMsgWrappedCancelUnbondingDelegation(msg *stakingtypes.MsgCancelUnbondingDelegation) *MsgWrappedCancelUnbondingDelegation {
+	return &MsgWrappedCancelUnbondingDelegation{Msg: msg}
+}
+
+// Route Implements Msg.
+func (m MsgWrappedCancelUnbondingDelegation) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type Implements Msg.
+func (m MsgWrappedCancelUnbondingDelegation) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes Implements Msg.
+func (m MsgWrappedCancelUnbondingDelegation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the signers of the wrapped message.
+func (m MsgWrappedCancelUnbondingDelegation) GetSigners() []sdk.AccAddress {
+	if m.Msg == nil {
+		return nil
+	}
+	return m.Msg.GetSigners()
+}
+
+// ValidateBasic does a sanity check on the wrapped message.
+func (m MsgWrappedCancelUnbondingDelegation) ValidateBasic() error {
+	return validateWrapped(m.Msg, m.Msg == nil)
+}
+
+// UnpackedMsg returns the unpacked queued message, or nil if it has not been unpacked.
+func (m QueuedMessage) UnpackedMsg() sdk.Msg {
+	if m.Msg == nil {
+		return nil
+	}
+	msg, ok := m.Msg.GetCachedValue().(sdk.Msg)
+	if !ok {
+		return nil
+	}
+	return msg
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueuedMessage) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var msg sdk.Msg
+	return unpacker.UnpackAny(m.Msg, &msg)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m QueryQueuedMessagesResponse) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msg := range m.Messages {
+		if err := msg.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
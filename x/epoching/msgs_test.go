@@ -0,0 +1,147 @@
+package epoching_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	govv1beta2 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var (
+	delegator = sdk.AccAddress("delegator")
+	valAddr   = sdk.ValAddress("validator")
+)
+
+func TestMsgWrappedDelegate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *epoching.MsgWrappedDelegate
+		expErr bool
+		errMsg string
+	}{
+		{
+			"empty wrapped message",
+			&epoching.MsgWrappedDelegate{},
+			true,
+			"wrapped message cannot be empty",
+		},
+		{
+			"invalid wrapped message",
+			epoching.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delegator, valAddr, sdk.NewInt64Coin("stake", 0))),
+			true,
+			"invalid delegation amount",
+		},
+		{
+			"valid wrapped message",
+			epoching.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(delegator, valAddr, sdk.NewInt64Coin("stake", 10))),
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, []sdk.AccAddress{delegator}, tc.msg.GetSigners())
+			}
+		})
+	}
+
+	require.Nil(t, (&epoching.MsgWrappedDelegate{}).GetSigners())
+}
+
+type recordTxHandler struct {
+	called bool
+}
+
+func (h *recordTxHandler) CheckTx(_ context.Context, _ tx.Request, _ tx.RequestCheckTx) (tx.Response, tx.ResponseCheckTx, error) {
+	h.called = true
+	return tx.Response{}, tx.ResponseCheckTx{}, nil
+}
+
+func (h *recordTxHandler) DeliverTx(_ context.Context, _ tx.Request) (tx.Response, error) {
+	h.called = true
+	return tx.Response{}, nil
+}
+
+func (h *recordTxHandler) SimulateTx(_ context.Context, _ tx.Request) (tx.Response, error) {
+	h.called = true
+	return tx.Response{}, nil
+}
+
+func TestRejectUnwrappedStakingMsgsMiddleware(t *testing.T) {
+	txConfig := simapp.MakeTestEncodingConfig().TxConfig
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, log.NewNopLogger())
+	delegate := stakingtypes.NewMsgDelegate(delegator, valAddr, sdk.NewInt64Coin("stake", 10))
+	grantee := sdk.AccAddress("grantee")
+	exec := authz.NewMsgExec(grantee, []sdk.Msg{delegate})
+	wrappedExec := authz.NewMsgExec(grantee, []sdk.Msg{epoching.NewMsgWrappedDelegate(delegate)})
+	proposal, err := group.NewMsgCreateProposalRequest(delegator.String(), []string{grantee.String()}, []sdk.Msg{delegate}, nil, group.Exec_EXEC_UNSPECIFIED)
+	require.NoError(t, err)
+	govProposal, err := govv1beta2.NewMsgSubmitProposal([]sdk.Msg{delegate}, nil, grantee.String(), nil, false)
+	require.NoError(t, err)
+	cancelUnbonding := stakingtypes.NewMsgCancelUnbondingDelegation(delegator, valAddr, 1, sdk.NewInt64Coin("stake", 10))
+
+	testCases := []struct {
+		name   string
+		msg    sdk.Msg
+		expErr bool
+	}{
+		{"unwrapped staking message", delegate, true},
+		{"wrapped staking message", epoching.NewMsgWrappedDelegate(delegate), false},
+		{"unwrapped unbonding delegation cancellation", cancelUnbonding, true},
+		{"wrapped unbonding delegation cancellation", epoching.NewMsgWrappedCancelUnbondingDelegation(cancelUnbonding), false},
+		{"unwrapped staking message nested in an authz MsgExec", &exec, true},
+		{"wrapped staking message nested in an authz MsgExec", &wrappedExec, false},
+		{"unwrapped staking message proposed in a group proposal", proposal, true},
+		{"unwrapped staking message proposed in a governance proposal", govProposal, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := txConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msg))
+			txBytes, err := txConfig.TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+
+			next := &recordTxHandler{}
+			txHandler := epoching.RejectUnwrappedStakingMsgsMiddleware(txConfig.TxDecoder())(next)
+
+			// the genesis transactions are not checked
+			_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{TxBytes: txBytes})
+			require.NoError(t, err)
+			require.True(t, next.called)
+
+			next.called = false
+			ctx := ctx.WithBlockHeight(1)
+			// the transaction is decoded by the middleware when needed
+			_, err = txHandler.DeliverTx(sdk.WrapSDKContext(ctx), tx.Request{TxBytes: txBytes})
+			_, _, checkErr := txHandler.CheckTx(sdk.WrapSDKContext(ctx), tx.Request{Tx: builder.GetTx()}, tx.RequestCheckTx{})
+			if tc.expErr {
+				require.ErrorIs(t, err, epoching.ErrUnwrappedMsg)
+				require.ErrorIs(t, checkErr, epoching.ErrUnwrappedMsg)
+				require.False(t, next.called)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, checkErr)
+				require.True(t, next.called)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/epoching/keeper"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding epoching type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], keeper.NextMessageIDKey):
+			return fmt.Sprintf("%v\n%v", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+		case bytes.Equal(kvA.Key[:1], keeper.EpochKey):
+			var epochA, epochB epoching.Epoch
+			cdc.MustUnmarshal(kvA.Value, &epochA)
+			cdc.MustUnmarshal(kvB.Value, &epochB)
+			return fmt.Sprintf("%v\n%v", epochA, epochB)
+		case bytes.Equal(kvA.Key[:1], keeper.QueuedMessagePrefix):
+			var queuedA, queuedB epoching.QueuedMessage
+			cdc.MustUnmarshal(kvA.Value, &queuedA)
+			cdc.MustUnmarshal(kvB.Value, &queuedB)
+			return fmt.Sprintf("%v\n%v", queuedA, queuedB)
+		default:
+			panic(fmt.Sprintf("invalid epoching key %X", kvA.Key))
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+)
+
+// Simulation parameter constants
+const (
+	EpochLength = "epoch_length"
+)
+
+// GenEpochLength randomized EpochLength
+func GenEpochLength(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for epoching
+func RandomizedGenState(simState *module.SimulationState) {
+	var epochLength uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, EpochLength, &epochLength, simState.Rand,
+		func(r *rand.Rand) { epochLength = GenEpochLength(r) },
+	)
+
+	epochingGenesis := epoching.NewGenesisState(epoching.NewParams(epochLength), 0, nil)
+
+	bz, err := json.MarshalIndent(&epochingGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated epoching parameters:\n%s\n", bz)
+	simState.GenState[epoching.ModuleName] = simState.Cdc.MustMarshalJSON(epochingGenesis)
+}
@@ -0,0 +1,169 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgWrappedDelegate   = "op_weight_msg_wrapped_delegate"
+	OpWeightMsgWrappedUndelegate = "op_weight_msg_wrapped_undelegate"
+)
+
+// epoching operations weights
+const (
+	WeightWrappedDelegate   = 100
+	WeightWrappedUndelegate = 100
+)
+
+var (
+	TypeMsgWrappedDelegate   = sdk.MsgTypeURL(&epoching.MsgWrappedDelegate{})
+	TypeMsgWrappedUndelegate = sdk.MsgTypeURL(&epoching.MsgWrappedUndelegate{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams, cdc codec.JSONCodec,
+	ak epoching.AccountKeeper, bk epoching.BankKeeper, sk epoching.StakingKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgWrappedDelegate   int
+		weightMsgWrappedUndelegate int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedDelegate, &weightMsgWrappedDelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedDelegate = WeightWrappedDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgWrappedUndelegate, &weightMsgWrappedUndelegate, nil,
+		func(_ *rand.Rand) {
+			weightMsgWrappedUndelegate = WeightWrappedUndelegate
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgWrappedDelegate,
+			SimulateMsgWrappedDelegate(ak, bk, sk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgWrappedUndelegate,
+			SimulateMsgWrappedUndelegate(ak, bk, sk),
+		),
+	}
+}
+
+// SimulateMsgWrappedDelegate generates a MsgWrappedDelegate with random values
+func SimulateMsgWrappedDelegate(ak epoching.AccountKeeper, bk epoching.BankKeeper, sk epoching.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		validators := sk.GetAllValidators(ctx)
+		if len(validators) == 0 {
+			return simtypes.NoOpMsg(epoching.ModuleName, TypeMsgWrappedDelegate, "number of validators equal zero"), nil, nil
+		}
+		val := validators[r.Intn(len(validators))]
+		if val.InvalidExRate() {
+			return simtypes.NoOpMsg(epoching.ModuleName, TypeMsgWrappedDelegate, "validator's invalid exchange rate"), nil, nil
+		}
+
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		denom := sk.BondDenom(ctx)
+		spendable := bk.SpendableCoins(ctx, simAccount.Address)
+		amount := spendable.AmountOf(denom)
+		if !amount.IsPositive() {
+			return simtypes.NoOpMsg(epoching.ModuleName, TypeMsgWrappedDelegate, "spendable balance is zero"), nil, nil
+		}
+
+		amount, err := simtypes.RandPositiveInt(r, amount)
+		if err != nil {
+			return simtypes.NoOpMsg(epoching.ModuleName, TypeMsgWrappedDelegate, "unable to generate positive amount"), nil, err
+		}
+		bondAmt := sdk.NewCoin(denom, amount)
+
+		var fees sdk.Coins
+		coins, hasNeg := spendable.SafeSub(sdk.Coins{bondAmt})
+		if !hasNeg {
+			fees, err = simtypes.RandomFees(r, ctx, coins)
+			if err != nil {
+				return simtypes.NoOpMsg(epoching.ModuleName, TypeMsgWrappedDelegate, "unable to generate fees"), nil, err
+			}
+		}
+
+		msg := epoching.NewMsgWrappedDelegate(stakingtypes.NewMsgDelegate(simAccount.Address, val.GetOperator(), bondAmt))
+
+		txCtx := simulation.OperationInput{
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           nil,
+			Msg:           msg,
+			MsgType:       TypeMsgWrappedDelegate,
+			Context:       ctx,
+			SimAccount:    simAccount,
+			AccountKeeper: ak,
+			ModuleName:    epoching.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTx(txCtx, fees)
+	}
+}
+
+// SimulateMsgWrappedUndelegate generates a MsgWrappedUndelegate with random values
+func SimulateMsgWrappedUndelegate(ak epoching.AccountKeeper, bk epoching.BankKeeper, sk epoching.StakingKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		delegations := sk.GetDelegatorDelegations(ctx, simAccount.Address, 10)
+		if len(delegations) == 0 {
+			return simtypes.NoOpMsg(epoching.ModuleName, TypeMsgWrappedUndelegate, "account has no delegation"), nil, nil
+		}
+
+		delegation := delegations[r.Intn(len(delegations))]
+		valAddr := delegation.GetValidatorAddr()
+		validator, found := sk.GetValidator(ctx, valAddr)
+		if !found {
+			return simtypes.NoOpMsg(epoching.ModuleName, TypeMsgWrappedUndelegate, "validator not found"), nil, nil
+		}
+
+		totalBond := validator.TokensFromShares(delegation.GetShares()).TruncateInt()
+		if !totalBond.IsPositive() {
+			return simtypes.NoOpMsg(epoching.ModuleName, TypeMsgWrappedUndelegate, "total bond is not positive"), nil, nil
+		}
+
+		unbondAmt, err := simtypes.RandPositiveInt(r, totalBond)
+		if err != nil {
+			return simtypes.NoOpMsg(epoching.ModuleName, TypeMsgWrappedUndelegate, "invalid unbond amount"), nil, err
+		}
+
+		msg := epoching.NewMsgWrappedUndelegate(stakingtypes.NewMsgUndelegate(simAccount.Address, valAddr, sdk.NewCoin(sk.BondDenom(ctx), unbondAmt)))
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:             nil,
+			Msg:             msg,
+			MsgType:         TypeMsgWrappedUndelegate,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      epoching.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,28 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+const (
+	keyEpochLength = "EpochLength"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
+	return []simtypes.ParamChange{
+		simulation.NewSimParamChange(epoching.ModuleName, keyEpochLength,
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenEpochLength(r))
+			},
+		),
+	}
+}
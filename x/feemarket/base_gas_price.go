@@ -0,0 +1,34 @@
+package feemarket
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NextBaseGasPrice computes the base gas price of the next block from the base
+// gas price and the gas consumed by the current block, as in EIP-1559: the price
+// changes in proportion to the distance of the block gas to the target, by at most
+// 1/BaseGasPriceChangeDenominator for an empty block or a block consuming twice the
+// target. A block consuming more than the target increases the price by at least
+// the smallest decimal unit, so that a zero (or tiny) price can still grow. The
+// price is bounded below by the min base gas price.
+func NextBaseGasPrice(params Params, baseGasPrice sdk.Dec, blockGasUsed uint64) sdk.Dec {
+	target := sdk.NewDecFromInt(sdk.NewIntFromUint64(params.TargetBlockGas))
+	used := sdk.NewDecFromInt(sdk.NewIntFromUint64(blockGasUsed))
+
+	// cap the increase so that a block consuming more than twice the target moves
+	// the price by the same amount as a block consuming twice the target
+	if used.GT(target.MulInt64(2)) {
+		used = target.MulInt64(2)
+	}
+
+	delta := baseGasPrice.Mul(used.Sub(target)).Quo(target).QuoInt64(int64(params.BaseGasPriceChangeDenominator))
+	if used.GT(target) && delta.LT(sdk.SmallestDec()) {
+		delta = sdk.SmallestDec()
+	}
+	next := baseGasPrice.Add(delta)
+	if next.LT(params.MinBaseGasPrice.Amount) {
+		return params.MinBaseGasPrice.Amount
+	}
+
+	return next
+}
@@ -0,0 +1,73 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+func TestNextBaseGasPrice(t *testing.T) {
+	params := feemarket.DefaultParams()
+	params.MinBaseGasPrice = sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2))
+	params.TargetBlockGas = 1000
+	params.BaseGasPriceChangeDenominator = 8
+
+	testCases := []struct {
+		name         string
+		baseGasPrice sdk.Dec
+		blockGasUsed uint64
+		exp          sdk.Dec
+	}{
+		{"target block gas", sdk.NewDec(1), 1000, sdk.NewDec(1)},
+		{"twice the target", sdk.NewDec(1), 2000, sdk.NewDecWithPrec(1125, 3)},
+		{"more than twice the target", sdk.NewDec(1), 10000, sdk.NewDecWithPrec(1125, 3)},
+		{"half the target", sdk.NewDec(1), 500, sdk.NewDecWithPrec(9375, 4)},
+		{"empty block", sdk.NewDec(1), 0, sdk.NewDecWithPrec(875, 3)},
+		{"bounded by the min base gas price", sdk.NewDecWithPrec(1, 2), 0, sdk.NewDecWithPrec(1, 2)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, feemarket.NextBaseGasPrice(params, tc.baseGasPrice, tc.blockGasUsed))
+		})
+	}
+}
+
+func TestNextBaseGasPriceFromZero(t *testing.T) {
+	params := feemarket.DefaultParams()
+	params.Enabled = true
+	params.TargetBlockGas = 1000
+
+	// an empty or target block keeps the price at zero
+	require.True(t, feemarket.NextBaseGasPrice(params, sdk.ZeroDec(), 0).IsZero())
+	require.True(t, feemarket.NextBaseGasPrice(params, sdk.ZeroDec(), 1000).IsZero())
+	// a block above the target increases it by at least the smallest decimal unit
+	require.Equal(t, sdk.SmallestDec(), feemarket.NextBaseGasPrice(params, sdk.ZeroDec(), 1001))
+
+	// full blocks move the price away from zero and then grow it geometrically
+	price := sdk.ZeroDec()
+	for i := 0; i < 400; i++ {
+		next := feemarket.NextBaseGasPrice(params, price, 2000)
+		require.True(t, next.GT(price))
+		price = next
+	}
+	require.True(t, price.GT(sdk.OneDec()), price)
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, feemarket.ValidateGenesis(*feemarket.DefaultGenesisState()))
+
+	params := feemarket.DefaultParams()
+	params.MinBaseGasPrice = sdk.NewDecCoinFromDec("stake", sdk.NewDec(1))
+	require.Error(t, feemarket.ValidateGenesis(*feemarket.NewGenesisState(params, sdk.NewDecWithPrec(5, 1))))
+
+	params.TargetBlockGas = 0
+	require.Error(t, feemarket.ValidateGenesis(*feemarket.NewGenesisState(params, sdk.NewDec(1))))
+
+	params = feemarket.DefaultParams()
+	params.MinBaseGasPrice = sdk.DecCoin{Denom: "stake", Amount: sdk.NewDec(-1)}
+	require.Error(t, feemarket.ValidateGenesis(*feemarket.NewGenesisState(params, sdk.NewDec(1))))
+}
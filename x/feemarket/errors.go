@@ -0,0 +1,10 @@
+package feemarket
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feemarket module sentinel errors
+var (
+	ErrUnknownRecipient = sdkerrors.Register(ModuleName, 2, "unknown base fee recipient")
+)
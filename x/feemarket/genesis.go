@@ -0,0 +1,34 @@
+package feemarket
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseGasPrice sdk.Dec) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		BaseGasPrice: baseGasPrice,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the feemarket module,
+// the base gas price starting at the min base gas price.
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, params.MinBaseGasPrice.Amount)
+}
+
+// ValidateGenesis checks the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	if data.BaseGasPrice.IsNil() || data.BaseGasPrice.LT(data.Params.MinBaseGasPrice.Amount) {
+		return fmt.Errorf("base gas price %s cannot be lower than the min base gas price %s",
+			data.BaseGasPrice, data.Params.MinBaseGasPrice.Amount)
+	}
+	return nil
+}
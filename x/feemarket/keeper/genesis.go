@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// InitGenesis initializes the feemarket module's state from a genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, data *feemarket.GenesisState) {
+	k.SetParams(ctx, data.Params)
+	k.SetBaseGasPrice(ctx, data.BaseGasPrice)
+}
+
+// ExportGenesis returns the feemarket module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *feemarket.GenesisState {
+	price, _ := k.GetBaseGasPrice(ctx)
+	return feemarket.NewGenesisState(k.GetParams(ctx), price.Amount)
+}
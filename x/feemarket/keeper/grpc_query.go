@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+var _ feemarket.QueryServer = Keeper{}
+
+// Params returns the parameters of the feemarket module
+func (k Keeper) Params(goCtx context.Context, r *feemarket.QueryParamsRequest) (*feemarket.QueryParamsResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &feemarket.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// BaseGasPrice returns the base gas price of the current block
+func (k Keeper) BaseGasPrice(goCtx context.Context, r *feemarket.QueryBaseGasPriceRequest) (*feemarket.QueryBaseGasPriceResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	price, enabled := k.GetBaseGasPrice(ctx)
+	return &feemarket.QueryBaseGasPriceResponse{
+		BaseGasPrice:     price,
+		Enabled:          enabled,
+		LastBlockGasUsed: k.GetLastBlockGasUsed(ctx),
+	}, nil
+}
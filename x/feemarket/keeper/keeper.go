@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the feemarket store
+type Keeper struct {
+	storeKey      storetypes.StoreKey
+	cdc           codec.BinaryCodec
+	paramSpace    paramtypes.Subspace
+	accountKeeper feemarket.AccountKeeper
+	bankKeeper    feemarket.BankKeeper
+}
+
+// NewKeeper creates a new feemarket Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	ak feemarket.AccountKeeper, bk feemarket.BankKeeper,
+) Keeper {
+	// ensure the feemarket module account, which burns the base fees, is set
+	if addr := ak.GetModuleAddress(feemarket.ModuleName); addr == nil {
+		panic("the feemarket module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(feemarket.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		paramSpace:    paramSpace,
+		accountKeeper: ak,
+		bankKeeper:    bk,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", feemarket.ModuleName))
+}
+
+// GetParams returns the total set of feemarket parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params feemarket.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of feemarket parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params feemarket.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// getBaseGasPriceAmount returns the stored base gas price, which may be lower than
+// the min base gas price if the latter was raised by governance.
+func (k Keeper) getBaseGasPriceAmount(ctx sdk.Context) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(BaseGasPriceKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+	var price sdk.DecProto
+	k.cdc.MustUnmarshal(bz, &price)
+	return price.Dec
+}
+
+// SetBaseGasPrice sets the base gas price, in the denom of the min base gas price.
+func (k Keeper) SetBaseGasPrice(ctx sdk.Context, price sdk.Dec) {
+	ctx.KVStore(k.storeKey).Set(BaseGasPriceKey, k.cdc.MustMarshal(&sdk.DecProto{Dec: price}))
+}
+
+// GetBaseGasPrice returns the base gas price that the transactions of the current
+// block must pay, and whether the fee market is enabled.
+func (k Keeper) GetBaseGasPrice(ctx sdk.Context) (sdk.DecCoin, bool) {
+	params := k.GetParams(ctx)
+	price := sdk.MaxDec(k.getBaseGasPriceAmount(ctx), params.MinBaseGasPrice.Amount)
+	return sdk.NewDecCoinFromDec(params.MinBaseGasPrice.Denom, price), params.Enabled
+}
+
+// GetLastBlockGasUsed returns the gas consumed by the transactions of the last block.
+func (k Keeper) GetLastBlockGasUsed(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(LastBlockGasUsedKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// UpdateBaseGasPrice records the gas consumed by the current block and, when the
+// fee market is enabled, adjusts the base gas price of the next block.
+func (k Keeper) UpdateBaseGasPrice(ctx sdk.Context, blockGasUsed uint64) error {
+	ctx.KVStore(k.storeKey).Set(LastBlockGasUsedKey, sdk.Uint64ToBigEndian(blockGasUsed))
+
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return nil
+	}
+
+	price, _ := k.GetBaseGasPrice(ctx)
+	next := feemarket.NextBaseGasPrice(params, price.Amount, blockGasUsed)
+	k.SetBaseGasPrice(ctx, next)
+
+	return ctx.EventManager().EmitTypedEvent(&feemarket.EventBaseGasPriceUpdated{
+		BaseGasPrice: next.String(),
+		BlockGasUsed: blockGasUsed,
+	})
+}
+
+// HandleBaseFee burns the base fee paid by a transaction, or sends it to the base
+// fee recipient when set. The fee is expected to be held by the fee collector.
+func (k Keeper) HandleBaseFee(ctx sdk.Context, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+
+	recipient := k.GetParams(ctx).BaseFeeRecipient
+	if recipient != "" {
+		if addr := k.accountKeeper.GetModuleAddress(recipient); addr == nil {
+			return sdkerrors.Wrapf(feemarket.ErrUnknownRecipient, "module account %s does not exist", recipient)
+		}
+		return k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, recipient, fee)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, feemarket.ModuleName, fee); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, feemarket.ModuleName, fee)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	feemarketmodule "github.com/cosmos/cosmos-sdk/x/feemarket/module"
+)
+
+type TestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	params      feemarket.Params
+	queryClient feemarket.QueryClient
+}
+
+func (s *TestSuite) SetupTest() {
+	app := simapp.Setup(s.T(), false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 2})
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	feemarket.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+
+	s.app = app
+	s.ctx = ctx
+	s.queryClient = feemarket.NewQueryClient(queryHelper)
+	s.params = feemarket.NewParams(true, sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2)), 1000, 8, "")
+	app.FeeMarketKeeper.SetParams(ctx, s.params)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
+
+func (s *TestSuite) endBlock(blockGasUsed uint64) {
+	meter := sdk.NewInfiniteGasMeter()
+	meter.ConsumeGas(blockGasUsed, "test")
+	s.ctx = s.ctx.WithBlockGasMeter(meter).WithEventManager(sdk.NewEventManager())
+	feemarketmodule.EndBlocker(s.ctx, s.app.FeeMarketKeeper)
+}
+
+func (s *TestSuite) TestBaseGasPrice() {
+	// the base gas price starts at the min base gas price
+	price, enabled := s.app.FeeMarketKeeper.GetBaseGasPrice(s.ctx)
+	s.Require().True(enabled)
+	s.Require().Equal(s.params.MinBaseGasPrice, price)
+
+	s.endBlock(2000)
+	price, _ = s.app.FeeMarketKeeper.GetBaseGasPrice(s.ctx)
+	s.Require().Equal(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1125, 5)), price)
+	s.Require().Equal(uint64(2000), s.app.FeeMarketKeeper.GetLastBlockGasUsed(s.ctx))
+	s.Require().Len(s.ctx.EventManager().Events(), 1)
+
+	res, err := s.queryClient.BaseGasPrice(s.ctx, &feemarket.QueryBaseGasPriceRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal(price, res.BaseGasPrice)
+	s.Require().True(res.Enabled)
+	s.Require().Equal(uint64(2000), res.LastBlockGasUsed)
+
+	// empty blocks bring the price back to its minimum
+	for i := 0; i < 10; i++ {
+		s.endBlock(0)
+	}
+	price, _ = s.app.FeeMarketKeeper.GetBaseGasPrice(s.ctx)
+	s.Require().Equal(s.params.MinBaseGasPrice, price)
+
+	// raising the min base gas price applies to the current block
+	s.params.MinBaseGasPrice = sdk.NewDecCoinFromDec("stake", sdk.NewDec(1))
+	s.app.FeeMarketKeeper.SetParams(s.ctx, s.params)
+	price, _ = s.app.FeeMarketKeeper.GetBaseGasPrice(s.ctx)
+	s.Require().Equal(s.params.MinBaseGasPrice, price)
+}
+
+func (s *TestSuite) TestDisabled() {
+	s.params.Enabled = false
+	s.app.FeeMarketKeeper.SetParams(s.ctx, s.params)
+
+	s.endBlock(2000)
+	price, enabled := s.app.FeeMarketKeeper.GetBaseGasPrice(s.ctx)
+	s.Require().False(enabled)
+	s.Require().Equal(s.params.MinBaseGasPrice, price)
+	s.Require().Equal(uint64(2000), s.app.FeeMarketKeeper.GetLastBlockGasUsed(s.ctx))
+	s.Require().Empty(s.ctx.EventManager().Events())
+}
+
+func (s *TestSuite) TestGenesis() {
+	s.endBlock(2000)
+	genState := s.app.FeeMarketKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(s.params, genState.Params)
+	s.Require().Equal(sdk.NewDecWithPrec(1125, 5), genState.BaseGasPrice)
+	s.Require().NoError(feemarket.ValidateGenesis(*genState))
+
+	s.app.FeeMarketKeeper.InitGenesis(s.ctx, feemarket.DefaultGenesisState())
+	_, enabled := s.app.FeeMarketKeeper.GetBaseGasPrice(s.ctx)
+	s.Require().False(enabled)
+
+	s.app.FeeMarketKeeper.InitGenesis(s.ctx, genState)
+	s.Require().Equal(genState, s.app.FeeMarketKeeper.ExportGenesis(s.ctx))
+}
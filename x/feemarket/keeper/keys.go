@@ -0,0 +1,7 @@
+package keeper
+
+// Keys for the feemarket store
+var (
+	BaseGasPriceKey     = []byte{0x01}
+	LastBlockGasUsedKey = []byte{0x02}
+)
@@ -0,0 +1,15 @@
+package feemarket
+
+const (
+	// ModuleName is the name of the feemarket module
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for the feemarket module
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the feemarket module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the feemarket module
+	QuerierRoute = ModuleName
+)
@@ -0,0 +1,25 @@
+package module
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+)
+
+// EndBlocker adjusts the base gas price of the next block from the gas consumed by
+// the transactions of the block, as tracked by the block gas meter.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(feemarket.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var blockGasUsed uint64
+	if meter := ctx.BlockGasMeter(); meter != nil {
+		blockGasUsed = meter.GasConsumedToLimit()
+	}
+
+	if err := k.UpdateBaseGasPrice(ctx, blockGasUsed); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,159 @@
+package feemarket
+
+import (
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Default parameter values
+const (
+	DefaultEnabled                              = false
+	DefaultTargetBlockGas                uint64 = 10_000_000
+	DefaultBaseGasPriceChangeDenominator uint32 = 8
+	DefaultBaseFeeRecipient                     = ""
+)
+
+// Parameter store keys
+var (
+	KeyEnabled                       = []byte("Enabled")
+	KeyMinBaseGasPrice               = []byte("MinBaseGasPrice")
+	KeyTargetBlockGas                = []byte("TargetBlockGas")
+	KeyBaseGasPriceChangeDenominator = []byte("BaseGasPriceChangeDenominator")
+	KeyBaseFeeRecipient              = []byte("BaseFeeRecipient")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+// ParamKeyTable returns the parameter key table for the feemarket module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance
+func NewParams(
+	enabled bool, minBaseGasPrice sdk.DecCoin, targetBlockGas uint64,
+	baseGasPriceChangeDenominator uint32, baseFeeRecipient string,
+) Params {
+	return Params{
+		Enabled:                       enabled,
+		MinBaseGasPrice:               minBaseGasPrice,
+		TargetBlockGas:                targetBlockGas,
+		BaseGasPriceChangeDenominator: baseGasPriceChangeDenominator,
+		BaseFeeRecipient:              baseFeeRecipient,
+	}
+}
+
+// DefaultParams returns the default feemarket module parameters, with the fee
+// market disabled.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultEnabled,
+		sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.ZeroDec()),
+		DefaultTargetBlockGas,
+		DefaultBaseGasPriceChangeDenominator,
+		DefaultBaseFeeRecipient,
+	)
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+	if err := validateMinBaseGasPrice(p.MinBaseGasPrice); err != nil {
+		return err
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validateBaseGasPriceChangeDenominator(p.BaseGasPriceChangeDenominator); err != nil {
+		return err
+	}
+	return validateBaseFeeRecipient(p.BaseFeeRecipient)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyMinBaseGasPrice, &p.MinBaseGasPrice, validateMinBaseGasPrice),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyBaseGasPriceChangeDenominator, &p.BaseGasPriceChangeDenominator, validateBaseGasPriceChangeDenominator),
+		paramtypes.NewParamSetPair(KeyBaseFeeRecipient, &p.BaseFeeRecipient, validateBaseFeeRecipient),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMinBaseGasPrice(i interface{}) error {
+	v, ok := i.(sdk.DecCoin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := sdk.ValidateDenom(v.Denom); err != nil {
+		return fmt.Errorf("invalid min base gas price denom: %w", err)
+	}
+	if v.Amount.IsNil() || v.Amount.IsNegative() {
+		return fmt.Errorf("min base gas price cannot be negative: %s", v.Amount)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBaseGasPriceChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("base gas price change denominator must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) != v {
+		return fmt.Errorf("base fee recipient cannot have leading or trailing spaces: %q", v)
+	}
+
+	return nil
+}
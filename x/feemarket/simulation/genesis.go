@@ -0,0 +1,58 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+)
+
+// Simulation parameter constants
+const (
+	TargetBlockGas                = "target_block_gas"
+	BaseGasPriceChangeDenominator = "base_gas_price_change_denominator"
+)
+
+// GenTargetBlockGas randomized TargetBlockGas
+func GenTargetBlockGas(r *rand.Rand) uint64 {
+	return uint64(r.Intn(50_000_000) + 1)
+}
+
+// GenBaseGasPriceChangeDenominator randomized BaseGasPriceChangeDenominator
+func GenBaseGasPriceChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(r.Intn(16) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket. The fee market
+// stays disabled, since the simulated transactions pay random fees.
+func RandomizedGenState(simState *module.SimulationState) {
+	var targetBlockGas uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBlockGas, &targetBlockGas, simState.Rand,
+		func(r *rand.Rand) { targetBlockGas = GenTargetBlockGas(r) },
+	)
+
+	var changeDenominator uint32
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseGasPriceChangeDenominator, &changeDenominator, simState.Rand,
+		func(r *rand.Rand) { changeDenominator = GenBaseGasPriceChangeDenominator(r) },
+	)
+
+	params := feemarket.NewParams(
+		false, sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.ZeroDec()),
+		targetBlockGas, changeDenominator, feemarket.DefaultBaseFeeRecipient,
+	)
+	feemarketGenesis := feemarket.NewGenesisState(params, params.MinBaseGasPrice.Amount)
+
+	bz, err := json.MarshalIndent(&feemarketGenesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated feemarket parameters:\n%s\n", bz)
+	simState.GenState[feemarket.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
@@ -3,32 +3,31 @@ package simulation
 // DONTCOVER
 
 import (
-	"encoding/json"
 	"fmt"
 	"math/rand"
 
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
 
-	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	"github.com/cosmos/cosmos-sdk/x/bank/types"
+const (
+	keyTargetBlockGas                = "TargetBlockGas"
+	keyBaseGasPriceChangeDenominator = "BaseGasPriceChangeDenominator"
 )
 
 // ParamChanges defines the parameters that can be modified by param change proposals
 // on the simulation
 func ParamChanges(r *rand.Rand) []simtypes.ParamChange {
 	return []simtypes.ParamChange{
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeySendEnabled),
+		simulation.NewSimParamChange(feemarket.ModuleName, keyTargetBlockGas,
 			func(r *rand.Rand) string {
-				paramsBytes, err := json.Marshal(RandomGenesisSendParams(r))
-				if err != nil {
-					panic(err)
-				}
-				return string(paramsBytes)
+				return fmt.Sprintf("\"%d\"", GenTargetBlockGas(r))
 			},
 		),
-		simulation.NewSimParamChange(types.ModuleName, string(types.KeyDefaultSendEnabled),
+		simulation.NewSimParamChange(feemarket.ModuleName, keyBaseGasPriceChangeDenominator,
 			func(r *rand.Rand) string {
-				return fmt.Sprintf("%v", RandomGenesisDefaultSendParam(r))
+				return fmt.Sprintf("%d", GenBaseGasPriceChangeDenominator(r))
 			},
 		),
 	}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/middleware"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
@@ -36,7 +38,7 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		logger.Info(
 			"proposal did not meet minimum deposit; deleted",
 			"proposal", proposal.ProposalId,
-			"min_deposit", sdk.NewCoins(keeper.GetDepositParams(ctx).MinDeposit...).String(),
+			"min_deposit", keeper.GetMinDeposit(ctx, proposal).String(),
 			"total_deposit", sdk.NewCoins(proposal.TotalDeposit...).String(),
 		)
 
@@ -49,37 +51,54 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 
 		passes, burnDeposits, tallyResults := keeper.Tally(ctx, proposal)
 
+		// An expedited proposal which did not pass and was not vetoed is converted to a regular
+		// proposal, keeping its votes and deposits until the end of the regular voting period.
+		if proposal.Expedited && !passes && !burnDeposits {
+			keeper.RemoveFromActiveProposalQueue(ctx, proposal.ProposalId, *proposal.VotingEndTime)
+
+			proposal.Expedited = false
+			endTime := proposal.VotingStartTime.Add(*keeper.GetVotingParams(ctx).VotingPeriod)
+			proposal.VotingEndTime = &endTime
+
+			keeper.SetProposal(ctx, proposal)
+			keeper.InsertActiveProposalQueue(ctx, proposal.ProposalId, *proposal.VotingEndTime)
+
+			logger.Info(
+				"expedited proposal converted to regular proposal",
+				"proposal", proposal.ProposalId,
+				"voting_end_time", proposal.VotingEndTime,
+			)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeActiveProposal,
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ProposalId)),
+					sdk.NewAttribute(types.AttributeKeyProposalResult, types.AttributeValueExpeditedProposalRejected),
+				),
+			)
+			return false
+		}
+
 		if burnDeposits {
 			keeper.DeleteAndBurnDeposits(ctx, proposal.ProposalId)
 		} else {
 			keeper.RefundAndDeleteDeposits(ctx, proposal.ProposalId)
 		}
+		keeper.DeleteVotes(ctx, proposal.ProposalId)
 
 		if passes {
-			var (
-				idx int
-				msg sdk.Msg
-			)
-
 			// attempt to execute all messages within the passed proposal
 			// Messages may mutate state thus we use a cached context. If one of
 			// the handlers fails, no state mutation is written and the error
-			// message is logged.
+			// message is logged. The execution is bounded by the max execution
+			// gas param, and a handler panicking, e.g. because it ran out of gas,
+			// only fails the proposal.
 			cacheCtx, writeCache := ctx.CacheContext()
-			messages, err := proposal.GetMsgs()
-			if err == nil {
-				for idx, msg = range messages {
-					handler := keeper.Router().Handler(msg)
-					_, err = handler(cacheCtx, msg)
-					if err != nil {
-						break
-					}
-				}
-			}
+			cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(keeper.GetVotingParams(ctx).MaxExecutionGas))
 
 			// `err == nil` when all handlers passed.
-			// Or else, `idx` and `err` are populated with the msg index and error.
-			if err == nil {
+			// Or else, `err` describes the msg which failed.
+			if err := executeProposal(cacheCtx, keeper, proposal); err == nil {
 				proposal.Status = v1beta2.StatusPassed
 				tagValue = types.AttributeValueProposalPassed
 				logMsg = "passed"
@@ -94,8 +113,9 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 				writeCache()
 			} else {
 				proposal.Status = v1beta2.StatusFailed
+				proposal.FailedReason = err.Error()
 				tagValue = types.AttributeValueProposalFailed
-				logMsg = fmt.Sprintf("passed, but msg %d (%s) failed on execution: %s", idx, sdk.MsgTypeURL(msg), err)
+				logMsg = fmt.Sprintf("passed, but %s", err)
 			}
 		} else {
 			proposal.Status = v1beta2.StatusRejected
@@ -127,3 +147,46 @@ func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 		return false
 	})
 }
+
+// executeProposal executes the messages of a passed proposal and returns an error
+// describing the first message which failed. The error message is stored in
+// state, so it must not contain a stacktrace.
+func executeProposal(ctx sdk.Context, keeper keeper.Keeper, proposal v1beta2.Proposal) error {
+	messages, err := proposal.GetMsgs()
+	if err != nil {
+		return err
+	}
+
+	for idx, msg := range messages {
+		if err := safeExecuteHandler(ctx, keeper.Router().Handler(msg), msg); err != nil {
+			return sdkerrors.Wrapf(err, "msg %d (%s) failed on execution", idx, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	return nil
+}
+
+// safeExecuteHandler executes the given handler, turning a panic, e.g. when the
+// handler runs out of gas, into an error.
+func safeExecuteHandler(ctx sdk.Context, handler middleware.MsgServiceHandler, msg sdk.Msg) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			switch r := r.(type) {
+			case sdk.ErrorOutOfGas:
+				err = sdkerrors.Wrapf(sdkerrors.ErrOutOfGas,
+					"out of gas in location: %v; gasLimit: %d, gasUsed: %d",
+					r.Descriptor, ctx.GasMeter().Limit(), ctx.GasMeter().GasConsumed(),
+				)
+
+			case error:
+				err = sdkerrors.ErrPanic.Wrap(r.Error())
+
+			default:
+				err = sdkerrors.ErrPanic.Wrapf("%v", r)
+			}
+		}
+	}()
+
+	_, err = handler(ctx, msg)
+	return err
+}
@@ -10,10 +10,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -38,6 +40,7 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		nil,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -91,6 +94,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		nil,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -115,6 +119,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		nil,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -173,6 +178,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 		sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 5)},
 		addrs[0].String(),
 		nil,
+		false,
 	)
 	require.NoError(t, err)
 
@@ -225,7 +231,7 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	activeQueue.Close()
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 5))}
-	newProposalMsg, err := v1beta2.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), nil)
+	newProposalMsg, err := v1beta2.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, proposalCoins, addrs[0].String(), nil, false)
 	require.NoError(t, err)
 
 	wrapCtx := sdk.WrapSDKContext(ctx)
@@ -293,7 +299,7 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.NotNil(t, macc)
 	initialModuleAccCoins := app.BankKeeper.GetAllBalances(ctx, macc.GetAddress())
 
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
@@ -324,6 +330,156 @@ func TestProposalPassedEndblocker(t *testing.T) {
 	require.True(t, app.BankKeeper.GetAllBalances(ctx, macc.GetAddress()).IsEqual(initialModuleAccCoins))
 }
 
+func TestProposalPassedEndblockerFrozenDepositor(t *testing.T) {
+	app := simapp.Setup(t, false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	addrs := simapp.AddTestAddrs(app, ctx, 10, valTokens)
+
+	SortAddresses(addrs)
+
+	govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+	stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+	header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	valAddr := sdk.ValAddress(addrs[0])
+
+	createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+	staking.EndBlocker(ctx, app.StakingKeeper)
+
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil, nil, false)
+	require.NoError(t, err)
+
+	proposalCoins := sdk.Coins{sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10))}
+	_, err = govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), v1beta2.NewMsgDeposit(addrs[1], proposal.ProposalId, proposalCoins))
+	require.NoError(t, err)
+
+	err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], v1beta2.NewNonSplitVoteOption(v1beta2.OptionYes))
+	require.NoError(t, err)
+
+	// freezing the depositor must not make the deposit refund halt the chain
+	require.NoError(t, app.BankKeeper.SetFrozen(ctx, sdk.DefaultBondDenom, addrs[1], true))
+	balance := app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom)
+
+	newHeader := ctx.BlockHeader()
+	newHeader.Time = ctx.BlockHeader().Time.Add(*app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(*app.GovKeeper.GetVotingParams(ctx).VotingPeriod)
+	ctx = ctx.WithBlockHeader(newHeader)
+
+	require.NotPanics(t, func() { gov.EndBlocker(ctx, app.GovKeeper) })
+
+	proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, ok)
+	require.Equal(t, v1beta2.StatusPassed, proposal.Status)
+	require.Equal(t, balance.Add(proposalCoins[0]), app.BankKeeper.GetBalance(ctx, addrs[1], sdk.DefaultBondDenom))
+}
+
+func TestExpeditedProposal_PassAndConvertToRegular(t *testing.T) {
+	testcases := []struct {
+		name            string
+		expeditedPasses bool
+	}{
+		{name: "expedited passes", expeditedPasses: true},
+		{name: "expedited fails, converted to regular", expeditedPasses: false},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 3, valTokens)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			depositParams := app.GovKeeper.GetDepositParams(ctx)
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+
+			// the regular min deposit is not enough to activate an expedited proposal
+			newProposalMsg, err := v1beta2.NewMsgSubmitProposal([]sdk.Msg{mkTestLegacyContent(t)}, depositParams.MinDeposit, addrs[1].String(), nil, true)
+			require.NoError(t, err)
+
+			res, err := govMsgSvr.SubmitProposal(sdk.WrapSDKContext(ctx), newProposalMsg)
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			proposalID := res.ProposalId
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.Equal(t, v1beta2.StatusDepositPeriod, proposal.Status)
+			require.True(t, proposal.Expedited)
+
+			remaining := sdk.NewCoins(depositParams.ExpeditedMinDeposit...).Sub(depositParams.MinDeposit)
+			newDepositMsg := v1beta2.NewMsgDeposit(addrs[2], proposalID, remaining)
+			res1, err := govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), newDepositMsg)
+			require.NoError(t, err)
+			require.NotNil(t, res1)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.Equal(t, v1beta2.StatusVotingPeriod, proposal.Status)
+			require.Equal(t, proposal.VotingStartTime.Add(*votingParams.ExpeditedVotingPeriod), *proposal.VotingEndTime)
+
+			option := v1beta2.OptionNo
+			if tc.expeditedPasses {
+				option = v1beta2.OptionYes
+			}
+			err = app.GovKeeper.AddVote(ctx, proposalID, addrs[0], v1beta2.NewNonSplitVoteOption(option))
+			require.NoError(t, err)
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = *proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+
+			if tc.expeditedPasses {
+				require.Equal(t, v1beta2.StatusPassed, proposal.Status)
+				return
+			}
+
+			// the proposal is converted to a regular one and keeps its votes and deposits
+			require.Equal(t, v1beta2.StatusVotingPeriod, proposal.Status)
+			require.False(t, proposal.Expedited)
+			require.Equal(t, proposal.VotingStartTime.Add(*votingParams.VotingPeriod), *proposal.VotingEndTime)
+			_, ok = app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+			require.True(t, ok)
+			_, ok = app.GovKeeper.GetDeposit(ctx, proposalID, addrs[2])
+			require.True(t, ok)
+
+			activeQueue := app.GovKeeper.ActiveProposalQueueIterator(ctx, *proposal.VotingEndTime)
+			require.True(t, activeQueue.Valid())
+			require.Equal(t, proposalID, types.GetProposalIDFromBytes(activeQueue.Value()))
+			activeQueue.Close()
+
+			newHeader = ctx.BlockHeader()
+			newHeader.Time = *proposal.VotingEndTime
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			gov.EndBlocker(ctx, app.GovKeeper)
+
+			proposal, ok = app.GovKeeper.GetProposal(ctx, proposalID)
+			require.True(t, ok)
+			require.Equal(t, v1beta2.StatusRejected, proposal.Status)
+			_, ok = app.GovKeeper.GetVote(ctx, proposalID, addrs[0])
+			require.False(t, ok)
+		})
+	}
+}
+
 func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	app := simapp.Setup(t, false)
 	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
@@ -343,7 +499,7 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	// Create a proposal where the handler will pass for the test proposal
 	// because the value of contextKeyBadProposal is true.
 	ctx = ctx.WithValue(contextKeyBadProposal, true)
-	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil)
+	proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{mkTestLegacyContent(t)}, nil, nil, false)
 	require.NoError(t, err)
 
 	proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
@@ -369,6 +525,85 @@ func TestEndBlockerProposalHandlerFailed(t *testing.T) {
 	gov.EndBlocker(ctx, app.GovKeeper)
 }
 
+func TestProposalExecutionGasLimit(t *testing.T) {
+	testcases := []struct {
+		name            string
+		maxExecutionGas uint64
+		circuitTripped  bool
+		expStatus       v1beta2.ProposalStatus
+		expFailedReason string
+	}{
+		{"enough gas", v1beta2.DefaultMaxExecutionGas, false, v1beta2.StatusPassed, ""},
+		{"out of gas", 1, false, v1beta2.StatusFailed, "out of gas"},
+		{"message disabled by the circuit breaker", v1beta2.DefaultMaxExecutionGas, true, v1beta2.StatusFailed, "disabled by the circuit breaker"},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			app := simapp.Setup(t, false)
+			ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+			addrs := simapp.AddTestAddrs(app, ctx, 2, valTokens)
+
+			SortAddresses(addrs)
+
+			govMsgSvr := keeper.NewMsgServerImpl(app.GovKeeper)
+			stakingMsgSvr := stakingkeeper.NewMsgServerImpl(app.StakingKeeper)
+
+			header := tmproto.Header{Height: app.LastBlockHeight() + 1}
+			app.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+			valAddr := sdk.ValAddress(addrs[0])
+
+			createValidators(t, stakingMsgSvr, ctx, []sdk.ValAddress{valAddr}, []int64{10})
+			staking.EndBlocker(ctx, app.StakingKeeper)
+
+			// the proposal sends coins from the gov module account
+			sendCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))
+			require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sendCoins))
+			require.NoError(t, app.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, types.ModuleName, sendCoins))
+			msgSend := banktypes.NewMsgSend(app.GovKeeper.GetGovernanceAccount(ctx).GetAddress(), addrs[1], sendCoins)
+			proposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgSend}, nil, nil, false)
+			require.NoError(t, err)
+
+			proposalCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, app.StakingKeeper.TokensFromConsensusPower(ctx, 10)))
+			res, err := govMsgSvr.Deposit(sdk.WrapSDKContext(ctx), v1beta2.NewMsgDeposit(addrs[0], proposal.ProposalId, proposalCoins))
+			require.NoError(t, err)
+			require.NotNil(t, res)
+
+			err = app.GovKeeper.AddVote(ctx, proposal.ProposalId, addrs[0], v1beta2.NewNonSplitVoteOption(v1beta2.OptionYes))
+			require.NoError(t, err)
+
+			votingParams := app.GovKeeper.GetVotingParams(ctx)
+			votingParams.MaxExecutionGas = tc.maxExecutionGas
+			app.GovKeeper.SetVotingParams(ctx, votingParams)
+
+			if tc.circuitTripped {
+				app.CircuitKeeper.DisableMsg(ctx, sdk.MsgTypeURL(msgSend))
+			}
+
+			balance := app.BankKeeper.GetAllBalances(ctx, addrs[1])
+
+			newHeader := ctx.BlockHeader()
+			newHeader.Time = ctx.BlockHeader().Time.Add(*app.GovKeeper.GetDepositParams(ctx).MaxDepositPeriod).Add(*votingParams.VotingPeriod)
+			ctx = ctx.WithBlockHeader(newHeader)
+
+			require.NotPanics(t, func() { gov.EndBlocker(ctx, app.GovKeeper) })
+
+			proposal, ok := app.GovKeeper.GetProposal(ctx, proposal.ProposalId)
+			require.True(t, ok)
+			require.Equal(t, tc.expStatus, proposal.Status)
+
+			if tc.expFailedReason == "" {
+				require.Empty(t, proposal.FailedReason)
+				require.Equal(t, balance.Add(sendCoins...), app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+			} else {
+				require.Contains(t, proposal.FailedReason, tc.expFailedReason)
+				require.Equal(t, balance, app.BankKeeper.GetAllBalances(ctx, addrs[1]))
+			}
+		})
+	}
+}
+
 func createValidators(t *testing.T, stakingMsgSvr stakingtypes.MsgServer, ctx sdk.Context, addrs []sdk.ValAddress, powerAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 
@@ -17,8 +17,11 @@ func TestIntegrationTestSuite(t *testing.T) {
 	cfg.NumValidators = 1
 	suite.Run(t, NewIntegrationTestSuite(cfg))
 
-	dp := v1beta2.NewDepositParams(sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1beta2.DefaultMinDepositTokens)), time.Duration(15)*time.Second)
-	vp := v1beta2.NewVotingParams(time.Duration(5) * time.Second)
+	dp := v1beta2.NewDepositParams(
+		sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1beta2.DefaultMinDepositTokens)), time.Duration(15)*time.Second,
+		sdk.NewCoins(sdk.NewCoin(cfg.BondDenom, v1beta2.DefaultMinExpeditedDepositTokens)), v1beta2.DefaultProposalCancelRatio,
+	)
+	vp := v1beta2.NewVotingParams(time.Duration(5)*time.Second, time.Duration(2)*time.Second, v1beta2.DefaultMaxExecutionGas)
 	genesisState := v1beta2.DefaultGenesisState()
 	genesisState.DepositParams = &dp
 	genesisState.VotingParams = &vp
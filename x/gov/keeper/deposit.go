@@ -136,7 +136,7 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	// Check if deposit has provided sufficient total funds to transition the proposal into the voting period
 	activatedVotingPeriod := false
 
-	if proposal.Status == v1beta2.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetDepositParams(ctx).MinDeposit) {
+	if proposal.Status == v1beta2.StatusDepositPeriod && sdk.NewCoins(proposal.TotalDeposit...).IsAllGTE(keeper.GetMinDeposit(ctx, proposal)) {
 		keeper.ActivateVotingPeriod(ctx, proposal)
 
 		activatedVotingPeriod = true
@@ -167,6 +167,62 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID uint64, depositorAdd
 	return activatedVotingPeriod, nil
 }
 
+// GetMinDeposit returns the minimum deposit for the given proposal to enter its voting period,
+// which is the expedited minimum deposit for expedited proposals.
+func (keeper Keeper) GetMinDeposit(ctx sdk.Context, proposal v1beta2.Proposal) sdk.Coins {
+	depositParams := keeper.GetDepositParams(ctx)
+	if proposal.Expedited {
+		return depositParams.ExpeditedMinDeposit
+	}
+
+	return depositParams.MinDeposit
+}
+
+// BurnAndRefundDeposits burns the given ratio of all the deposits on a specific proposal, refunds
+// the remainder to the depositors and deletes the deposits.
+func (keeper Keeper) BurnAndRefundDeposits(ctx sdk.Context, proposalID uint64, burnRatio sdk.Dec) error {
+	store := ctx.KVStore(keeper.storeKey)
+
+	var (
+		burnAmount sdk.Coins
+		err        error
+	)
+	keeper.IterateDeposits(ctx, proposalID, func(deposit v1beta2.Deposit) bool {
+		var depositor sdk.AccAddress
+		depositor, err = sdk.AccAddressFromBech32(deposit.Depositor)
+		if err != nil {
+			return true
+		}
+
+		var depositBurn sdk.Coins
+		for _, coin := range deposit.Amount {
+			amount := coin.Amount.ToDec().Mul(burnRatio).TruncateInt()
+			depositBurn = depositBurn.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+		burnAmount = burnAmount.Add(depositBurn...)
+
+		refund := sdk.NewCoins(deposit.Amount...).Sub(depositBurn)
+		if !refund.IsZero() {
+			err = keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund)
+			if err != nil {
+				return true
+			}
+		}
+
+		store.Delete(types.DepositKey(proposalID, depositor))
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	if !burnAmount.IsZero() {
+		return keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, burnAmount)
+	}
+
+	return nil
+}
+
 // RefundAndDeleteDeposits refunds and deletes all the deposits on a specific proposal.
 func (keeper Keeper) RefundAndDeleteDeposits(ctx sdk.Context, proposalID uint64) {
 	store := ctx.KVStore(keeper.storeKey)
@@ -53,7 +53,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1beta2.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, nil)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, nil, nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -127,7 +127,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryProposal() {
 				testProposal := v1beta1.NewTextProposal("Proposal", "testing proposal")
 				msgContent, err := v1beta2.NewLegacyContent(testProposal, govAcct.String())
 				suite.Require().NoError(err)
-				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, nil)
+				submittedProposal, err := app.GovKeeper.SubmitProposal(ctx, []sdk.Msg{msgContent}, nil, nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotEmpty(submittedProposal)
 
@@ -192,7 +192,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryProposals() {
 					testProposal := []sdk.Msg{
 						v1beta2.NewMsgVote(govAddress, uint64(i), v1beta2.OptionYes),
 					}
-					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil)
+					proposal, err := app.GovKeeper.SubmitProposal(ctx, testProposal, nil, nil, false)
 					suite.Require().NotEmpty(proposal)
 					suite.Require().NoError(err)
 					testProposals = append(testProposals, &proposal)
@@ -367,7 +367,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVote() {
 			"no votes present",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta2.QueryVoteRequest{
@@ -472,7 +472,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta2.QueryVotesRequest{
@@ -573,7 +573,7 @@ func (suite *KeeperTestSuite) TestLegacyGRPCQueryVotes() {
 			"create a proposal and get votes",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta1.QueryVotesRequest{
@@ -855,7 +855,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposit() {
 			"no deposits proposal",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -944,7 +944,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryDeposits() {
 			"create a proposal and get deposits",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 
 				req = &v1beta2.QueryDepositsRequest{
@@ -1036,7 +1036,7 @@ func (suite *KeeperTestSuite) TestGRPCQueryTally() {
 			"create a proposal and get tally",
 			func() {
 				var err error
-				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil)
+				proposal, err = app.GovKeeper.SubmitProposal(ctx, TestProposal, nil, nil, false)
 				suite.Require().NoError(err)
 				suite.Require().NotNil(proposal)
 
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
+)
+
+func (suite *KeeperTestSuite) TestMsgUpdateParams() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	_, _, randomAddr := testdata.KeyTestPubAddr()
+	msgServer := keeper.NewMsgServerImpl(suite.app.GovKeeper)
+
+	params := v1beta2.DefaultParams()
+	votingPeriod := time.Hour
+	params.VotingParams.VotingPeriod = &votingPeriod
+
+	// only the authority can update the params
+	_, err := msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), v1beta2.NewMsgUpdateParams(randomAddr, params))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Equal(v1beta2.DefaultPeriod, *suite.app.GovKeeper.GetVotingParams(suite.ctx).VotingPeriod)
+
+	_, err = msgServer.UpdateParams(sdk.WrapSDKContext(suite.ctx), v1beta2.NewMsgUpdateParams(govAcct, params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.app.GovKeeper.GetParams(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestSubmitProposalUpdateParams() {
+	govAcct := suite.app.GovKeeper.GetGovernanceAccount(suite.ctx).GetAddress()
+	_, _, randomAddr := testdata.KeyTestPubAddr()
+
+	params := banktypes.NewParams(false, nil)
+
+	// the proposal messages must be signed by the gov module account
+	_, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{banktypes.NewMsgUpdateParams(randomAddr, params)}, nil, nil, false)
+	suite.Require().ErrorIs(err, types.ErrInvalidSigner)
+
+	msg := banktypes.NewMsgUpdateParams(govAcct, params)
+	_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{msg}, nil, nil, false)
+	suite.Require().NoError(err)
+
+	// executing the proposal message updates the bank params
+	_, err = suite.app.GovKeeper.Router().Handler(msg)(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.app.BankKeeper.GetParams(suite.ctx))
+}
@@ -2,41 +2,59 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
 )
 
-// GetDepositParams returns the current DepositParams from the global param store
+// GetParams returns the current gov params from the gov module store
+func (keeper Keeper) GetParams(ctx sdk.Context) (params v1beta2.Params) {
+	bz := ctx.KVStore(keeper.storeKey).Get(types.ParamsKey)
+	if bz == nil {
+		return params
+	}
+
+	keeper.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the gov params to the gov module store
+func (keeper Keeper) SetParams(ctx sdk.Context, params v1beta2.Params) {
+	bz := keeper.cdc.MustMarshal(&params)
+	ctx.KVStore(keeper.storeKey).Set(types.ParamsKey, bz)
+}
+
+// GetDepositParams returns the current DepositParams from the gov module store
 func (keeper Keeper) GetDepositParams(ctx sdk.Context) v1beta2.DepositParams {
-	var depositParams v1beta2.DepositParams
-	keeper.paramSpace.Get(ctx, v1beta2.ParamStoreKeyDepositParams, &depositParams)
-	return depositParams
+	return keeper.GetParams(ctx).DepositParams
 }
 
-// GetVotingParams returns the current VotingParams from the global param store
+// GetVotingParams returns the current VotingParams from the gov module store
 func (keeper Keeper) GetVotingParams(ctx sdk.Context) v1beta2.VotingParams {
-	var votingParams v1beta2.VotingParams
-	keeper.paramSpace.Get(ctx, v1beta2.ParamStoreKeyVotingParams, &votingParams)
-	return votingParams
+	return keeper.GetParams(ctx).VotingParams
 }
 
-// GetTallyParams returns the current TallyParam from the global param store
+// GetTallyParams returns the current TallyParam from the gov module store
 func (keeper Keeper) GetTallyParams(ctx sdk.Context) v1beta2.TallyParams {
-	var tallyParams v1beta2.TallyParams
-	keeper.paramSpace.Get(ctx, v1beta2.ParamStoreKeyTallyParams, &tallyParams)
-	return tallyParams
+	return keeper.GetParams(ctx).TallyParams
 }
 
-// SetDepositParams sets DepositParams to the global param store
+// SetDepositParams sets DepositParams to the gov module store
 func (keeper Keeper) SetDepositParams(ctx sdk.Context, depositParams v1beta2.DepositParams) {
-	keeper.paramSpace.Set(ctx, v1beta2.ParamStoreKeyDepositParams, &depositParams)
+	params := keeper.GetParams(ctx)
+	params.DepositParams = depositParams
+	keeper.SetParams(ctx, params)
 }
 
-// SetVotingParams sets VotingParams to the global param store
+// SetVotingParams sets VotingParams to the gov module store
 func (keeper Keeper) SetVotingParams(ctx sdk.Context, votingParams v1beta2.VotingParams) {
-	keeper.paramSpace.Set(ctx, v1beta2.ParamStoreKeyVotingParams, &votingParams)
+	params := keeper.GetParams(ctx)
+	params.VotingParams = votingParams
+	keeper.SetParams(ctx, params)
 }
 
-// SetTallyParams sets TallyParams to the global param store
+// SetTallyParams sets TallyParams to the gov module store
 func (keeper Keeper) SetTallyParams(ctx sdk.Context, tallyParams v1beta2.TallyParams) {
-	keeper.paramSpace.Set(ctx, v1beta2.ParamStoreKeyTallyParams, &tallyParams)
+	params := keeper.GetParams(ctx)
+	params.TallyParams = tallyParams
+	keeper.SetParams(ctx, params)
 }
@@ -10,8 +10,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
 )
 
-// SubmitProposal create new proposal given an array of messages
-func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata []byte) (v1beta2.Proposal, error) {
+// SubmitProposal create new proposal given an array of messages. The proposer is recorded in the
+// proposal so that it can later cancel it.
+func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadata []byte, proposer sdk.AccAddress, expedited bool) (v1beta2.Proposal, error) {
 	if metadata != nil && uint64(len(metadata)) > keeper.config.MaxMetadataLen {
 		return v1beta2.Proposal{}, types.ErrMetadataTooLong.Wrapf("got metadata with length %d", len(metadata))
 	}
@@ -67,7 +68,7 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	submitTime := ctx.BlockHeader().Time
 	depositPeriod := keeper.GetDepositParams(ctx).MaxDepositPeriod
 
-	proposal, err := v1beta2.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod))
+	proposal, err := v1beta2.NewProposal(messages, proposalID, metadata, submitTime, submitTime.Add(*depositPeriod), proposer, expedited)
 	if err != nil {
 		return v1beta2.Proposal{}, err
 	}
@@ -90,6 +91,50 @@ func (keeper Keeper) SubmitProposal(ctx sdk.Context, messages []sdk.Msg, metadat
 	return proposal, nil
 }
 
+// CancelProposal cancels a proposal still in its deposit or voting period. Only the proposer can
+// cancel a proposal. The proposal cancel ratio of the deposits is burned and the rest is refunded
+// to the depositors, then the proposal and its votes are deleted.
+func (keeper Keeper) CancelProposal(ctx sdk.Context, proposalID uint64, proposer string) error {
+	proposal, ok := keeper.GetProposal(ctx, proposalID)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+
+	if proposal.Proposer != proposer {
+		return sdkerrors.Wrapf(types.ErrInvalidProposer, "%s is not the proposer of proposal %d", proposer, proposalID)
+	}
+
+	if proposal.Status != v1beta2.StatusDepositPeriod && proposal.Status != v1beta2.StatusVotingPeriod {
+		return sdkerrors.Wrapf(types.ErrInactiveProposal, "%d", proposalID)
+	}
+
+	if proposal.VotingEndTime != nil && !ctx.BlockTime().Before(*proposal.VotingEndTime) {
+		return sdkerrors.Wrapf(types.ErrVotingPeriodEnded, "proposal %d voting period ended at %s", proposalID, proposal.VotingEndTime)
+	}
+
+	cancelRatio, err := sdk.NewDecFromStr(keeper.GetDepositParams(ctx).ProposalCancelRatio)
+	if err != nil {
+		return err
+	}
+
+	if err := keeper.BurnAndRefundDeposits(ctx, proposalID, cancelRatio); err != nil {
+		return err
+	}
+
+	keeper.DeleteVotes(ctx, proposalID)
+	keeper.DeleteProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelProposal,
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyProposalProposer, proposer),
+		),
+	)
+
+	return nil
+}
+
 // GetProposal get proposal from store by ProposalID.
 // Panics if can't unmarshal the proposal.
 func (keeper Keeper) GetProposal(ctx sdk.Context, proposalID uint64) (v1beta2.Proposal, bool) {
@@ -233,10 +278,16 @@ func (keeper Keeper) SetProposalID(ctx sdk.Context, proposalID uint64) {
 	store.Set(types.ProposalIDKey, types.GetProposalIDBytes(proposalID))
 }
 
+// ActivateVotingPeriod moves a proposal into its voting period, which lasts for the expedited
+// voting period if the proposal is expedited.
 func (keeper Keeper) ActivateVotingPeriod(ctx sdk.Context, proposal v1beta2.Proposal) {
 	startTime := ctx.BlockHeader().Time
 	proposal.VotingStartTime = &startTime
-	votingPeriod := keeper.GetVotingParams(ctx).VotingPeriod
+	votingParams := keeper.GetVotingParams(ctx)
+	votingPeriod := votingParams.VotingPeriod
+	if proposal.Expedited {
+		votingPeriod = votingParams.ExpeditedVotingPeriod
+	}
 	endTime := proposal.VotingStartTime.Add(*votingPeriod)
 	proposal.VotingEndTime = &endTime
 	proposal.Status = v1beta2.StatusVotingPeriod
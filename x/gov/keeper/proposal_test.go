@@ -19,7 +19,7 @@ import (
 
 func (suite *KeeperTestSuite) TestGetSetProposal() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil, nil, false)
 	suite.Require().NoError(err)
 	proposalID := proposal.ProposalId
 	suite.app.GovKeeper.SetProposal(suite.ctx, proposal)
@@ -31,7 +31,7 @@ func (suite *KeeperTestSuite) TestGetSetProposal() {
 
 func (suite *KeeperTestSuite) TestActivateVotingPeriod() {
 	tp := TestProposal
-	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil)
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, tp, nil, nil, false)
 	suite.Require().NoError(err)
 
 	suite.Require().Nil(proposal.VotingStartTime)
@@ -82,11 +82,58 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	for i, tc := range testCases {
 		prop, err := v1beta2.NewLegacyContent(tc.content, tc.authority)
 		suite.Require().NoError(err)
-		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata)
+		_, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, []sdk.Msg{prop}, tc.metadata, nil, false)
 		suite.Require().True(errors.Is(tc.expectedErr, err), "tc #%d; got: %v, expected: %v", i, err, tc.expectedErr)
 	}
 }
 
+func (suite *KeeperTestSuite) TestCancelProposal() {
+	proposer := suite.addrs[0]
+	depositor := suite.addrs[1]
+
+	proposal, err := suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, nil, proposer, false)
+	suite.Require().NoError(err)
+	proposalID := proposal.ProposalId
+
+	depositCoins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	_, err = suite.app.GovKeeper.AddDeposit(suite.ctx, proposalID, depositor, depositCoins)
+	suite.Require().NoError(err)
+	depositorBalance := suite.app.BankKeeper.GetAllBalances(suite.ctx, depositor)
+	supply := suite.app.BankKeeper.GetSupply(suite.ctx, sdk.DefaultBondDenom)
+
+	// unknown proposal
+	err = suite.app.GovKeeper.CancelProposal(suite.ctx, proposalID+1, proposer.String())
+	suite.Require().ErrorIs(err, types.ErrUnknownProposal)
+
+	// only the proposer can cancel
+	err = suite.app.GovKeeper.CancelProposal(suite.ctx, proposalID, depositor.String())
+	suite.Require().ErrorIs(err, types.ErrInvalidProposer)
+
+	err = suite.app.GovKeeper.CancelProposal(suite.ctx, proposalID, proposer.String())
+	suite.Require().NoError(err)
+
+	_, ok := suite.app.GovKeeper.GetProposal(suite.ctx, proposalID)
+	suite.Require().False(ok)
+	_, ok = suite.app.GovKeeper.GetDeposit(suite.ctx, proposalID, depositor)
+	suite.Require().False(ok)
+
+	// half of the deposit (default cancel ratio) is burned, the rest is refunded
+	refund := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(500)))
+	suite.Require().Equal(depositorBalance.Add(refund...), suite.app.BankKeeper.GetAllBalances(suite.ctx, depositor))
+	suite.Require().Equal(supply.SubAmount(sdk.NewInt(500)), suite.app.BankKeeper.GetSupply(suite.ctx, sdk.DefaultBondDenom))
+
+	// a proposal cannot be canceled once its voting period has ended
+	proposal, err = suite.app.GovKeeper.SubmitProposal(suite.ctx, TestProposal, nil, proposer, false)
+	suite.Require().NoError(err)
+	suite.app.GovKeeper.ActivateVotingPeriod(suite.ctx, proposal)
+	proposal, ok = suite.app.GovKeeper.GetProposal(suite.ctx, proposal.ProposalId)
+	suite.Require().True(ok)
+
+	ctx := suite.ctx.WithBlockTime(*proposal.VotingEndTime)
+	err = suite.app.GovKeeper.CancelProposal(ctx, proposal.ProposalId, proposer.String())
+	suite.Require().ErrorIs(err, types.ErrVotingPeriodEnded)
+}
+
 func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 	proposalID := uint64(1)
 	status := []v1beta2.ProposalStatus{v1beta2.StatusDepositPeriod, v1beta2.StatusVotingPeriod}
@@ -95,7 +142,7 @@ func (suite *KeeperTestSuite) TestGetProposalsFiltered() {
 
 	for _, s := range status {
 		for i := 0; i < 50; i++ {
-			p, err := v1beta2.NewProposal(TestProposal, proposalID, nil, time.Now(), time.Now())
+			p, err := v1beta2.NewProposal(TestProposal, proposalID, nil, time.Now(), time.Now(), nil, false)
 			suite.Require().NoError(err)
 
 			p.Status = s
@@ -9,7 +9,8 @@ import (
 // TODO: Break into several smaller functions for clarity
 
 // Tally iterates over the votes and updates the tally of a proposal based on the voting power of the
-// voters
+// voters. Expedited proposals are tallied against the expedited threshold. Votes are left in the store,
+// it is up to the caller to delete them once the proposal is finalized.
 func (keeper Keeper) Tally(ctx sdk.Context, proposal v1beta2.Proposal) (passes bool, burnDeposits bool, tallyResults v1beta2.TallyResult) {
 	results := make(map[v1beta2.VoteOption]sdk.Dec)
 	results[v1beta2.OptionYes] = sdk.ZeroDec()
@@ -71,7 +72,6 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1beta2.Proposal) (passes b
 			return false
 		})
 
-		keeper.deleteVote(ctx, vote.ProposalId, voter)
 		return false
 	})
 
@@ -121,6 +121,9 @@ func (keeper Keeper) Tally(ctx sdk.Context, proposal v1beta2.Proposal) (passes b
 
 	// If more than 1/2 of non-abstaining voters vote Yes, proposal passes
 	threshold, _ := sdk.NewDecFromStr(tallyParams.Threshold)
+	if proposal.Expedited {
+		threshold, _ = sdk.NewDecFromStr(tallyParams.ExpeditedThreshold)
+	}
 	if results[v1beta2.OptionYes].Quo(totalVotingPower.Sub(results[v1beta2.OptionAbstain])).GT(threshold) {
 		return true, false, tallyResults
 	}
@@ -2,6 +2,7 @@ package v046
 
 import (
 	"fmt"
+	"time"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/types"
@@ -165,25 +166,62 @@ func convertToNewVotes(oldVotes v1beta1.Votes) (v1beta2.Votes, error) {
 
 func convertToNewDepParams(oldDepParams v1beta1.DepositParams) v1beta2.DepositParams {
 	return v1beta2.DepositParams{
-		MinDeposit:       oldDepParams.MinDeposit,
-		MaxDepositPeriod: &oldDepParams.MaxDepositPeriod,
+		MinDeposit:          oldDepParams.MinDeposit,
+		MaxDepositPeriod:    &oldDepParams.MaxDepositPeriod,
+		ExpeditedMinDeposit: defaultExpeditedMinDeposit(oldDepParams.MinDeposit),
+		ProposalCancelRatio: v1beta2.DefaultProposalCancelRatio.String(),
 	}
 }
 
 func convertToNewVotingParams(oldVoteParams v1beta1.VotingParams) v1beta2.VotingParams {
+	expeditedVotingPeriod := defaultExpeditedVotingPeriod(oldVoteParams.VotingPeriod)
 	return v1beta2.VotingParams{
-		VotingPeriod: &oldVoteParams.VotingPeriod,
+		VotingPeriod:          &oldVoteParams.VotingPeriod,
+		ExpeditedVotingPeriod: &expeditedVotingPeriod,
+		MaxExecutionGas:       v1beta2.DefaultMaxExecutionGas,
 	}
 }
 
 func convertToNewTallyParams(oldTallyParams v1beta1.TallyParams) v1beta2.TallyParams {
 	return v1beta2.TallyParams{
-		Quorum:        oldTallyParams.Quorum.String(),
-		Threshold:     oldTallyParams.Threshold.String(),
-		VetoThreshold: oldTallyParams.VetoThreshold.String(),
+		Quorum:             oldTallyParams.Quorum.String(),
+		Threshold:          oldTallyParams.Threshold.String(),
+		VetoThreshold:      oldTallyParams.VetoThreshold.String(),
+		ExpeditedThreshold: defaultExpeditedThreshold(oldTallyParams.Threshold).String(),
 	}
 }
 
+// defaultExpeditedMinDeposit returns the default expedited minimum deposit for the given minimum
+// deposit.
+func defaultExpeditedMinDeposit(minDeposit types.Coins) types.Coins {
+	expeditedMinDeposit := make(types.Coins, len(minDeposit))
+	for i, coin := range minDeposit {
+		expeditedMinDeposit[i] = types.NewCoin(coin.Denom, coin.Amount.MulRaw(v1beta2.DefaultMinExpeditedDepositRatio))
+	}
+
+	return expeditedMinDeposit
+}
+
+// defaultExpeditedVotingPeriod returns the default expedited voting period, or half of the voting
+// period if the latter is not longer than the default expedited voting period.
+func defaultExpeditedVotingPeriod(votingPeriod time.Duration) time.Duration {
+	if v1beta2.DefaultExpeditedPeriod < votingPeriod {
+		return v1beta2.DefaultExpeditedPeriod
+	}
+
+	return votingPeriod / 2
+}
+
+// defaultExpeditedThreshold returns the default expedited threshold, or the midpoint between the
+// threshold and 1 if the threshold is not lower than the default expedited threshold.
+func defaultExpeditedThreshold(threshold types.Dec) types.Dec {
+	if v1beta2.DefaultExpeditedThreshold.GT(threshold) {
+		return v1beta2.DefaultExpeditedThreshold
+	}
+
+	return threshold.Add(types.OneDec()).QuoInt64(2)
+}
+
 func convertToNewProposal(oldProp v1beta1.Proposal) (v1beta2.Proposal, error) {
 	msg, err := v1beta2.NewLegacyContent(oldProp.GetContent(), authtypes.NewModuleAddress(ModuleName).String())
 	if err != nil {
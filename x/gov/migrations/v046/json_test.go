@@ -74,18 +74,27 @@ func TestMigrateJSON(t *testing.T) {
 	// - Proposals use MsgExecLegacyContent
 	expected := `{
 	"deposit_params": {
+		"expedited_min_deposit": [
+			{
+				"amount": "50000000",
+				"denom": "stake"
+			}
+		],
 		"max_deposit_period": "172800s",
 		"min_deposit": [
 			{
 				"amount": "10000000",
 				"denom": "stake"
 			}
-		]
+		],
+		"proposal_cancel_ratio": "0.500000000000000000"
 	},
 	"deposits": [],
 	"proposals": [
 		{
 			"deposit_end_time": "2001-09-09T01:46:40Z",
+			"expedited": false,
+			"failed_reason": "",
 			"final_tally_result": {
 				"abstain": "0",
 				"no": "0",
@@ -105,6 +114,7 @@ func TestMigrateJSON(t *testing.T) {
 			],
 			"metadata": null,
 			"proposal_id": "1",
+			"proposer": "",
 			"status": "PROPOSAL_STATUS_DEPOSIT_PERIOD",
 			"submit_time": "2001-09-09T01:46:40Z",
 			"total_deposit": [
@@ -119,6 +129,7 @@ func TestMigrateJSON(t *testing.T) {
 	],
 	"starting_proposal_id": "1",
 	"tally_params": {
+		"expedited_threshold": "0.667000000000000000",
 		"quorum": "0.334000000000000000",
 		"threshold": "0.500000000000000000",
 		"veto_threshold": "0.334000000000000000"
@@ -146,6 +157,8 @@ func TestMigrateJSON(t *testing.T) {
 		}
 	],
 	"voting_params": {
+		"expedited_voting_period": "86400s",
+		"max_execution_gas": "10000000",
 		"voting_period": "172800s"
 	}
 }`
@@ -4,3 +4,6 @@ const (
 	// ModuleName is the name of the module
 	ModuleName = "gov"
 )
+
+// ParamsKey is the key of the gov params in the gov module store
+var ParamsKey = []byte{0x30}
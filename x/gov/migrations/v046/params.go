@@ -0,0 +1,37 @@
+package v046
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
+)
+
+// MigrateParamsToStore performs in-place store migrations from consensus
+// version 3 to 4. The migration moves the deposit, voting and tally params out
+// of the legacy x/params subspace into a single Params object in the x/gov
+// module store.
+func MigrateParamsToStore(ctx sdk.Context, storeKey storetypes.StoreKey, legacySubspace types.ParamSubspace, cdc codec.BinaryCodec) error {
+	var (
+		depositParams v1beta2.DepositParams
+		votingParams  v1beta2.VotingParams
+		tallyParams   v1beta2.TallyParams
+	)
+	legacySubspace.Get(ctx, v1beta2.ParamStoreKeyDepositParams, &depositParams)
+	legacySubspace.Get(ctx, v1beta2.ParamStoreKeyVotingParams, &votingParams)
+	legacySubspace.Get(ctx, v1beta2.ParamStoreKeyTallyParams, &tallyParams)
+
+	params := v1beta2.NewParams(votingParams, tallyParams, depositParams)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(storeKey).Set(ParamsKey, bz)
+	return nil
+}
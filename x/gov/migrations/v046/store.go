@@ -6,7 +6,9 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	v040 "github.com/cosmos/cosmos-sdk/x/gov/migrations/v040"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
 )
 
 // migrateProposals migrates all legacy proposals into MsgExecLegacyContent
@@ -40,12 +42,45 @@ func migrateProposals(store sdk.KVStore, cdc codec.BinaryCodec) error {
 	return nil
 }
 
+// migrateParams sets the expedited proposal, proposal cancellation and proposal
+// execution params, which did not exist before v0.46, to their default values.
+func migrateParams(ctx sdk.Context, paramSpace types.ParamSubspace) error {
+	var depositParams v1beta2.DepositParams
+	paramSpace.Get(ctx, v1beta2.ParamStoreKeyDepositParams, &depositParams)
+	depositParams.ExpeditedMinDeposit = defaultExpeditedMinDeposit(depositParams.MinDeposit)
+	depositParams.ProposalCancelRatio = v1beta2.DefaultProposalCancelRatio.String()
+	paramSpace.Set(ctx, v1beta2.ParamStoreKeyDepositParams, depositParams)
+
+	var votingParams v1beta2.VotingParams
+	paramSpace.Get(ctx, v1beta2.ParamStoreKeyVotingParams, &votingParams)
+	expeditedVotingPeriod := defaultExpeditedVotingPeriod(*votingParams.VotingPeriod)
+	votingParams.ExpeditedVotingPeriod = &expeditedVotingPeriod
+	votingParams.MaxExecutionGas = v1beta2.DefaultMaxExecutionGas
+	paramSpace.Set(ctx, v1beta2.ParamStoreKeyVotingParams, votingParams)
+
+	var tallyParams v1beta2.TallyParams
+	paramSpace.Get(ctx, v1beta2.ParamStoreKeyTallyParams, &tallyParams)
+	threshold, err := sdk.NewDecFromStr(tallyParams.Threshold)
+	if err != nil {
+		return err
+	}
+	tallyParams.ExpeditedThreshold = defaultExpeditedThreshold(threshold).String()
+	paramSpace.Set(ctx, v1beta2.ParamStoreKeyTallyParams, tallyParams)
+
+	return nil
+}
+
 // MigrateStore performs in-place store migrations from v0.43 to v0.46. The
 // migration includes:
 //
 // - Migrate proposals to be Msg-based.
-func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+// - Set the expedited proposal and proposal cancellation params.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec, paramSpace types.ParamSubspace) error {
 	store := ctx.KVStore(storeKey)
 
-	return migrateProposals(store, cdc)
+	if err := migrateProposals(store, cdc); err != nil {
+		return err
+	}
+
+	return migrateParams(ctx, paramSpace)
 }
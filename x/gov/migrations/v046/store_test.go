@@ -13,14 +13,18 @@ import (
 	v046gov "github.com/cosmos/cosmos-sdk/x/gov/migrations/v046"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	"github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 )
 
 func TestMigrateStore(t *testing.T) {
-	cdc := simapp.MakeTestEncodingConfig().Codec
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Codec
 	govKey := sdk.NewKVStoreKey("gov")
-	ctx := testutil.DefaultContext(govKey, sdk.NewTransientStoreKey("transient_test"))
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
 	store := ctx.KVStore(govKey)
+	paramstore := paramtypes.NewSubspace(cdc, encCfg.Amino, govKey, tGovKey, "gov").WithKeyTable(v1beta2.ParamKeyTable())
 
 	propTime := time.Unix(1e9, 0)
 
@@ -39,10 +43,41 @@ func TestMigrateStore(t *testing.T) {
 	store.Set(v040gov.ProposalKey(prop1.ProposalId), prop1Bz)
 	store.Set(v040gov.ProposalKey(prop2.ProposalId), prop2Bz)
 
+	// Set v0.43 params.
+	oldDepositParams := v1beta1.DefaultDepositParams()
+	oldVotingParams := v1beta1.NewVotingParams(time.Hour)
+	oldTallyParams := v1beta1.NewTallyParams(v1beta1.DefaultQuorum, sdk.NewDecWithPrec(8, 1), v1beta1.DefaultVetoThreshold)
+	paramstore.Set(ctx, v1beta2.ParamStoreKeyDepositParams, v1beta2.DepositParams{
+		MinDeposit:       oldDepositParams.MinDeposit,
+		MaxDepositPeriod: &oldDepositParams.MaxDepositPeriod,
+	})
+	paramstore.Set(ctx, v1beta2.ParamStoreKeyVotingParams, v1beta2.VotingParams{VotingPeriod: &oldVotingParams.VotingPeriod})
+	paramstore.Set(ctx, v1beta2.ParamStoreKeyTallyParams, v1beta2.TallyParams{
+		Quorum:        oldTallyParams.Quorum.String(),
+		Threshold:     oldTallyParams.Threshold.String(),
+		VetoThreshold: oldTallyParams.VetoThreshold.String(),
+	})
+
 	// Run migrations.
-	err = v046gov.MigrateStore(ctx, govKey, cdc)
+	err = v046gov.MigrateStore(ctx, govKey, cdc, paramstore)
 	require.NoError(t, err)
 
+	// Make sure the new params are set.
+	var depositParams v1beta2.DepositParams
+	paramstore.Get(ctx, v1beta2.ParamStoreKeyDepositParams, &depositParams)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, v1beta2.DefaultMinExpeditedDepositTokens)), sdk.NewCoins(depositParams.ExpeditedMinDeposit...))
+	require.Equal(t, v1beta2.DefaultProposalCancelRatio.String(), depositParams.ProposalCancelRatio)
+
+	var votingParams v1beta2.VotingParams
+	paramstore.Get(ctx, v1beta2.ParamStoreKeyVotingParams, &votingParams)
+	require.Equal(t, time.Hour, *votingParams.VotingPeriod)
+	require.Equal(t, 30*time.Minute, *votingParams.ExpeditedVotingPeriod)
+	require.Equal(t, v1beta2.DefaultMaxExecutionGas, votingParams.MaxExecutionGas)
+
+	var tallyParams v1beta2.TallyParams
+	paramstore.Get(ctx, v1beta2.ParamStoreKeyTallyParams, &tallyParams)
+	require.Equal(t, sdk.NewDecWithPrec(9, 1).String(), tallyParams.ExpeditedThreshold)
+
 	var newProp1 v1beta2.Proposal
 	err = cdc.Unmarshal(store.Get(v040gov.ProposalKey(prop1.ProposalId)), &newProp1)
 	require.NoError(t, err)
@@ -74,3 +109,28 @@ func compareProps(t *testing.T, oldProp v1beta1.Proposal, newProp v1beta2.Propos
 	require.Equal(t, oldProp.VotingStartTime.Unix(), newProp.VotingStartTime.Unix())
 	require.Equal(t, oldProp.VotingEndTime.Unix(), newProp.VotingEndTime.Unix())
 }
+
+func TestMigrateParamsToStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	cdc := encCfg.Codec
+	govKey := sdk.NewKVStoreKey("gov")
+	tGovKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(govKey, tGovKey)
+	paramstore := paramtypes.NewSubspace(cdc, encCfg.Amino, govKey, tGovKey, "gov").WithKeyTable(v1beta2.ParamKeyTable())
+
+	// Set the params in the legacy subspace.
+	params := v1beta2.DefaultParams()
+	paramstore.Set(ctx, v1beta2.ParamStoreKeyDepositParams, params.DepositParams)
+	paramstore.Set(ctx, v1beta2.ParamStoreKeyVotingParams, params.VotingParams)
+	paramstore.Set(ctx, v1beta2.ParamStoreKeyTallyParams, params.TallyParams)
+
+	// Run migrations.
+	err := v046gov.MigrateParamsToStore(ctx, govKey, paramstore, cdc)
+	require.NoError(t, err)
+
+	// Make sure the params were moved into the module store.
+	var res v1beta2.Params
+	bz := ctx.KVStore(govKey).Get(v046gov.ParamsKey)
+	require.NoError(t, cdc.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
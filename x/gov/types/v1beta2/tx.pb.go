@@ -13,18 +13,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,8 @@ type MsgSubmitProposal struct {
 	Proposer       string        `protobuf:"bytes,3,opt,name=proposer,proto3" json:"proposer,omitempty"`
 	// metadata is any arbitrary metadata attached to the proposal.
 	Metadata []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// expedited defines whether the proposal is expedited.
+	Expedited bool `protobuf:"varint,5,opt,name=expedited,proto3" json:"expedited,omitempty"`
 }
 
 func (m *MsgSubmitProposal) Reset()         { *m = MsgSubmitProposal{} }
@@ -103,6 +109,13 @@ func (m *MsgSubmitProposal) GetMetadata() []byte {
 	return nil
 }
 
+func (m *MsgSubmitProposal) GetExpedited() bool {
+	if m != nil {
+		return m.Expedited
+	}
+	return false
+}
+
 // MsgSubmitProposalResponse defines the Msg/SubmitProposal response type.
 type MsgSubmitProposalResponse struct {
 	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
@@ -535,6 +548,216 @@ func (m *MsgDepositResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDepositResponse proto.InternalMessageInfo
 
+// MsgCancelProposal defines a message to cancel a proposal.
+type MsgCancelProposal struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	Proposer   string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *MsgCancelProposal) Reset()         { *m = MsgCancelProposal{} }
+func (m *MsgCancelProposal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposal) ProtoMessage()    {}
+func (*MsgCancelProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4214261f6b3f9ed4, []int{10}
+}
+func (m *MsgCancelProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposal.Merge(m, src)
+}
+func (m *MsgCancelProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposal proto.InternalMessageInfo
+
+func (m *MsgCancelProposal) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+// MsgCancelProposalResponse defines the Msg/CancelProposal response type.
+type MsgCancelProposalResponse struct {
+	ProposalId     uint64    `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id"`
+	CanceledTime   time.Time `protobuf:"bytes,2,opt,name=canceled_time,json=canceledTime,proto3,stdtime" json:"canceled_time"`
+	CanceledHeight uint64    `protobuf:"varint,3,opt,name=canceled_height,json=canceledHeight,proto3" json:"canceled_height,omitempty"`
+}
+
+func (m *MsgCancelProposalResponse) Reset()         { *m = MsgCancelProposalResponse{} }
+func (m *MsgCancelProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelProposalResponse) ProtoMessage()    {}
+func (*MsgCancelProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4214261f6b3f9ed4, []int{11}
+}
+func (m *MsgCancelProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelProposalResponse.Merge(m, src)
+}
+func (m *MsgCancelProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelProposalResponse proto.InternalMessageInfo
+
+func (m *MsgCancelProposalResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func (m *MsgCancelProposalResponse) GetCanceledTime() time.Time {
+	if m != nil {
+		return m.CanceledTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCancelProposalResponse) GetCanceledHeight() uint64 {
+	if m != nil {
+		return m.CanceledHeight
+	}
+	return 0
+}
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address of the account allowed to update the params, the
+	// governance module account by default.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/gov parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4214261f6b3f9ed4, []int{12}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4214261f6b3f9ed4, []int{13}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSubmitProposal)(nil), "cosmos.gov.v1beta2.MsgSubmitProposal")
 	proto.RegisterType((*MsgSubmitProposalResponse)(nil), "cosmos.gov.v1beta2.MsgSubmitProposalResponse")
@@ -546,59 +769,75 @@ func init() {
 	proto.RegisterType((*MsgVoteWeightedResponse)(nil), "cosmos.gov.v1beta2.MsgVoteWeightedResponse")
 	proto.RegisterType((*MsgDeposit)(nil), "cosmos.gov.v1beta2.MsgDeposit")
 	proto.RegisterType((*MsgDepositResponse)(nil), "cosmos.gov.v1beta2.MsgDepositResponse")
+	proto.RegisterType((*MsgCancelProposal)(nil), "cosmos.gov.v1beta2.MsgCancelProposal")
+	proto.RegisterType((*MsgCancelProposalResponse)(nil), "cosmos.gov.v1beta2.MsgCancelProposalResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "cosmos.gov.v1beta2.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "cosmos.gov.v1beta2.MsgUpdateParamsResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/gov/v1beta2/tx.proto", fileDescriptor_4214261f6b3f9ed4) }
 
 var fileDescriptor_4214261f6b3f9ed4 = []byte{
-	// 737 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0x4f, 0x6f, 0xd3, 0x4e,
-	0x10, 0x8d, 0x9b, 0xfc, 0x9a, 0x76, 0xda, 0x5f, 0xaa, 0xae, 0x22, 0xd5, 0x71, 0x2b, 0x37, 0x32,
-	0xa2, 0x8a, 0x40, 0xb1, 0x93, 0x80, 0x8a, 0x54, 0x38, 0xd0, 0x14, 0xa4, 0x82, 0x88, 0x00, 0x57,
-	0x02, 0x89, 0x4b, 0x71, 0xe2, 0xed, 0xd6, 0xa2, 0xf1, 0x5a, 0xde, 0x4d, 0xd4, 0x1c, 0xe1, 0x13,
-	0xf0, 0x4d, 0xe0, 0xd0, 0x3b, 0xe2, 0x56, 0x71, 0xaa, 0x38, 0x71, 0xa1, 0x42, 0xed, 0x01, 0x89,
-	0x13, 0x1f, 0x01, 0xd9, 0x5e, 0x3b, 0x6d, 0xf3, 0xa7, 0xe5, 0xc2, 0x29, 0xbb, 0xf3, 0xde, 0x9b,
-	0x9d, 0xb7, 0x99, 0xf1, 0xc2, 0x62, 0x8b, 0xb2, 0x36, 0x65, 0x06, 0xa1, 0x5d, 0xa3, 0x5b, 0x6d,
-	0x62, 0x6e, 0xd5, 0x0c, 0xbe, 0xaf, 0x7b, 0x3e, 0xe5, 0x14, 0xa1, 0x08, 0xd4, 0x09, 0xed, 0xea,
-	0x02, 0x54, 0x54, 0x21, 0x68, 0x5a, 0x0c, 0x0b, 0x45, 0xd5, 0x68, 0x51, 0xc7, 0x8d, 0x34, 0xca,
-	0xd2, 0x90, 0x84, 0x81, 0x3e, 0x42, 0xf3, 0x84, 0x12, 0x1a, 0x2e, 0x8d, 0x60, 0x25, 0xa2, 0x85,
-	0x48, 0xb3, 0x1d, 0x01, 0xe2, 0x50, 0x01, 0x11, 0x4a, 0xc9, 0x1e, 0x36, 0xc2, 0x5d, 0xb3, 0xb3,
-	0x63, 0x58, 0x6e, 0x4f, 0x40, 0x0b, 0xe2, 0xa4, 0x36, 0x23, 0x46, 0xb7, 0x1a, 0xfc, 0x44, 0x80,
-	0xf6, 0x5b, 0x82, 0xf9, 0x06, 0x23, 0x5b, 0x9d, 0x66, 0xdb, 0xe1, 0xcf, 0x7c, 0xea, 0x51, 0x66,
-	0xed, 0xa1, 0x0a, 0x4c, 0xb5, 0x31, 0x63, 0x16, 0xc1, 0x4c, 0x96, 0x8a, 0xe9, 0xd2, 0x4c, 0x2d,
-	0xaf, 0x47, 0xc9, 0xf5, 0x38, 0xb9, 0xbe, 0xee, 0xf6, 0xcc, 0x84, 0x85, 0x36, 0x61, 0xce, 0x71,
-	0x1d, 0xee, 0x58, 0x7b, 0xdb, 0x36, 0xf6, 0x28, 0x73, 0xb8, 0x3c, 0x11, 0x0a, 0x0b, 0xba, 0xa8,
-	0x31, 0xb8, 0x04, 0x71, 0x33, 0x55, 0x7d, 0x83, 0x3a, 0x6e, 0x3d, 0x73, 0x78, 0xbc, 0x9c, 0x32,
-	0x73, 0x42, 0xf7, 0x20, 0x92, 0xa1, 0xdb, 0x30, 0xe5, 0x85, 0x75, 0x60, 0x5f, 0x4e, 0x17, 0xa5,
-	0xd2, 0x74, 0x5d, 0xfe, 0x7a, 0x50, 0xce, 0x8b, 0x2c, 0xeb, 0xb6, 0xed, 0x63, 0xc6, 0xb6, 0xb8,
-	0xef, 0xb8, 0xc4, 0x4c, 0x98, 0x48, 0x09, 0x2a, 0xe6, 0x96, 0x6d, 0x71, 0x4b, 0xce, 0x14, 0xa5,
-	0xd2, 0xac, 0x99, 0xec, 0xd7, 0xfe, 0x7f, 0xf7, 0xf3, 0xe3, 0x8d, 0x84, 0xaa, 0xdd, 0x83, 0xc2,
-	0x80, 0x63, 0x13, 0x33, 0x8f, 0xba, 0x0c, 0xa3, 0x65, 0x98, 0xf1, 0x44, 0x6c, 0xdb, 0xb1, 0x65,
-	0xa9, 0x28, 0x95, 0x32, 0x26, 0xc4, 0xa1, 0x47, 0xb6, 0xf6, 0x56, 0x82, 0x7c, 0x83, 0x91, 0x87,
-	0xfb, 0xb8, 0xf5, 0x04, 0x13, 0xab, 0xd5, 0xdb, 0xa0, 0x2e, 0xc7, 0x2e, 0x47, 0x77, 0x21, 0xdb,
-	0x8a, 0x96, 0xa1, 0x6a, 0xc4, 0x95, 0xd5, 0x67, 0xbe, 0x1c, 0x94, 0xb3, 0x42, 0x63, 0xc6, 0x0a,
-	0xb4, 0x04, 0xd3, 0x56, 0x87, 0xef, 0x52, 0xdf, 0xe1, 0x3d, 0x79, 0x22, 0x70, 0x6d, 0xf6, 0x03,
-	0x6b, 0xb9, 0xc0, 0x40, 0x7f, 0xaf, 0xa9, 0xb0, 0x34, 0xac, 0x84, 0xd8, 0x84, 0xf6, 0x41, 0x82,
-	0x6c, 0x83, 0x91, 0x17, 0x94, 0x63, 0x54, 0x19, 0x62, 0xa8, 0x3e, 0xf7, 0xeb, 0x78, 0xf9, 0x6c,
-	0xf8, 0xac, 0x43, 0xa4, 0xc3, 0x7f, 0x5d, 0xca, 0xb1, 0x1f, 0xd5, 0x31, 0xe6, 0xf6, 0x23, 0x1a,
-	0x5a, 0x85, 0x49, 0xea, 0x71, 0x87, 0xba, 0xe1, 0xdf, 0x95, 0xab, 0xa9, 0xfa, 0xe0, 0x28, 0xe8,
-	0x41, 0x2d, 0x4f, 0x43, 0x96, 0x29, 0xd8, 0x6b, 0x10, 0xb8, 0x8a, 0x72, 0x68, 0xf3, 0x30, 0x27,
-	0x0a, 0x4e, 0x4c, 0x7c, 0x96, 0x92, 0xd8, 0x4b, 0xec, 0x90, 0x5d, 0x8e, 0xed, 0x7f, 0x60, 0xe6,
-	0x3e, 0x64, 0xa3, 0xf2, 0x98, 0x9c, 0x0e, 0xfb, 0x77, 0x65, 0x98, 0x9b, 0xb8, 0xa0, 0x33, 0xae,
-	0x62, 0xd9, 0x39, 0x5b, 0x05, 0x58, 0xb8, 0x60, 0x21, 0xb1, 0xf7, 0x49, 0x02, 0x68, 0x30, 0x12,
-	0x77, 0xfd, 0xdf, 0x3b, 0x5b, 0x85, 0x69, 0x31, 0x69, 0xf4, 0x72, 0x77, 0x7d, 0x2a, 0xba, 0x03,
-	0x93, 0x56, 0x9b, 0x76, 0x5c, 0x2e, 0x0c, 0x5e, 0x3a, 0xa0, 0x82, 0x2e, 0xba, 0x30, 0x49, 0xa4,
-	0xe5, 0x01, 0xf5, 0x0d, 0xc4, 0xbe, 0x6a, 0xdf, 0xd3, 0x90, 0x6e, 0x30, 0x82, 0x76, 0x20, 0x77,
-	0xe1, 0xa3, 0x72, 0x7d, 0xd8, 0x4d, 0x0e, 0x4c, 0xa2, 0x52, 0xbe, 0x12, 0x2d, 0x19, 0x58, 0x0a,
-	0xf3, 0x83, 0xb3, 0x58, 0x1a, 0x91, 0x63, 0x80, 0xa9, 0x54, 0xae, 0xca, 0x4c, 0x0e, 0xdc, 0x84,
-	0x4c, 0x38, 0x58, 0x8b, 0x23, 0x94, 0x01, 0xa8, 0x5c, 0x1b, 0x03, 0x26, 0x99, 0x5e, 0xc3, 0xec,
-	0xb9, 0xee, 0x1e, 0x27, 0x8a, 0x49, 0xca, 0xcd, 0x2b, 0x90, 0x92, 0x13, 0x9e, 0x43, 0x36, 0x6e,
-	0x30, 0x75, 0x84, 0x4e, 0xe0, 0xca, 0xca, 0x78, 0x3c, 0x4e, 0x59, 0x7f, 0x7c, 0x78, 0xa2, 0x4a,
-	0x47, 0x27, 0xaa, 0xf4, 0xe3, 0x44, 0x95, 0xde, 0x9f, 0xaa, 0xa9, 0xa3, 0x53, 0x35, 0xf5, 0xed,
-	0x54, 0x4d, 0xbd, 0xaa, 0x10, 0x87, 0xef, 0x76, 0x9a, 0x7a, 0x8b, 0xb6, 0xc5, 0xbb, 0x24, 0x7e,
-	0xca, 0xcc, 0x7e, 0x63, 0xec, 0x87, 0xaf, 0x1c, 0xef, 0x79, 0x98, 0xc5, 0x6f, 0x5d, 0x73, 0x32,
-	0xfc, 0x32, 0xde, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0xf4, 0x8d, 0x72, 0xa4, 0x59, 0x07, 0x00,
-	0x00,
+	// 934 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xae, 0x93, 0x6c, 0xd3, 0xbe, 0x76, 0x53, 0x75, 0x14, 0x69, 0x1d, 0x6f, 0xe5, 0x44, 0x41,
+	0x2c, 0x11, 0xab, 0xd8, 0x6d, 0x40, 0x0b, 0x2a, 0x1c, 0xd8, 0x14, 0xa4, 0x2e, 0x22, 0x62, 0xf1,
+	0xf2, 0x43, 0xe2, 0x52, 0x26, 0xf1, 0x74, 0x6a, 0x51, 0x7b, 0xac, 0xcc, 0x24, 0x6a, 0x8e, 0x70,
+	0xe4, 0x80, 0x56, 0xe2, 0x8f, 0xe0, 0x08, 0x87, 0xbd, 0xa3, 0xbd, 0xad, 0x38, 0xad, 0x38, 0x71,
+	0x5a, 0x50, 0x7b, 0x40, 0xe2, 0xaf, 0x40, 0x1e, 0x8f, 0x27, 0x69, 0x7e, 0xb4, 0xe9, 0x85, 0x53,
+	0xec, 0xf7, 0xbe, 0xef, 0xbd, 0xf7, 0xbd, 0x99, 0xf7, 0x62, 0xb8, 0xdb, 0x63, 0x3c, 0x64, 0xdc,
+	0xa5, 0x6c, 0xe8, 0x0e, 0xf7, 0xba, 0x44, 0xe0, 0x96, 0x2b, 0xce, 0x9c, 0xb8, 0xcf, 0x04, 0x43,
+	0x28, 0x75, 0x3a, 0x94, 0x0d, 0x1d, 0xe5, 0xb4, 0x6c, 0x45, 0xe8, 0x62, 0x4e, 0x14, 0x63, 0xcf,
+	0xed, 0xb1, 0x20, 0x4a, 0x39, 0xd6, 0xce, 0x9c, 0x80, 0x09, 0x3f, 0xf5, 0x96, 0x29, 0xa3, 0x4c,
+	0x3e, 0xba, 0xc9, 0x93, 0xb2, 0x56, 0x52, 0xce, 0x51, 0xea, 0x50, 0x49, 0x95, 0x8b, 0x32, 0x46,
+	0x4f, 0x89, 0x2b, 0xdf, 0xba, 0x83, 0x63, 0x17, 0x47, 0x23, 0xe5, 0xaa, 0x4e, 0xbb, 0x44, 0x10,
+	0x12, 0x2e, 0x70, 0x18, 0x2b, 0xc0, 0x1d, 0x55, 0x4a, 0xc8, 0xa9, 0x3b, 0xdc, 0x4b, 0x7e, 0x52,
+	0x47, 0xfd, 0xc7, 0x1c, 0x6c, 0x77, 0x38, 0x7d, 0x32, 0xe8, 0x86, 0x81, 0x78, 0xdc, 0x67, 0x31,
+	0xe3, 0xf8, 0x14, 0xed, 0xc2, 0x5a, 0x48, 0x38, 0xc7, 0x94, 0x70, 0xd3, 0xa8, 0xe5, 0x1b, 0x1b,
+	0xad, 0xb2, 0x93, 0xa6, 0x70, 0xb2, 0x14, 0xce, 0xc3, 0x68, 0xe4, 0x69, 0x14, 0x3a, 0x84, 0xad,
+	0x20, 0x0a, 0x44, 0x80, 0x4f, 0x8f, 0x7c, 0x12, 0x33, 0x1e, 0x08, 0x33, 0x27, 0x89, 0x15, 0x47,
+	0x89, 0x48, 0xba, 0xa4, 0x5a, 0xb7, 0xe7, 0x1c, 0xb0, 0x20, 0x6a, 0x17, 0x5e, 0xbc, 0xaa, 0xae,
+	0x78, 0x25, 0xc5, 0xfb, 0x30, 0xa5, 0xa1, 0xb7, 0x61, 0x2d, 0x96, 0x75, 0x90, 0xbe, 0x99, 0xaf,
+	0x19, 0x8d, 0xf5, 0xb6, 0xf9, 0xc7, 0xb3, 0x66, 0x59, 0x45, 0x79, 0xe8, 0xfb, 0x7d, 0xc2, 0xf9,
+	0x13, 0xd1, 0x0f, 0x22, 0xea, 0x69, 0x24, 0xb2, 0x92, 0x8a, 0x05, 0xf6, 0xb1, 0xc0, 0x66, 0xa1,
+	0x66, 0x34, 0x36, 0x3d, 0xfd, 0x8e, 0x76, 0x60, 0x9d, 0x9c, 0xc5, 0xc4, 0x0f, 0x04, 0xf1, 0xcd,
+	0x5b, 0x35, 0xa3, 0xb1, 0xe6, 0x8d, 0x0d, 0xfb, 0xb7, 0xbf, 0xff, 0xe7, 0xd7, 0x37, 0x75, 0xa0,
+	0xfa, 0xfb, 0x50, 0x99, 0xe9, 0x87, 0x47, 0x78, 0xcc, 0x22, 0x4e, 0x50, 0x15, 0x36, 0x62, 0x65,
+	0x3b, 0x0a, 0x7c, 0xd3, 0xa8, 0x19, 0x8d, 0x82, 0x07, 0x99, 0xe9, 0x91, 0x5f, 0xff, 0xce, 0x80,
+	0x72, 0x87, 0xd3, 0x8f, 0xce, 0x48, 0xef, 0x13, 0x42, 0x71, 0x6f, 0x74, 0xc0, 0x22, 0x41, 0x22,
+	0x81, 0xde, 0x83, 0x62, 0x2f, 0x7d, 0x94, 0xac, 0x05, 0x0d, 0x6d, 0x6f, 0xfc, 0xfe, 0xac, 0x59,
+	0x54, 0x1c, 0x2f, 0x63, 0x24, 0x02, 0xf0, 0x40, 0x9c, 0xb0, 0x7e, 0x20, 0x46, 0x66, 0x2e, 0xe9,
+	0x89, 0x37, 0x36, 0xec, 0x97, 0x12, 0x01, 0xe3, 0xf7, 0xba, 0x0d, 0x3b, 0xf3, 0x4a, 0xc8, 0x44,
+	0xd4, 0x7f, 0x31, 0xa0, 0xd8, 0xe1, 0xf4, 0x4b, 0x26, 0x08, 0xda, 0x9d, 0x23, 0xa8, 0xbd, 0xf5,
+	0xef, 0xab, 0xea, 0xa4, 0x79, 0x52, 0x21, 0x72, 0xe0, 0xd6, 0x90, 0x09, 0xd2, 0x37, 0x73, 0xd7,
+	0x9c, 0x4d, 0x0a, 0x43, 0x0f, 0x60, 0x95, 0xc5, 0x22, 0x60, 0x91, 0x3c, 0xcc, 0x52, 0xcb, 0x76,
+	0x66, 0x27, 0xc9, 0x49, 0x6a, 0xf9, 0x54, 0xa2, 0x3c, 0x85, 0xde, 0x87, 0x44, 0x55, 0x1a, 0xa3,
+	0xbe, 0x0d, 0x5b, 0xaa, 0x60, 0x2d, 0xe2, 0xb9, 0xa1, 0x6d, 0x5f, 0x91, 0x80, 0x9e, 0x08, 0xe2,
+	0xff, 0x0f, 0x62, 0x3e, 0x80, 0x62, 0x5a, 0x1e, 0x37, 0xf3, 0xf2, 0x76, 0xdf, 0x9b, 0xa7, 0x26,
+	0x2b, 0x68, 0x42, 0x55, 0x46, 0xbb, 0x24, 0xab, 0x02, 0x77, 0xa6, 0x24, 0x68, 0x79, 0xbf, 0x19,
+	0x00, 0x1d, 0x4e, 0xb3, 0x99, 0xb8, 0xb9, 0xb2, 0x07, 0xb0, 0xae, 0xe6, 0x90, 0x5d, 0xaf, 0x6e,
+	0x0c, 0x45, 0xef, 0xc0, 0x2a, 0x0e, 0xd9, 0x20, 0x12, 0x66, 0x7e, 0xb9, 0xf1, 0x55, 0x70, 0x75,
+	0x0b, 0x75, 0xa0, 0x7a, 0x19, 0xd0, 0x58, 0x80, 0xd6, 0xf5, 0x83, 0x21, 0xd7, 0xcd, 0x01, 0x8e,
+	0x7a, 0xe4, 0x74, 0x62, 0xdd, 0xdc, 0x54, 0xde, 0xe4, 0x92, 0xc8, 0x2d, 0xbb, 0x24, 0xa6, 0x47,
+	0xfd, 0xb9, 0x01, 0x95, 0x99, 0x62, 0xf4, 0xac, 0xdf, 0xbc, 0xa8, 0x47, 0x70, 0xbb, 0x27, 0x63,
+	0x11, 0xff, 0x28, 0x59, 0xc0, 0xb2, 0xb2, 0x8d, 0x96, 0x35, 0x33, 0xe9, 0x9f, 0x67, 0xdb, 0xb9,
+	0xbd, 0x96, 0xf4, 0xf0, 0xe9, 0x5f, 0x55, 0xc3, 0xdb, 0xcc, 0xa8, 0x89, 0x13, 0xbd, 0x01, 0x5b,
+	0x3a, 0xd4, 0x89, 0xbc, 0x1c, 0x72, 0x7c, 0x0a, 0x5e, 0x29, 0x33, 0x1f, 0x4a, 0x6b, 0xfd, 0xa7,
+	0x74, 0x0e, 0xbe, 0x88, 0x7d, 0x2c, 0xc8, 0x63, 0xdc, 0xc7, 0x21, 0x4f, 0xce, 0x7e, 0xbc, 0x2e,
+	0x8c, 0xeb, 0xce, 0x5e, 0x43, 0xd1, 0xbb, 0xb0, 0x1a, 0xcb, 0x08, 0xba, 0xf0, 0x39, 0x97, 0x3b,
+	0xcd, 0x91, 0x1d, 0x7e, 0x8a, 0x9f, 0x59, 0x41, 0xe9, 0xcd, 0x9e, 0x2c, 0x2a, 0x6b, 0x6b, 0xeb,
+	0xe7, 0x5b, 0x90, 0xef, 0x70, 0x8a, 0x8e, 0xa1, 0x34, 0xf5, 0xa7, 0xf3, 0xfa, 0xbc, 0x74, 0x33,
+	0xbb, 0xd8, 0x6a, 0x2e, 0x05, 0xd3, 0xc7, 0xc8, 0x60, 0x7b, 0x76, 0x1b, 0x37, 0x16, 0xc4, 0x98,
+	0x41, 0x5a, 0xbb, 0xcb, 0x22, 0x75, 0xc2, 0x43, 0x28, 0xc8, 0xd5, 0x7a, 0x77, 0x01, 0x33, 0x71,
+	0x5a, 0xaf, 0x5d, 0xe1, 0xd4, 0x91, 0xbe, 0x81, 0xcd, 0x4b, 0xfb, 0xed, 0x2a, 0x52, 0x06, 0xb2,
+	0xee, 0x2f, 0x01, 0xd2, 0x19, 0x3e, 0x83, 0x62, 0xb6, 0x62, 0xec, 0x05, 0x3c, 0xe5, 0xb7, 0xee,
+	0x5d, 0xed, 0xd7, 0x21, 0x8f, 0xa1, 0x34, 0x35, 0xdd, 0x8b, 0xce, 0xf5, 0x32, 0xcc, 0x6a, 0x2e,
+	0x05, 0x9b, 0x6c, 0xce, 0xa5, 0x4b, 0xbf, 0xa8, 0x39, 0x93, 0x20, 0xeb, 0xfe, 0x12, 0xa0, 0x2c,
+	0x43, 0xfb, 0xe3, 0x17, 0xe7, 0xb6, 0xf1, 0xf2, 0xdc, 0x36, 0xfe, 0x3e, 0xb7, 0x8d, 0xa7, 0x17,
+	0xf6, 0xca, 0xcb, 0x0b, 0x7b, 0xe5, 0xcf, 0x0b, 0x7b, 0xe5, 0xeb, 0x5d, 0x1a, 0x88, 0x93, 0x41,
+	0xd7, 0xe9, 0xb1, 0x50, 0x7d, 0xa2, 0xa9, 0x9f, 0x26, 0xf7, 0xbf, 0x75, 0xcf, 0xe4, 0x07, 0x9f,
+	0x18, 0xc5, 0x84, 0x67, 0x9f, 0x7d, 0xdd, 0x55, 0x39, 0xfb, 0x6f, 0xfd, 0x37, 0x00, 0xd9, 0x32,
+	0x10, 0x7e, 0x64, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -624,6 +863,11 @@ type MsgClient interface {
 	VoteWeighted(ctx context.Context, in *MsgVoteWeighted, opts ...grpc.CallOption) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	// CancelProposal defines a method to cancel a proposal before the end of its voting period.
+	CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error)
+	// UpdateParams defines a governance operation for updating the x/gov module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -679,6 +923,24 @@ func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.Ca
 	return out, nil
 }
 
+func (c *msgClient) CancelProposal(ctx context.Context, in *MsgCancelProposal, opts ...grpc.CallOption) (*MsgCancelProposalResponse, error) {
+	out := new(MsgCancelProposalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta2.Msg/CancelProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.gov.v1beta2.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SubmitProposal defines a method to create new proposal given a content.
@@ -692,6 +954,11 @@ type MsgServer interface {
 	VoteWeighted(context.Context, *MsgVoteWeighted) (*MsgVoteWeightedResponse, error)
 	// Deposit defines a method to add deposit on a specific proposal.
 	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	// CancelProposal defines a method to cancel a proposal before the end of its voting period.
+	CancelProposal(context.Context, *MsgCancelProposal) (*MsgCancelProposalResponse, error)
+	// UpdateParams defines a governance operation for updating the x/gov module
+	// parameters. The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -713,6 +980,12 @@ func (*UnimplementedMsgServer) VoteWeighted(ctx context.Context, req *MsgVoteWei
 func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
 }
+func (*UnimplementedMsgServer) CancelProposal(ctx context.Context, req *MsgCancelProposal) (*MsgCancelProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelProposal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -808,6 +1081,42 @@ func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelProposal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta2.Msg/CancelProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelProposal(ctx, req.(*MsgCancelProposal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.gov.v1beta2.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.gov.v1beta2.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -832,6 +1141,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Deposit",
 			Handler:    _Msg_Deposit_Handler,
 		},
+		{
+			MethodName: "CancelProposal",
+			Handler:    _Msg_CancelProposal_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/gov/v1beta2/tx.proto",
@@ -857,6 +1174,16 @@ func (m *MsgSubmitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Expedited {
+		i--
+		if m.Expedited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.Metadata) > 0 {
 		i -= len(m.Metadata)
 		copy(dAtA[i:], m.Metadata)
@@ -1202,56 +1529,198 @@ func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCancelProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSubmitProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCancelProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Messages) > 0 {
-		for _, e := range m.Messages {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.InitialDeposit) > 0 {
-		for _, e := range m.InitialDeposit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProposalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCancelProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.CanceledHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CanceledHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CanceledTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CanceledTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
 	if m.ProposalId != 0 {
-		n += 1 + sovTx(uint64(m.ProposalId))
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSubmitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.InitialDeposit) > 0 {
+		for _, e := range m.InitialDeposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Expedited {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
 }
 
 func (m *MsgExecLegacyContent) Size() (n int) {
@@ -1370,6 +1839,63 @@ func (m *MsgDepositResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CanceledTime)
+	n += 1 + l + sovTx(uint64(l))
+	if m.CanceledHeight != 0 {
+		n += 1 + sovTx(uint64(m.CanceledHeight))
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1539,6 +2065,26 @@ func (m *MsgSubmitProposal) Unmarshal(dAtA []byte) error {
 				m.Metadata = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expedited", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Expedited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2337,6 +2883,393 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CanceledTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanceledHeight", wireType)
+			}
+			m.CanceledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CanceledHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
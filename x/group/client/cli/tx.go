@@ -21,6 +21,7 @@ import (
 const (
 	FlagExec = "exec"
 	ExecTry  = "try"
+	ExecAuto = "auto"
 )
 
 // TxCmd returns a root CLI command handler for all x/group transaction commands.
@@ -45,6 +46,7 @@ func TxCmd(name string) *cobra.Command {
 		MsgCreateProposalCmd(),
 		MsgVoteCmd(),
 		MsgExecCmd(),
+		MsgLeaveGroupCmd(),
 	)
 
 	return txCmd
@@ -299,6 +301,12 @@ Note, the '--from' flag is ignored as it is implied from [admin].
 Example:
 $ %s tx group create-group-policy [admin] [group-id] [metadata] \
 '{"@type":"/cosmos.group.v1beta1.ThresholdDecisionPolicy", "threshold":"1", "timeout":"1s"}'
+
+The decision policy can also be a percentage of the total group weight:
+'{"@type":"/cosmos.group.v1beta1.PercentageDecisionPolicy", "percentage":"0.5", "timeout":"1s"}'
+
+or a quorum and veto tally similar to x/gov:
+'{"@type":"/cosmos.group.v1beta1.QuorumDecisionPolicy", "quorum":"0.4", "threshold":"0.5", "veto_threshold":"0.334", "timeout":"1s"}'
 `,
 				version.AppName,
 			),
@@ -392,7 +400,18 @@ func MsgUpdateGroupPolicyDecisionPolicyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-group-policy-decision-policy [admin] [group-policy-account] [decision-policy]",
 		Short: "Update a group policy's decision policy",
-		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update a group policy's decision policy.
+Note, the '--from' flag is ignored as it is implied from [admin].
+
+Example:
+$ %s tx group update-group-policy-decision-policy [admin] [group-policy-account] \
+'{"@type":"/cosmos.group.v1beta1.PercentageDecisionPolicy", "percentage":"0.5", "timeout":"1s"}'
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			err := cmd.Flags().Set(flags.FlagFrom, args[0])
 			if err != nil {
@@ -533,7 +552,7 @@ Parameters:
 		},
 	}
 
-	cmd.Flags().String(FlagExec, "", "Set to 1 to try to execute proposal immediately after creation (proposers signatures are considered as Yes votes)")
+	cmd.Flags().String(FlagExec, "", "Set to \"try\" to try to execute proposal immediately after creation (proposers signatures are considered as Yes votes), or to \"auto\" to execute it automatically at the end of its voting period if it's accepted")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -656,7 +675,7 @@ Parameters:
 		},
 	}
 
-	cmd.Flags().String(FlagExec, "", "Set to 1 to try to execute proposal immediately after voting")
+	cmd.Flags().String(FlagExec, "", "Set to \"try\" to try to execute proposal immediately after voting, or to \"auto\" to execute it automatically at the end of its voting period if it's accepted")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -699,3 +718,52 @@ func MsgExecCmd() *cobra.Command {
 
 	return cmd
 }
+
+// MsgLeaveGroupCmd creates a CLI command for Msg/LeaveGroup.
+func MsgLeaveGroupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leave-group [member-address] [group-id]",
+		Short: "Remove a member from a group. Note, the '--from' flag is ignored as it is implied from [member-address].",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Remove a member from a group. Note, the '--from' flag is ignored as it is implied from [member-address].
+The group policies of the group must remain valid with the new total weight of the group.
+
+Example:
+$ %s tx group leave-group [member-address] [group-id]
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := cmd.Flags().Set(flags.FlagFrom, args[0])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			groupID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &group.MsgLeaveGroup{
+				Address: clientCtx.GetFromAddress().String(),
+				GroupId: groupID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return fmt.Errorf("message validation failed: %w", err)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -33,6 +33,8 @@ func execFromString(execStr string) group.Exec {
 	switch execStr {
 	case ExecTry:
 		exec = group.Exec_EXEC_TRY
+	case ExecAuto:
+		exec = group.Exec_EXEC_AUTO
 	}
 	return exec
 }
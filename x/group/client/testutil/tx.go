@@ -728,6 +728,38 @@ func (s *IntegrationTestSuite) TestTxCreateGroupPolicy() {
 			&sdk.TxResponse{},
 			0,
 		},
+		{
+			"percentage decision policy",
+			append(
+				[]string{
+					val.Address.String(),
+					fmt.Sprintf("%v", groupID),
+					validMetadata,
+					"{\"@type\":\"/cosmos.group.v1beta1.PercentageDecisionPolicy\", \"percentage\":\"0.5\", \"timeout\":\"1s\"}",
+				},
+				commonFlags...,
+			),
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"quorum decision policy",
+			append(
+				[]string{
+					val.Address.String(),
+					fmt.Sprintf("%v", groupID),
+					validMetadata,
+					"{\"@type\":\"/cosmos.group.v1beta1.QuorumDecisionPolicy\", \"quorum\":\"0.4\", \"threshold\":\"0.5\", \"veto_threshold\":\"0.334\", \"timeout\":\"1s\"}",
+				},
+				commonFlags...,
+			),
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
 		{
 			"wrong admin",
 			append(
@@ -1787,6 +1819,158 @@ func (s *IntegrationTestSuite) TestTxExec() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestTxLeaveGroup() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+
+	var commonFlags = []string{
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+
+	// create a new funded account
+	info, _, err := clientCtx.Keyring.NewMnemonic("leaveGroupMember", keyring.English, sdk.FullFundraiserPath, keyring.DefaultBIP39Passphrase, hd.Secp256k1)
+	s.Require().NoError(err)
+	pk, err := info.GetPubKey()
+	s.Require().NoError(err)
+	member := sdk.AccAddress(pk.Address())
+	_, err = banktestutil.MsgSendExec(
+		clientCtx,
+		val.Address,
+		member,
+		sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(2000))),
+		commonFlags...,
+	)
+	s.Require().NoError(err)
+
+	// create a group with the new account as member
+	validMembers := fmt.Sprintf(`
+	{
+		"members": [
+			{
+				"address": "%s",
+				"weight": "2",
+				"metadata": "%s"
+			},
+			{
+				"address": "%s",
+				"weight": "1",
+				"metadata": "%s"
+			}
+		]
+	}`, val.Address.String(), validMetadata, member.String(), validMetadata)
+	validMembersFile := testutil.WriteToNewTempFile(s.T(), validMembers)
+	out, err := cli.ExecTestCLICmd(clientCtx, client.MsgCreateGroupCmd(),
+		append(
+			[]string{
+				val.Address.String(),
+				validMetadata,
+				validMembersFile.Name(),
+			},
+			commonFlags...,
+		),
+	)
+	s.Require().NoError(err, out.String())
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	var groupID string
+	createGroupEvent, _ := sdk.TypedEventToEvent(&group.EventCreateGroup{})
+	for _, e := range txResp.Logs[0].Events {
+		if e.Type == createGroupEvent.Type {
+			groupID = strings.ReplaceAll(e.Attributes[0].Value, "\"", "")
+		}
+	}
+	s.Require().NotEmpty(groupID)
+
+	testCases := []struct {
+		name         string
+		args         []string
+		expectErr    bool
+		expectErrMsg string
+		respType     proto.Message
+		expectedCode uint32
+	}{
+		{
+			"invalid member address",
+			append(
+				[]string{
+					"address",
+					groupID,
+				},
+				commonFlags...,
+			),
+			true,
+			"key not found",
+			nil,
+			0,
+		},
+		{
+			"invalid group id",
+			append(
+				[]string{
+					member.String(),
+					"abcd",
+				},
+				commonFlags...,
+			),
+			true,
+			"invalid syntax",
+			nil,
+			0,
+		},
+		{
+			"correct data",
+			append(
+				[]string{
+					member.String(),
+					groupID,
+				},
+				commonFlags...,
+			),
+			false,
+			"",
+			&sdk.TxResponse{},
+			0,
+		},
+		{
+			"not a group member anymore",
+			append(
+				[]string{
+					member.String(),
+					groupID,
+				},
+				commonFlags...,
+			),
+			true,
+			"not in group",
+			nil,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := client.MsgLeaveGroupCmd()
+
+			out, err := cli.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Contains(out.String(), tc.expectErrMsg)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+
+				txResp := tc.respType.(*sdk.TxResponse)
+				s.Require().Equal(tc.expectedCode, txResp.Code, out.String())
+			}
+		})
+	}
+}
+
 func getTxSendFileName(s *IntegrationTestSuite, from string, to string) string {
 	tx := fmt.Sprintf(
 		`{"body":{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%s","to_address":"%s","amount":[{"denom":"%s","amount":"10"}]}],"memo":"","timeout_height":"0","extension_options":[],"non_critical_extension_options":[]},"auth_info":{"signer_infos":[],"fee":{"amount":[],"gas_limit":"200000","payer":"","granter":""}},"signatures":[]}`,
@@ -24,6 +24,9 @@ func TestDec(t *testing.T) {
 	t.Run("TestSubRightIdentity", rapid.MakeCheck(testSubRightIdentity))
 	t.Run("TestSubZero", rapid.MakeCheck(testSubZero))
 
+	// Properties about multiplication
+	t.Run("TestMulCommutative", rapid.MakeCheck(testMulCommutative))
+
 	// Properties combining operations
 	t.Run("TestSubAdd", rapid.MakeCheck(testSubAdd))
 	t.Run("TestAddSub", rapid.MakeCheck(testAddSub))
@@ -75,6 +78,18 @@ func TestDec(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, res.IsEqual(minusFivePointZero))
 
+	res, err = onePointOneFive.Mul(two)
+	require.NoError(t, err)
+	require.Equal(t, "2.30", res.String())
+
+	res, err = minusOne.Mul(twoPointThreeFour)
+	require.NoError(t, err)
+	require.Equal(t, "-2.34", res.String())
+
+	res, err = onePointOneFive.Mul(zero)
+	require.NoError(t, err)
+	require.True(t, res.IsZero())
+
 	require.False(t, zero.IsNegative())
 	require.False(t, one.IsNegative())
 	require.True(t, minusOne.IsNegative())
@@ -146,6 +161,20 @@ func testAddCommutative(t *rapid.T) {
 	require.True(t, c.IsEqual(d))
 }
 
+// Property: a * b == b * a
+func testMulCommutative(t *rapid.T) {
+	a := genDec.Draw(t, "a").(Dec)
+	b := genDec.Draw(t, "b").(Dec)
+
+	c, err := a.Mul(b)
+	require.NoError(t, err)
+
+	d, err := b.Mul(a)
+	require.NoError(t, err)
+
+	require.True(t, c.IsEqual(d))
+}
+
 // Property: (a + b) + c == a + (b + c)
 func testAddAssociative(t *rapid.T) {
 	a := genDec.Draw(t, "a").(Dec)
@@ -1,14 +1,24 @@
 package keeper
 
+import "time"
+
 // Config is a config struct used for intialising the group module to avoid using globals.
 type Config struct {
 	// MaxMetadataLen defines the max length of the metadata bytes field for various entities within the group module. Defaults to 255 if not explicitly set.
 	MaxMetadataLen uint64
+	// MaxExecutionPeriod defines the max duration after a proposal's voting period ends that members can send a MsgExec
+	// to execute the proposal, after which the proposal and its votes are pruned. Defaults to 2 weeks if not explicitly set.
+	MaxExecutionPeriod time.Duration
+	// MaxExecutionGas defines the max amount of gas the automatic execution of a proposal by the EndBlocker can consume,
+	// after which the execution fails. Defaults to 10,000,000 if not explicitly set.
+	MaxExecutionGas uint64
 }
 
 // DefaultConfig returns the default config for group.
 func DefaultConfig() Config {
 	return Config{
-		MaxMetadataLen: 255,
+		MaxMetadataLen:     255,
+		MaxExecutionPeriod: 2 * 7 * 24 * time.Hour, // 2 weeks
+		MaxExecutionGas:    10_000_000,
 	}
 }
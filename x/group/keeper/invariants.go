@@ -85,14 +85,20 @@ func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, key storety
 		return msg, broken
 	}
 
+	// proposals are matched by ID, as the pruned proposals are missing from the current ones
+	curProposalsByID := make(map[uint64]*group.Proposal, len(curProposals))
+	for _, p := range curProposals {
+		curProposalsByID[p.ProposalId] = p
+	}
+
 	for i := 0; i < len(prevProposals); i++ {
-		if prevProposals[i].ProposalId == curProposals[i].ProposalId {
+		if curProposal, ok := curProposalsByID[prevProposals[i].ProposalId]; ok {
 			prevYesCount, err := prevProposals[i].VoteState.GetYesCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting yes votes weight of proposal at block height %d\n%v\n", prevCtx.BlockHeight(), err)
 				return msg, broken
 			}
-			curYesCount, err := curProposals[i].VoteState.GetYesCount()
+			curYesCount, err := curProposal.VoteState.GetYesCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting yes votes weight of proposal at block height %d\n%v\n", ctx.BlockHeight(), err)
 				return msg, broken
@@ -102,7 +108,7 @@ func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, key storety
 				msg += fmt.Sprintf("error while getting no votes weight of proposal at block height %d\n%v\n", prevCtx.BlockHeight(), err)
 				return msg, broken
 			}
-			curNoCount, err := curProposals[i].VoteState.GetNoCount()
+			curNoCount, err := curProposal.VoteState.GetNoCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting no votes weight of proposal at block height %d\n%v\n", ctx.BlockHeight(), err)
 				return msg, broken
@@ -112,7 +118,7 @@ func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, key storety
 				msg += fmt.Sprintf("error while getting abstain votes weight of proposal at block height %d\n%v\n", prevCtx.BlockHeight(), err)
 				return msg, broken
 			}
-			curAbstainCount, err := curProposals[i].VoteState.GetAbstainCount()
+			curAbstainCount, err := curProposal.VoteState.GetAbstainCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting abstain votes weight of proposal at block height %d\n%v\n", ctx.BlockHeight(), err)
 				return msg, broken
@@ -122,7 +128,7 @@ func TallyVotesInvariantHelper(ctx sdk.Context, prevCtx sdk.Context, key storety
 				msg += fmt.Sprintf("error while getting veto votes weight of proposal at block height %d\n%v\n", prevCtx.BlockHeight(), err)
 				return msg, broken
 			}
-			curVetoCount, err := curProposals[i].VoteState.GetVetoCount()
+			curVetoCount, err := curProposal.VoteState.GetVetoCount()
 			if err != nil {
 				msg += fmt.Sprintf("error while getting veto votes weight of proposal at block height %d\n%v\n", ctx.BlockHeight(), err)
 				return msg, broken
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -35,6 +36,7 @@ const (
 	ProposalTableSeqPrefix           byte = 0x31
 	ProposalByGroupPolicyIndexPrefix byte = 0x32
 	ProposalByProposerIndexPrefix    byte = 0x33
+	ProposalsByProcessTimePrefix     byte = 0x34
 
 	// Vote Table
 	VoteTablePrefix           byte = 0x40
@@ -66,6 +68,7 @@ type Keeper struct {
 	proposalTable              orm.AutoUInt64Table
 	proposalByGroupPolicyIndex orm.Index
 	proposalByProposerIndex    orm.Index
+	proposalsByProcessTime     orm.Index
 
 	// Vote Table
 	voteTable           orm.PrimaryKeyTable
@@ -181,6 +184,12 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *authmiddle
 	if err != nil {
 		panic(err.Error())
 	}
+	k.proposalsByProcessTime, err = orm.NewIndex(proposalTable, ProposalsByProcessTimePrefix, func(value interface{}) ([]interface{}, error) {
+		return []interface{}{sdk.FormatTimeBytes(proposalProcessTime(value.(*group.Proposal)))}, nil
+	}, []byte{})
+	if err != nil {
+		panic(err.Error())
+	}
 	k.proposalTable = *proposalTable
 
 	// Vote Table
@@ -209,6 +218,12 @@ func NewKeeper(storeKey storetypes.StoreKey, cdc codec.Codec, router *authmiddle
 	if config.MaxMetadataLen == 0 {
 		config.MaxMetadataLen = DefaultConfig().MaxMetadataLen
 	}
+	if config.MaxExecutionPeriod == 0 {
+		config.MaxExecutionPeriod = DefaultConfig().MaxExecutionPeriod
+	}
+	if config.MaxExecutionGas == 0 {
+		config.MaxExecutionGas = DefaultConfig().MaxExecutionGas
+	}
 	k.config = config
 
 	return k
@@ -227,3 +242,143 @@ func (k Keeper) MaxMetadataLength() uint64 { return k.config.MaxMetadataLen }
 func (k Keeper) GetGroupSequence(ctx sdk.Context) uint64 {
 	return k.groupTable.Sequence().CurVal(ctx.KVStore(k.key))
 }
+
+// proposalProcessTime returns the time at which the group EndBlocker processes the proposal: the end of its voting
+// period, then its process time if it is kept after the end of its voting period and hasn't been executed
+// successfully since.
+func proposalProcessTime(proposal *group.Proposal) time.Time {
+	if proposal.ProcessTime.IsZero() || proposal.ExecutorResult == group.ProposalExecutorResultSuccess {
+		return proposal.Timeout
+	}
+	return proposal.ProcessTime
+}
+
+// ProcessProposalsAtVotingPeriodEnd tallies the final result of the proposals whose voting period has ended,
+// executes the accepted ones which opted in for automatic execution, and prunes the proposals, with their votes,
+// which can't be executed anymore: the rejected, aborted or withdrawn ones, the ones executed successfully, and the
+// ones whose max execution period has elapsed. The accepted proposals which are kept are only processed again when
+// they can be executed automatically or pruned, or once they have been executed successfully.
+func (k Keeper) ProcessProposalsAtVotingPeriodEnd(ctx sdk.Context) error {
+	// the end of the scan is exclusive, the proposals whose process time is the block time are included
+	it, err := k.proposalsByProcessTime.PrefixScan(ctx.KVStore(k.key), nil, sdk.FormatTimeBytes(ctx.BlockTime().Add(time.Nanosecond)))
+	if err != nil {
+		return err
+	}
+	var proposals []*group.Proposal
+	if _, err := orm.ReadAll(it, &proposals); err != nil {
+		return err
+	}
+
+	for _, proposal := range proposals {
+		prune, err := k.processProposalAtVotingPeriodEnd(ctx, proposal)
+		if err != nil {
+			return err
+		}
+
+		if prune {
+			if err := k.pruneProposal(ctx, proposal.ProposalId); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := k.proposalTable.Update(ctx.KVStore(k.key), proposal.ProposalId, proposal); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// processProposalAtVotingPeriodEnd tallies and executes the proposal if needed, and returns whether it can be
+// pruned.
+func (k Keeper) processProposalAtVotingPeriodEnd(ctx sdk.Context, proposal *group.Proposal) (bool, error) {
+	if proposal.Status != group.ProposalStatusSubmitted && proposal.Status != group.ProposalStatusClosed {
+		return true, nil
+	}
+
+	policyInfo, err := k.getGroupPolicyInfo(ctx, proposal.Address)
+	if err != nil {
+		return false, err
+	}
+
+	if proposal.Status == group.ProposalStatusSubmitted {
+		if err := k.doTallyOrAbort(ctx, proposal, policyInfo); err != nil {
+			return false, err
+		}
+	}
+
+	if proposal.Status != group.ProposalStatusClosed || proposal.Result != group.ProposalResultAccepted {
+		return true, nil
+	}
+	if proposal.ExecutorResult == group.ProposalExecutorResultSuccess {
+		return true, nil
+	}
+	pruneTime := proposal.Timeout.Add(k.config.MaxExecutionPeriod)
+	if !ctx.BlockTime().Before(pruneTime) {
+		return true, nil
+	}
+
+	// The proposal is kept until it can be pruned, unless it must still be executed automatically.
+	proposal.ProcessTime = pruneTime
+	// Automatic executions are only tried once, the failed ones can still be retried with a MsgExec.
+	if proposal.AutoExec && proposal.ExecutorResult == group.ProposalExecutorResultNotRun {
+		executableAt, err := proposalExecutableAt(*proposal, policyInfo)
+		if err != nil {
+			return false, err
+		}
+		if ctx.BlockTime().Before(executableAt) {
+			if executableAt.Before(pruneTime) {
+				proposal.ProcessTime = executableAt
+			}
+			return false, nil
+		}
+
+		if err := k.safeExecuteProposal(ctx, proposal, policyInfo); err != nil {
+			return false, err
+		}
+		if err := ctx.EventManager().EmitTypedEvent(&group.EventExec{ProposalId: proposal.ProposalId}); err != nil {
+			return false, err
+		}
+	}
+
+	return proposal.ExecutorResult == group.ProposalExecutorResultSuccess, nil
+}
+
+// safeExecuteProposal executes the proposal automatically. The execution is bounded by the max execution gas config,
+// and a message panicking, e.g. because it ran out of gas, only fails the proposal instead of halting the chain.
+func (k Keeper) safeExecuteProposal(ctx sdk.Context, proposal *group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(k.config.MaxExecutionGas))
+	defer func() {
+		if r := recover(); r != nil {
+			proposal.ExecutorResult = group.ProposalExecutorResultFailure
+			k.Logger(ctx).Info("proposal execution failed", "cause", r, "proposalID", proposal.ProposalId)
+		}
+	}()
+
+	return k.doExecuteProposal(ctx, proposal, policyInfo)
+}
+
+// pruneProposal deletes the proposal and its votes.
+func (k Keeper) pruneProposal(ctx sdk.Context, proposalID uint64) error {
+	store := ctx.KVStore(k.key)
+
+	it, err := k.voteByProposalIndex.Get(store, proposalID)
+	if err != nil {
+		return err
+	}
+	var votes []*group.Vote
+	if _, err := orm.ReadAll(it, &votes); err != nil {
+		return err
+	}
+	for _, vote := range votes {
+		if err := k.voteTable.Delete(store, vote); err != nil {
+			return err
+		}
+	}
+
+	if err := k.proposalTable.Delete(store, proposalID); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&group.EventProposalPruned{ProposalId: proposalID})
+}
@@ -15,6 +15,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authmiddleware "github.com/cosmos/cosmos-sdk/x/auth/middleware"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/cosmos/cosmos-sdk/x/bank/testutil"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
@@ -705,6 +707,94 @@ func (s *TestSuite) TestUpdateGroupMembers() {
 	}
 }
 
+func (s *TestSuite) TestLeaveGroup() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+	addr4 := addrs[3]
+	addr5 := addrs[4]
+
+	specs := map[string]struct {
+		req        *group.MsgLeaveGroup
+		expErr     bool
+		expGroup   *group.GroupInfo
+		expMembers []*group.GroupMember
+	}{
+		"group not found": {
+			req: &group.MsgLeaveGroup{
+				GroupId: 100,
+				Address: addr5.String(),
+			},
+			expErr: true,
+		},
+		"not a group member": {
+			req: &group.MsgLeaveGroup{
+				GroupId: s.groupID,
+				Address: addr4.String(),
+			},
+			expErr: true,
+		},
+		"policy threshold greater than the new total weight": {
+			req: &group.MsgLeaveGroup{
+				GroupId: s.groupID,
+				Address: addr2.String(),
+			},
+			expErr: true,
+		},
+		"member leaves": {
+			req: &group.MsgLeaveGroup{
+				GroupId: s.groupID,
+				Address: addr5.String(),
+			},
+			expGroup: &group.GroupInfo{
+				GroupId:     s.groupID,
+				Admin:       addr1.String(),
+				TotalWeight: "2",
+				Version:     2,
+				CreatedAt:   s.blockTime,
+			},
+			expMembers: []*group.GroupMember{
+				{
+					GroupId: s.groupID,
+					Member: &group.Member{
+						Address: addr2.String(),
+						Weight:  "2",
+					},
+				},
+			},
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			ctx := sdk.WrapSDKContext(sdkCtx)
+			_, err := s.keeper.LeaveGroup(ctx, spec.req)
+			if spec.expErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			// then
+			res, err := s.keeper.GroupInfo(ctx, &group.QueryGroupInfoRequest{GroupId: s.groupID})
+			s.Require().NoError(err)
+			s.Assert().Equal(spec.expGroup, res.Info)
+
+			// and members persisted
+			membersRes, err := s.keeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: s.groupID})
+			s.Require().NoError(err)
+			loadedMembers := membersRes.Members
+			s.Require().Equal(len(spec.expMembers), len(loadedMembers))
+			for i := range loadedMembers {
+				s.Assert().Equal(spec.expMembers[i].Member.Address, loadedMembers[i].Member.Address)
+				s.Assert().Equal(spec.expMembers[i].Member.Weight, loadedMembers[i].Member.Weight)
+				s.Assert().Equal(spec.expMembers[i].GroupId, loadedMembers[i].GroupId)
+			}
+		})
+	}
+}
+
 func (s *TestSuite) TestCreateGroupPolicy() {
 	addrs := s.addrs
 	addr1 := addrs[0]
@@ -2035,6 +2125,20 @@ func (s *TestSuite) TestExecProposal() {
 			expProposalResult: group.ProposalResultUnfinalized,
 			expExecutorResult: group.ProposalExecutorResultNotRun,
 		},
+		"with group member left before tally": {
+			setupProposal: func(ctx context.Context) uint64 {
+				myProposalID := createProposal(ctx, s, []sdk.Msg{msgSend1}, proposers)
+				_, err := s.keeper.LeaveGroup(ctx, &group.MsgLeaveGroup{
+					Address: addrs[4].String(),
+					GroupId: s.groupID,
+				})
+				s.Require().NoError(err)
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusAborted,
+			expProposalResult: group.ProposalResultUnfinalized,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
 		"prevent double execution when successful": {
 			setupProposal: func(ctx context.Context) uint64 {
 				myProposalID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend1}, proposers, group.Choice_CHOICE_YES)
@@ -2059,6 +2163,16 @@ func (s *TestSuite) TestExecProposal() {
 			expProposalResult: group.ProposalResultAccepted,
 			expExecutorResult: group.ProposalExecutorResultFailure,
 		},
+		"proposal not executed when its messages are disabled by the circuit breaker": {
+			setupProposal: func(ctx context.Context) uint64 {
+				myProposalID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend1}, proposers, group.Choice_CHOICE_YES)
+				s.app.CircuitKeeper.DisableMsg(sdk.UnwrapSDKContext(ctx), sdk.MsgTypeURL(msgSend1))
+				return myProposalID
+			},
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultFailure,
+		},
 		"executable when failed before": {
 			setupProposal: func(ctx context.Context) uint64 {
 				msgs := []sdk.Msg{msgSend2}
@@ -2183,3 +2297,266 @@ func createGroupAndGroupPolicy(
 
 	return groupPolicyRes.Address, myGroupID, policy
 }
+
+func (s *TestSuite) TestProcessProposalsAtVotingPeriodEnd() {
+	addrs := s.addrs
+	addr2 := addrs[1]
+	addr5 := addrs[4]
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	votingPeriodEnd := s.blockTime.Add(time.Second)
+	maxExecutionPeriod := keeper.DefaultConfig().MaxExecutionPeriod
+
+	specs := map[string]struct {
+		setupProposal     func(ctx context.Context) uint64
+		blockTime         time.Time
+		expPruned         bool
+		expProposalStatus group.Proposal_Status
+		expProposalResult group.Proposal_Result
+		expExecutorResult group.Proposal_ExecutorResult
+		expToBalances     sdk.Coin
+	}{
+		"proposal not processed before voting period end": {
+			setupProposal: func(ctx context.Context) uint64 {
+				return createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{addr5.String()}, group.Choice_CHOICE_YES)
+			},
+			blockTime:         s.blockTime,
+			expProposalStatus: group.ProposalStatusSubmitted,
+			expProposalResult: group.ProposalResultUnfinalized,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"open proposal tallied and pruned when rejected": {
+			setupProposal: func(ctx context.Context) uint64 {
+				return createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{addr5.String()}, group.Choice_CHOICE_YES)
+			},
+			blockTime: votingPeriodEnd,
+			expPruned: true,
+		},
+		"rejected proposal pruned": {
+			setupProposal: func(ctx context.Context) uint64 {
+				return createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{addr2.String()}, group.Choice_CHOICE_NO)
+			},
+			blockTime: votingPeriodEnd,
+			expPruned: true,
+		},
+		"withdrawn proposal pruned": {
+			setupProposal: func(ctx context.Context) uint64 {
+				id := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{addr2.String()})
+				_, err := s.keeper.WithdrawProposal(ctx, &group.MsgWithdrawProposal{ProposalId: id, Address: addr2.String()})
+				s.Require().NoError(err)
+				return id
+			},
+			blockTime: votingPeriodEnd,
+			expPruned: true,
+		},
+		"accepted proposal kept for execution": {
+			setupProposal: func(ctx context.Context) uint64 {
+				return createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{addr2.String()}, group.Choice_CHOICE_YES)
+			},
+			blockTime:         votingPeriodEnd,
+			expProposalStatus: group.ProposalStatusClosed,
+			expProposalResult: group.ProposalResultAccepted,
+			expExecutorResult: group.ProposalExecutorResultNotRun,
+		},
+		"accepted proposal pruned after max execution period": {
+			setupProposal: func(ctx context.Context) uint64 {
+				return createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{addr2.String()}, group.Choice_CHOICE_YES)
+			},
+			blockTime: votingPeriodEnd.Add(maxExecutionPeriod),
+			expPruned: true,
+		},
+		"accepted proposal executed automatically when created with auto exec": {
+			setupProposal: func(ctx context.Context) uint64 {
+				proposalReq := &group.MsgCreateProposal{
+					Address:   s.groupPolicyAddr.String(),
+					Proposers: []string{addr2.String()},
+					Exec:      group.Exec_EXEC_AUTO,
+				}
+				s.Require().NoError(proposalReq.SetMsgs([]sdk.Msg{msgSend}))
+				res, err := s.keeper.CreateProposal(ctx, proposalReq)
+				s.Require().NoError(err)
+				_, err = s.keeper.Vote(ctx, &group.MsgVote{ProposalId: res.ProposalId, Voter: addr2.String(), Choice: group.Choice_CHOICE_YES})
+				s.Require().NoError(err)
+				return res.ProposalId
+			},
+			blockTime:     votingPeriodEnd,
+			expPruned:     true,
+			expToBalances: sdk.NewInt64Coin("test", 100),
+		},
+		"accepted proposal executed automatically when voted with auto exec": {
+			setupProposal: func(ctx context.Context) uint64 {
+				id := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{addr2.String()})
+				_, err := s.keeper.Vote(ctx, &group.MsgVote{ProposalId: id, Voter: addr2.String(), Choice: group.Choice_CHOICE_YES, Exec: group.Exec_EXEC_AUTO})
+				s.Require().NoError(err)
+				return id
+			},
+			blockTime:     votingPeriodEnd,
+			expPruned:     true,
+			expToBalances: sdk.NewInt64Coin("test", 100),
+		},
+	}
+	for msg, spec := range specs {
+		spec := spec
+		s.Run(msg, func() {
+			sdkCtx, _ := s.sdkCtx.CacheContext()
+			proposalID := spec.setupProposal(sdk.WrapSDKContext(sdkCtx))
+
+			sdkCtx = sdkCtx.WithBlockTime(spec.blockTime)
+			s.Require().NoError(s.keeper.ProcessProposalsAtVotingPeriodEnd(sdkCtx))
+			ctx := sdk.WrapSDKContext(sdkCtx)
+
+			res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+			if spec.expPruned {
+				s.Require().Error(err)
+
+				votesRes, err := s.keeper.VotesByProposal(ctx, &group.QueryVotesByProposalRequest{ProposalId: proposalID})
+				s.Require().NoError(err)
+				s.Require().Empty(votesRes.Votes)
+			} else {
+				s.Require().NoError(err)
+				s.Assert().Equal(spec.expProposalStatus, res.Proposal.Status)
+				s.Assert().Equal(spec.expProposalResult, res.Proposal.Result)
+				s.Assert().Equal(spec.expExecutorResult, res.Proposal.ExecutorResult)
+			}
+
+			if !spec.expToBalances.IsNil() {
+				toBalances := s.app.BankKeeper.GetAllBalances(sdkCtx, addr2)
+				s.Require().Contains(toBalances, spec.expToBalances)
+			}
+		})
+	}
+}
+
+func (s *TestSuite) TestProcessProposalsAfterVotingPeriodEnd() {
+	addr2 := s.addrs[1]
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposalID := createProposalAndVote(ctx, s, []sdk.Msg{msgSend}, []string{addr2.String()}, group.Choice_CHOICE_YES)
+	votingPeriodEnd := s.blockTime.Add(time.Second)
+
+	// the accepted proposal is kept until its max execution period has elapsed
+	sdkCtx = sdkCtx.WithBlockTime(votingPeriodEnd)
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	s.Require().NoError(s.keeper.ProcessProposalsAtVotingPeriodEnd(sdkCtx))
+	res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalResultAccepted, res.Proposal.Result)
+	s.Require().Equal(votingPeriodEnd.Add(keeper.DefaultConfig().MaxExecutionPeriod), res.Proposal.ProcessTime)
+
+	// it isn't processed again before that
+	sdkCtx = sdkCtx.WithBlockTime(votingPeriodEnd.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	s.Require().NoError(s.keeper.ProcessProposalsAtVotingPeriodEnd(sdkCtx))
+	s.Require().Empty(sdkCtx.EventManager().Events())
+	res, err = s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(votingPeriodEnd.Add(keeper.DefaultConfig().MaxExecutionPeriod), res.Proposal.ProcessTime)
+
+	// unless it is executed successfully, in which case it is pruned
+	_, err = s.keeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Signer: addr2.String()})
+	s.Require().NoError(err)
+	s.Require().NoError(s.keeper.ProcessProposalsAtVotingPeriodEnd(sdkCtx))
+	_, err = s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().Error(err)
+	s.Require().Contains(s.app.BankKeeper.GetAllBalances(sdkCtx, addr2), sdk.NewInt64Coin("test", 100))
+}
+
+func (s *TestSuite) TestAutoExecGasLimit() {
+	addr2 := s.addrs[1]
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := sdk.WrapSDKContext(sdkCtx)
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposalID := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{addr2.String()})
+	_, err := s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: addr2.String(), Choice: group.Choice_CHOICE_YES, Exec: group.Exec_EXEC_AUTO})
+	s.Require().NoError(err)
+
+	// the automatic execution runs out of gas, which fails the proposal instead of panicking
+	router := authmiddleware.NewMsgServiceRouter(s.app.InterfaceRegistry())
+	banktypes.RegisterMsgServer(router, bankkeeper.NewMsgServerImpl(s.app.BankKeeper))
+	k := keeper.NewKeeper(s.app.GetKey(group.StoreKey), s.app.AppCodec(), router, s.app.AccountKeeper, keeper.Config{MaxExecutionGas: 1})
+	sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(time.Second))
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	s.Require().NotPanics(func() { s.Require().NoError(k.ProcessProposalsAtVotingPeriodEnd(sdkCtx)) })
+	res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalExecutorResultFailure, res.Proposal.ExecutorResult)
+	s.Require().NotContains(s.app.BankKeeper.GetAllBalances(sdkCtx, addr2), sdk.NewInt64Coin("test", 100))
+
+	// it can still be executed with a MsgExec
+	_, err = s.keeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Signer: addr2.String()})
+	s.Require().NoError(err)
+	s.Require().Contains(s.app.BankKeeper.GetAllBalances(sdkCtx, addr2), sdk.NewInt64Coin("test", 100))
+}
+
+func (s *TestSuite) TestMinExecutionPeriod() {
+	addrs := s.addrs
+	addr1 := addrs[0]
+	addr2 := addrs[1]
+
+	sdkCtx, _ := s.sdkCtx.CacheContext()
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	// a min execution period which can't be reached is rejected
+	updateReq, err := group.NewMsgUpdateGroupPolicyDecisionPolicyRequest(addr1, s.groupPolicyAddr, &group.ThresholdDecisionPolicy{
+		Threshold:          "2",
+		Timeout:            time.Second,
+		MinExecutionPeriod: time.Second + keeper.DefaultConfig().MaxExecutionPeriod,
+	})
+	s.Require().NoError(err)
+	_, err = s.keeper.UpdateGroupPolicyDecisionPolicy(ctx, updateReq)
+	s.Require().Error(err)
+
+	updateReq, err = group.NewMsgUpdateGroupPolicyDecisionPolicyRequest(addr1, s.groupPolicyAddr, &group.ThresholdDecisionPolicy{
+		Threshold:          "2",
+		Timeout:            time.Second,
+		MinExecutionPeriod: 2 * time.Second,
+	})
+	s.Require().NoError(err)
+	_, err = s.keeper.UpdateGroupPolicyDecisionPolicy(ctx, updateReq)
+	s.Require().NoError(err)
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: s.groupPolicyAddr.String(),
+		ToAddress:   addr2.String(),
+		Amount:      sdk.Coins{sdk.NewInt64Coin("test", 100)},
+	}
+	proposalID := createProposal(ctx, s, []sdk.Msg{msgSend}, []string{addr2.String()})
+	_, err = s.keeper.Vote(ctx, &group.MsgVote{ProposalId: proposalID, Voter: addr2.String(), Choice: group.Choice_CHOICE_YES, Exec: group.Exec_EXEC_AUTO})
+	s.Require().NoError(err)
+
+	// the proposal is accepted but can't be executed before the min execution period
+	sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(time.Second))
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	_, err = s.keeper.Exec(ctx, &group.MsgExec{ProposalId: proposalID, Signer: addr2.String()})
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "must wait until")
+	s.Require().NoError(s.keeper.ProcessProposalsAtVotingPeriodEnd(sdkCtx))
+	res, err := s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().NoError(err)
+	s.Require().Equal(group.ProposalResultAccepted, res.Proposal.Result)
+	s.Require().Equal(group.ProposalExecutorResultNotRun, res.Proposal.ExecutorResult)
+	s.Require().Equal(s.blockTime.Add(2*time.Second), res.Proposal.ProcessTime)
+
+	// the proposal is executed automatically once the min execution period has elapsed
+	sdkCtx = sdkCtx.WithBlockTime(s.blockTime.Add(2 * time.Second))
+	ctx = sdk.WrapSDKContext(sdkCtx)
+	s.Require().NoError(s.keeper.ProcessProposalsAtVotingPeriodEnd(sdkCtx))
+	_, err = s.keeper.Proposal(ctx, &group.QueryProposalRequest{ProposalId: proposalID})
+	s.Require().Error(err)
+	s.Require().Contains(s.app.BankKeeper.GetAllBalances(sdkCtx, addr2), sdk.NewInt64Coin("test", 100))
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"reflect"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -247,6 +248,10 @@ func (k Keeper) CreateGroupPolicy(goCtx context.Context, req *group.MsgCreateGro
 		return nil, err
 	}
 
+	if err := k.validateDecisionPolicy(policy); err != nil {
+		return nil, err
+	}
+
 	g, err := k.getGroupInfo(ctx, groupID)
 	if err != nil {
 		return nil, err
@@ -331,6 +336,10 @@ func (k Keeper) UpdateGroupPolicyDecisionPolicy(goCtx context.Context, req *grou
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	policy := req.GetDecisionPolicy()
 
+	if err := k.validateDecisionPolicy(policy); err != nil {
+		return nil, err
+	}
+
 	action := func(groupPolicy *group.GroupPolicyInfo) error {
 		err := groupPolicy.SetDecisionPolicy(policy)
 		if err != nil {
@@ -435,6 +444,7 @@ func (k Keeper) CreateProposal(goCtx context.Context, req *group.MsgCreatePropos
 		Status:             group.ProposalStatusSubmitted,
 		ExecutorResult:     group.ProposalExecutorResultNotRun,
 		Timeout:            ctx.BlockTime().Add(window),
+		AutoExec:           req.Exec == group.Exec_EXEC_AUTO,
 		VoteState: group.Tally{
 			YesCount:     "0",
 			NoCount:      "0",
@@ -612,6 +622,9 @@ func (k Keeper) Vote(goCtx context.Context, req *group.MsgVote) (*group.MsgVoteR
 	if err := proposal.VoteState.Add(newVote, voter.Member.Weight); err != nil {
 		return nil, sdkerrors.Wrap(err, "add new vote")
 	}
+	if req.Exec == group.Exec_EXEC_AUTO {
+		proposal.AutoExec = true
+	}
 
 	// The ORM will return an error if the vote already exists,
 	// making sure than a voter hasn't already voted.
@@ -698,47 +711,21 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 	}
 
 	if proposal.Status == group.ProposalStatusSubmitted {
-		// Ensure that group policy hasn't been modified before tally.
-		if proposal.GroupPolicyVersion != policyInfo.Version {
-			proposal.Result = group.ProposalResultUnfinalized
-			proposal.Status = group.ProposalStatusAborted
-			return storeUpdates()
-		}
-
-		electorate, err := k.getGroupInfo(ctx, policyInfo.GroupId)
-		if err != nil {
-			return nil, sdkerrors.Wrap(err, "load group")
+		if err := k.doTallyOrAbort(ctx, &proposal, policyInfo); err != nil {
+			return nil, err
 		}
-
-		// Ensure that group hasn't been modified before tally.
-		if electorate.Version != proposal.GroupVersion {
-			proposal.Result = group.ProposalResultUnfinalized
-			proposal.Status = group.ProposalStatusAborted
+		if proposal.Status == group.ProposalStatusAborted {
 			return storeUpdates()
 		}
-		if err := doTally(ctx, &proposal, electorate, policyInfo); err != nil {
-			return nil, err
-		}
 	}
 
 	// Execute proposal payload.
 	if proposal.Status == group.ProposalStatusClosed && proposal.Result == group.ProposalResultAccepted && proposal.ExecutorResult != group.ProposalExecutorResultSuccess {
-		logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
-		// Caching context so that we don't update the store in case of failure.
-		ctx, flush := ctx.CacheContext()
-
-		addr, err := sdk.AccAddressFromBech32(policyInfo.Address)
-		if err != nil {
+		if err := k.assertExecutionPeriod(ctx, proposal, policyInfo); err != nil {
 			return nil, err
 		}
-		_, err = k.doExecuteMsgs(ctx, k.router, proposal, addr)
-		if err != nil {
-			proposal.ExecutorResult = group.ProposalExecutorResultFailure
-			proposalType := reflect.TypeOf(proposal).String()
-			logger.Info("proposal execution failed", "cause", err, "type", proposalType, "proposalID", id)
-		} else {
-			proposal.ExecutorResult = group.ProposalExecutorResultSuccess
-			flush()
+		if err := k.doExecuteProposal(ctx, &proposal, policyInfo); err != nil {
+			return nil, err
 		}
 	}
 
@@ -756,6 +743,176 @@ func (k Keeper) Exec(goCtx context.Context, req *group.MsgExec) (*group.MsgExecR
 	return res, nil
 }
 
+func (k Keeper) LeaveGroup(goCtx context.Context, req *group.MsgLeaveGroup) (*group.MsgLeaveGroupResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	g, err := k.getGroupInfo(ctx, req.GroupId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "load group")
+	}
+	totalWeight, err := math.NewNonNegativeDecFromString(g.TotalWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupMember group.GroupMember
+	err = k.groupMemberTable.GetOne(ctx.KVStore(k.key), orm.PrimaryKey(&group.GroupMember{
+		GroupId: req.GroupId,
+		Member:  &group.Member{Address: req.Address},
+	}), &groupMember)
+	switch {
+	case sdkerrors.ErrNotFound.Is(err):
+		return nil, sdkerrors.Wrapf(errors.ErrUnauthorized, "not in group: %s", req.Address)
+	case err != nil:
+		return nil, sdkerrors.Wrap(err, "get group member")
+	}
+
+	memberWeight, err := math.NewNonNegativeDecFromString(groupMember.Member.Weight)
+	if err != nil {
+		return nil, err
+	}
+	// Subtract the weight of the leaving member from the group total weight.
+	totalWeight, err = math.SubNonNegative(totalWeight, memberWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delete group member in the groupMemberTable.
+	if err := k.groupMemberTable.Delete(ctx.KVStore(k.key), &groupMember); err != nil {
+		return nil, sdkerrors.Wrap(err, "delete member")
+	}
+
+	// Updating the group version invalidates the proposals submitted before the member left.
+	g.TotalWeight = totalWeight.String()
+	g.Version++
+	if err := k.validateDecisionPolicies(ctx, g); err != nil {
+		return nil, err
+	}
+	if err := k.groupTable.Update(ctx.KVStore(k.key), g.GroupId, &g); err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&group.EventLeaveGroup{
+		GroupId: req.GroupId,
+		Address: req.Address,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &group.MsgLeaveGroupResponse{}, nil
+}
+
+// validateDecisionPolicies validates the decision policies of all the group policies of a group against the
+// given group info, so that the group can't be left with policies whose proposals can't pass.
+func (k Keeper) validateDecisionPolicies(ctx sdk.Context, g group.GroupInfo) error {
+	it, err := k.groupPolicyByGroupIndex.Get(ctx.KVStore(k.key), g.GroupId)
+	if err != nil {
+		return err
+	}
+	var policies []*group.GroupPolicyInfo
+	if _, err := orm.ReadAll(it, &policies); err != nil {
+		return err
+	}
+
+	for _, policyInfo := range policies {
+		policy := policyInfo.GetDecisionPolicy()
+		if policy == nil {
+			return sdkerrors.Wrap(errors.ErrEmpty, "nil policy")
+		}
+		if err := policy.Validate(g); err != nil {
+			return sdkerrors.Wrapf(err, "group policy %s", policyInfo.Address)
+		}
+	}
+
+	return nil
+}
+
+// doTallyOrAbort tallies a submitted proposal, or aborts it if the group or the group policy were modified since
+// its submission.
+func (k Keeper) doTallyOrAbort(ctx sdk.Context, proposal *group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	// Ensure that group policy hasn't been modified before tally.
+	if proposal.GroupPolicyVersion != policyInfo.Version {
+		proposal.Result = group.ProposalResultUnfinalized
+		proposal.Status = group.ProposalStatusAborted
+		return nil
+	}
+
+	electorate, err := k.getGroupInfo(ctx, policyInfo.GroupId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "load group")
+	}
+
+	// Ensure that group hasn't been modified before tally.
+	if electorate.Version != proposal.GroupVersion {
+		proposal.Result = group.ProposalResultUnfinalized
+		proposal.Status = group.ProposalStatusAborted
+		return nil
+	}
+
+	return doTally(ctx, proposal, electorate, policyInfo)
+}
+
+// assertExecutionPeriod returns an error if the proposal can't be executed yet, i.e. if the min execution period
+// of the decision policy hasn't elapsed since its submission.
+func (k Keeper) assertExecutionPeriod(ctx sdk.Context, proposal group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	executableAt, err := proposalExecutableAt(proposal, policyInfo)
+	if err != nil {
+		return err
+	}
+
+	if ctx.BlockTime().Before(executableAt) {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "must wait until %s to execute proposal %d", executableAt, proposal.ProposalId)
+	}
+	return nil
+}
+
+// proposalExecutableAt returns the time from which the proposal can be executed, once the min execution period of
+// the decision policy has elapsed since its submission.
+func proposalExecutableAt(proposal group.Proposal, policyInfo group.GroupPolicyInfo) (time.Time, error) {
+	policy := policyInfo.GetDecisionPolicy()
+	if policy == nil {
+		return time.Time{}, sdkerrors.Wrap(errors.ErrEmpty, "nil policy")
+	}
+	return proposal.SubmittedAt.Add(policy.GetMinExecutionPeriod()), nil
+}
+
+// doExecuteProposal executes the messages of an accepted proposal and records the result of the execution. A failed
+// execution is not an error, the changes of the messages being discarded.
+func (k Keeper) doExecuteProposal(ctx sdk.Context, proposal *group.Proposal, policyInfo group.GroupPolicyInfo) error {
+	logger := ctx.Logger().With("module", fmt.Sprintf("x/%s", group.ModuleName))
+	// Caching context so that we don't update the store in case of failure.
+	ctx, flush := ctx.CacheContext()
+
+	addr, err := sdk.AccAddressFromBech32(policyInfo.Address)
+	if err != nil {
+		return err
+	}
+	_, err = k.doExecuteMsgs(ctx, k.router, *proposal, addr)
+	if err != nil {
+		proposal.ExecutorResult = group.ProposalExecutorResultFailure
+		proposalType := reflect.TypeOf(proposal).String()
+		logger.Info("proposal execution failed", "cause", err, "type", proposalType, "proposalID", proposal.ProposalId)
+	} else {
+		proposal.ExecutorResult = group.ProposalExecutorResultSuccess
+		flush()
+	}
+	return nil
+}
+
+// validateDecisionPolicy returns an error if the proposals of the decision policy could never be executed, i.e. if
+// its min execution period isn't lower than its timeout plus the max execution period.
+func (k Keeper) validateDecisionPolicy(policy group.DecisionPolicy) error {
+	if policy == nil {
+		return sdkerrors.Wrap(errors.ErrEmpty, "nil policy")
+	}
+
+	if maxExecutionPeriod := policy.GetTimeout() + k.config.MaxExecutionPeriod; policy.GetMinExecutionPeriod() >= maxExecutionPeriod {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "min execution period %s should be lower than the timeout plus the max execution period %s", policy.GetMinExecutionPeriod(), maxExecutionPeriod)
+	}
+	return nil
+}
+
 type authNGroupReq interface {
 	GetGroupID() uint64
 	GetAdmin() string
@@ -0,0 +1,13 @@
+package module
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group/keeper"
+)
+
+// EndBlocker tallies, executes and prunes the proposals whose voting period has ended.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	if err := k.ProcessProposalsAtVotingPeriodEnd(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -392,6 +392,50 @@ func TestMsgCreateGroupPolicy(t *testing.T) {
 			true,
 			"expected a positive decimal",
 		},
+		{
+			"invalid percentage policy",
+			func() *group.MsgCreateGroupPolicy {
+				policy := group.NewPercentageDecisionPolicy("1.5", time.Second)
+				req, err := group.NewMsgCreateGroupPolicy(admin, 1, []byte("metadata"), policy)
+				require.NoError(t, err)
+				return req
+			},
+			true,
+			"percentage: 1.5 should not be greater than 1",
+		},
+		{
+			"invalid quorum policy",
+			func() *group.MsgCreateGroupPolicy {
+				policy := group.NewQuorumDecisionPolicy("0.4", "0.5", "1", time.Second)
+				req, err := group.NewMsgCreateGroupPolicy(admin, 1, []byte("metadata"), policy)
+				require.NoError(t, err)
+				return req
+			},
+			true,
+			"veto threshold: 1 should be lower than 1",
+		},
+		{
+			"valid percentage policy",
+			func() *group.MsgCreateGroupPolicy {
+				policy := group.NewPercentageDecisionPolicy("0.5", time.Second)
+				req, err := group.NewMsgCreateGroupPolicy(admin, 1, []byte("metadata"), policy)
+				require.NoError(t, err)
+				return req
+			},
+			false,
+			"",
+		},
+		{
+			"valid quorum policy",
+			func() *group.MsgCreateGroupPolicy {
+				policy := group.NewQuorumDecisionPolicy("0.4", "0.5", "0.334", time.Second)
+				req, err := group.NewMsgCreateGroupPolicy(admin, 1, []byte("metadata"), policy)
+				require.NoError(t, err)
+				return req
+			},
+			false,
+			"",
+		},
 		{
 			"valid test case",
 			func() *group.MsgCreateGroupPolicy {
@@ -817,3 +861,51 @@ func TestMsgExec(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgLeaveGroup(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *group.MsgLeaveGroup
+		expErr bool
+		errMsg string
+	}{
+		{
+			"invalid member address",
+			&group.MsgLeaveGroup{
+				Address: "member",
+			},
+			true,
+			"group member: decoding bech32 failed",
+		},
+		{
+			"group id is required",
+			&group.MsgLeaveGroup{
+				Address: member1.String(),
+			},
+			true,
+			"group id: value is empty",
+		},
+		{
+			"valid testcase",
+			&group.MsgLeaveGroup{
+				Address: member1.String(),
+				GroupId: 1,
+			},
+			false,
+			"",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := tc.msg
+			err := msg.ValidateBasic()
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, msg.Type(), sdk.MsgTypeURL(&group.MsgLeaveGroup{}))
+			}
+		})
+	}
+}
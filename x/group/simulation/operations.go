@@ -15,6 +15,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group/internal/math"
 	"github.com/cosmos/cosmos-sdk/x/group/keeper"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
@@ -37,6 +38,7 @@ var (
 	TypeMsgWithdrawProposal                = sdk.MsgTypeURL(&group.MsgWithdrawProposal{})
 	TypeMsgVote                            = sdk.MsgTypeURL(&group.MsgVote{})
 	TypeMsgExec                            = sdk.MsgTypeURL(&group.MsgExec{})
+	TypeMsgLeaveGroup                      = sdk.MsgTypeURL(&group.MsgLeaveGroup{})
 )
 
 // Simulation operation weights constants
@@ -53,6 +55,7 @@ const (
 	OpMsgWithdrawProposal                = "op_weight_msg_withdraw_proposal"
 	OpMsgVote                            = "op_weight_msg_vote"
 	OpMsgExec                            = "op_weight_msg_exec"
+	OpMsgLeaveGroup                      = "op_weight_msg_leave_group"
 )
 
 // If update group or group policy txn's executed, `SimulateMsgVote` & `SimulateMsgExec` txn's returns `noOp`.
@@ -70,6 +73,7 @@ const (
 	WeightMsgUpdateGroupPolicyDecisionPolicy = 5
 	WeightMsgUpdateGroupPolicyMetadata       = 5
 	WeightMsgWithdrawProposal                = 20
+	WeightMsgLeaveGroup                      = 5
 )
 
 const GroupMemberWeight = 40
@@ -91,6 +95,7 @@ func WeightedOperations(
 		weightMsgVote                            int
 		weightMsgExec                            int
 		weightMsgWithdrawProposal                int
+		weightMsgLeaveGroup                      int
 	)
 
 	appParams.GetOrGenerate(cdc, OpMsgCreateGroup, &weightMsgCreateGroup, nil,
@@ -153,6 +158,11 @@ func WeightedOperations(
 			weightMsgWithdrawProposal = WeightMsgWithdrawProposal
 		},
 	)
+	appParams.GetOrGenerate(cdc, OpMsgLeaveGroup, &weightMsgLeaveGroup, nil,
+		func(_ *rand.Rand) {
+			weightMsgLeaveGroup = WeightMsgLeaveGroup
+		},
+	)
 
 	// create two proposals for weightedOperations
 	var createProposalOps simulation.WeightedOperations
@@ -211,6 +221,10 @@ func WeightedOperations(
 			weightMsgUpdateGroupPolicyMetadata,
 			SimulateMsgUpdateGroupPolicyMetadata(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgLeaveGroup,
+			SimulateMsgLeaveGroup(ak, bk, k),
+		),
 	}
 
 	return append(wPreCreateProposalOps, append(createProposalOps, wPostCreateProposalOps...)...)
@@ -1022,6 +1036,72 @@ func SimulateMsgExec(ak group.AccountKeeper,
 	}
 }
 
+// SimulateMsgLeaveGroup generates a MsgLeaveGroup with random values
+func SimulateMsgLeaveGroup(ak group.AccountKeeper, bk group.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, sdkCtx sdk.Context, accounts []simtypes.Account, chainID string) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		groupInfo, _, _, err := randomGroup(r, k, ak, sdkCtx, accounts)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, ""), nil, err
+		}
+		if groupInfo == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "no group found"), nil, nil
+		}
+
+		// Pick a random member from the group
+		ctx := sdk.WrapSDKContext(sdkCtx)
+		acc, account, err := randomMember(r, k, ak, ctx, accounts, groupInfo.GroupId)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, ""), nil, err
+		}
+		if account == nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "no group member found"), nil, nil
+		}
+
+		// Return a no-op if we know the group policies would be invalid without the member
+		ok, err := canLeaveGroup(k, ctx, *groupInfo, acc.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, ""), nil, err
+		}
+		if !ok {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "group policies would be invalid"), nil, nil
+		}
+
+		spendableCoins := bk.SpendableCoins(sdkCtx, account.GetAddress())
+		fees, err := simtypes.RandomFees(r, sdkCtx, spendableCoins)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "fee error"), nil, err
+		}
+
+		msg := group.MsgLeaveGroup{
+			Address: acc.Address.String(),
+			GroupId: groupInfo.GroupId,
+		}
+
+		txGen := simappparams.MakeTestEncodingConfig().TxConfig
+		tx, err := helpers.GenTx(
+			txGen,
+			[]sdk.Msg{&msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			acc.PrivKey,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, TypeMsgLeaveGroup, "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+		if err != nil {
+			return simtypes.NoOpMsg(group.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(&msg, true, "", nil), nil, err
+	}
+}
+
 func randomGroup(r *rand.Rand, k keeper.Keeper, ak group.AccountKeeper,
 	ctx sdk.Context, accounts []simtypes.Account) (groupInfo *group.GroupInfo, acc simtypes.Account, account authtypes.AccountI, err error) {
 	groupID := k.GetGroupSequence(ctx)
@@ -1132,3 +1212,41 @@ func findAccount(accounts []simtypes.Account, addr string) (idx int) {
 	}
 	return idx
 }
+
+// canLeaveGroup returns whether the decision policies of the group policies of a group remain valid
+// once the given member left the group.
+func canLeaveGroup(k keeper.Keeper, ctx context.Context, groupInfo group.GroupInfo, member string) (bool, error) {
+	membersRes, err := k.GroupMembers(ctx, &group.QueryGroupMembersRequest{GroupId: groupInfo.GroupId})
+	if err != nil {
+		return false, err
+	}
+	totalWeight, err := math.NewNonNegativeDecFromString(groupInfo.TotalWeight)
+	if err != nil {
+		return false, err
+	}
+	for _, m := range membersRes.Members {
+		if m.Member.Address != member {
+			continue
+		}
+		memberWeight, err := math.NewNonNegativeDecFromString(m.Member.Weight)
+		if err != nil {
+			return false, err
+		}
+		totalWeight, err = math.SubNonNegative(totalWeight, memberWeight)
+		if err != nil {
+			return false, err
+		}
+	}
+	groupInfo.TotalWeight = totalWeight.String()
+
+	policiesRes, err := k.GroupPoliciesByGroup(ctx, &group.QueryGroupPoliciesByGroupRequest{GroupId: groupInfo.GroupId})
+	if err != nil {
+		return false, err
+	}
+	for _, policyInfo := range policiesRes.GroupPolicies {
+		if err := policyInfo.GetDecisionPolicy().Validate(groupInfo); err != nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
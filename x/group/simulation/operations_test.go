@@ -62,6 +62,8 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		{simulation.WeightMsgUpdateGroupPolicyAdmin, group.MsgUpdateGroupPolicyAdmin{}.Route(), simulation.TypeMsgUpdateGroupPolicyAdmin},
 		{simulation.WeightMsgUpdateGroupPolicyDecisionPolicy, group.MsgUpdateGroupPolicyDecisionPolicy{}.Route(), simulation.TypeMsgUpdateGroupPolicyDecisionPolicy},
 		{simulation.WeightMsgUpdateGroupPolicyMetadata, group.MsgUpdateGroupPolicyMetadata{}.Route(), simulation.TypeMsgUpdateGroupPolicyMetadata},
+		// the groups created by SimulateMsgCreateGroup have a single member who can't leave them
+		{simulation.WeightMsgLeaveGroup, group.ModuleName, simulation.TypeMsgLeaveGroup},
 	}
 
 	for i, w := range weightedOps {
@@ -713,6 +715,64 @@ func (suite *SimTestSuite) TestSimulateUpdateGroupPolicyMetadata() {
 	suite.Require().Len(futureOperations, 0)
 }
 
+func (suite *SimTestSuite) TestSimulateLeaveGroup() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	require := suite.Require()
+
+	// setup 4 account
+	accounts := suite.getTestingAccounts(r, 4)
+	admin := accounts[0]
+	member1 := accounts[1]
+	member2 := accounts[2]
+	member3 := accounts[3]
+
+	// setup a group
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	groupRes, err := suite.app.GroupKeeper.CreateGroup(ctx,
+		&group.MsgCreateGroup{
+			Admin: admin.Address.String(),
+			Members: []group.Member{
+				{Address: member1.Address.String(), Weight: "1"},
+				{Address: member2.Address.String(), Weight: "2"},
+				{Address: member3.Address.String(), Weight: "1"},
+			},
+		},
+	)
+	require.NoError(err)
+
+	// setup a group account
+	accountReq := &group.MsgCreateGroupPolicy{
+		Admin:    admin.Address.String(),
+		GroupId:  groupRes.GroupId,
+		Metadata: nil,
+	}
+	err = accountReq.SetDecisionPolicy(group.NewThresholdDecisionPolicy("3", time.Hour))
+	require.NoError(err)
+	_, err = suite.app.GroupKeeper.CreateGroupPolicy(ctx, accountReq)
+	require.NoError(err)
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	op := simulation.SimulateMsgLeaveGroup(suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.GroupKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, suite.ctx, accounts, "")
+	require.NoError(err)
+
+	var msg group.MsgLeaveGroup
+	err = group.ModuleCdc.UnmarshalJSON(operationMsg.Msg, &msg)
+	require.NoError(err)
+	require.True(operationMsg.OK)
+	require.Equal(groupRes.GroupId, msg.GroupId)
+	require.Len(futureOperations, 0)
+}
+
 func TestSimTestSuite(t *testing.T) {
 	suite.Run(t, new(SimTestSuite))
 }
@@ -43,16 +43,21 @@ const (
 	// the proposal will still be open and could
 	// be executed at a later point.
 	Exec_EXEC_TRY Exec = 1
+	// Execute the proposal automatically at the end of its voting period
+	// if it is accepted, in the EndBlocker of the group module.
+	Exec_EXEC_AUTO Exec = 2
 )
 
 var Exec_name = map[int32]string{
 	0: "EXEC_UNSPECIFIED",
 	1: "EXEC_TRY",
+	2: "EXEC_AUTO",
 }
 
 var Exec_value = map[string]int32{
 	"EXEC_UNSPECIFIED": 0,
 	"EXEC_TRY":         1,
+	"EXEC_AUTO":        2,
 }
 
 func (x Exec) String() string {
@@ -1254,6 +1259,98 @@ func (m *MsgExecResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExecResponse proto.InternalMessageInfo
 
+// MsgLeaveGroup is the Msg/LeaveGroup request type.
+type MsgLeaveGroup struct {
+	// address is the account address of the group member.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// group_id is the unique ID of the group.
+	GroupId uint64 `protobuf:"varint,2,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+}
+
+func (m *MsgLeaveGroup) Reset()         { *m = MsgLeaveGroup{} }
+func (m *MsgLeaveGroup) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveGroup) ProtoMessage()    {}
+func (*MsgLeaveGroup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da0de9d603d844fb, []int{24}
+}
+func (m *MsgLeaveGroup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveGroup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveGroup.Merge(m, src)
+}
+func (m *MsgLeaveGroup) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveGroup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLeaveGroup proto.InternalMessageInfo
+
+func (m *MsgLeaveGroup) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgLeaveGroup) GetGroupId() uint64 {
+	if m != nil {
+		return m.GroupId
+	}
+	return 0
+}
+
+// MsgLeaveGroupResponse is the Msg/LeaveGroup response type.
+type MsgLeaveGroupResponse struct {
+}
+
+func (m *MsgLeaveGroupResponse) Reset()         { *m = MsgLeaveGroupResponse{} }
+func (m *MsgLeaveGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLeaveGroupResponse) ProtoMessage()    {}
+func (*MsgLeaveGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da0de9d603d844fb, []int{25}
+}
+func (m *MsgLeaveGroupResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLeaveGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLeaveGroupResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgLeaveGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLeaveGroupResponse.Merge(m, src)
+}
+func (m *MsgLeaveGroupResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLeaveGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLeaveGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgLeaveGroupResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("cosmos.group.v1beta1.Exec", Exec_name, Exec_value)
 	proto.RegisterType((*MsgCreateGroup)(nil), "cosmos.group.v1beta1.MsgCreateGroup")
@@ -1280,80 +1377,85 @@ func init() {
 	proto.RegisterType((*MsgVoteResponse)(nil), "cosmos.group.v1beta1.MsgVoteResponse")
 	proto.RegisterType((*MsgExec)(nil), "cosmos.group.v1beta1.MsgExec")
 	proto.RegisterType((*MsgExecResponse)(nil), "cosmos.group.v1beta1.MsgExecResponse")
+	proto.RegisterType((*MsgLeaveGroup)(nil), "cosmos.group.v1beta1.MsgLeaveGroup")
+	proto.RegisterType((*MsgLeaveGroupResponse)(nil), "cosmos.group.v1beta1.MsgLeaveGroupResponse")
 }
 
 func init() { proto.RegisterFile("cosmos/group/v1beta1/tx.proto", fileDescriptor_da0de9d603d844fb) }
 
 var fileDescriptor_da0de9d603d844fb = []byte{
-	// 1077 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xc4, 0x6e, 0x3e, 0x9e, 0x8b, 0x9b, 0x6c, 0x4d, 0x71, 0x96, 0xc4, 0x36, 0x26, 0x80,
-	0x31, 0xc9, 0x2e, 0x71, 0x8a, 0x40, 0x56, 0x85, 0x48, 0x52, 0x83, 0x2c, 0x61, 0x14, 0xb6, 0x94,
-	0xaf, 0x8b, 0xb5, 0xf6, 0x0e, 0x9b, 0x2d, 0xb1, 0x67, 0xe5, 0xd9, 0x7c, 0xf8, 0xda, 0x13, 0x52,
-	0x2f, 0xfc, 0x03, 0x48, 0x48, 0x48, 0x1c, 0x38, 0x71, 0xe8, 0x85, 0x2b, 0xa7, 0x8a, 0x53, 0xc5,
-	0x89, 0x13, 0xaa, 0x92, 0x03, 0x48, 0x1c, 0xfa, 0x2f, 0x20, 0xcf, 0xec, 0x4e, 0xbc, 0xf1, 0xae,
-	0xbd, 0xb6, 0xa2, 0x9c, 0x92, 0xd9, 0xf9, 0xbd, 0xf7, 0x7e, 0xbf, 0x79, 0x6f, 0xe6, 0x3d, 0x19,
-	0x56, 0x5b, 0x84, 0xb6, 0x09, 0x55, 0xcd, 0x2e, 0x39, 0xb4, 0xd5, 0xa3, 0xcd, 0x26, 0x76, 0xf4,
-	0x4d, 0xd5, 0x39, 0x51, 0xec, 0x2e, 0x71, 0x88, 0x94, 0xe6, 0xdb, 0x0a, 0xdb, 0x56, 0xdc, 0x6d,
-	0x39, 0x6d, 0x12, 0x93, 0x30, 0x80, 0xda, 0xff, 0x8f, 0x63, 0xe5, 0x65, 0x8e, 0x6d, 0xf0, 0x0d,
-	0xd7, 0xd0, 0xdd, 0x32, 0x09, 0x31, 0x0f, 0xb0, 0xca, 0x56, 0xcd, 0xc3, 0x6f, 0x54, 0xbd, 0xd3,
-	0x73, 0xb7, 0xf2, 0xc1, 0x04, 0x7a, 0x36, 0xf6, 0x8c, 0x5f, 0x72, 0x11, 0x6d, 0x6a, 0xaa, 0x47,
-	0x9b, 0xfd, 0x3f, 0x7c, 0xa3, 0xf0, 0x33, 0x82, 0x54, 0x9d, 0x9a, 0xbb, 0x5d, 0xac, 0x3b, 0xf8,
-	0xa3, 0xbe, 0xbd, 0xa4, 0xc0, 0x35, 0xdd, 0x68, 0x5b, 0x9d, 0x0c, 0xca, 0xa3, 0xe2, 0xc2, 0x4e,
-	0xe6, 0xcf, 0xc7, 0x1b, 0x9e, 0x84, 0x6d, 0xc3, 0xe8, 0x62, 0x4a, 0xef, 0x39, 0x5d, 0xab, 0x63,
-	0x6a, 0x1c, 0x26, 0xdd, 0x81, 0xb9, 0x36, 0x6e, 0x37, 0x71, 0x97, 0x66, 0x66, 0xf2, 0xf1, 0x62,
-	0xb2, 0xbc, 0xa2, 0x04, 0x29, 0x56, 0xea, 0x0c, 0xb4, 0x93, 0x78, 0xf2, 0x77, 0x2e, 0xa6, 0x79,
-	0x26, 0x92, 0x0c, 0xf3, 0x6d, 0xec, 0xe8, 0x86, 0xee, 0xe8, 0x99, 0x78, 0x1e, 0x15, 0xaf, 0x6b,
-	0x62, 0x5d, 0x81, 0x87, 0xff, 0xfc, 0x5a, 0xe2, 0x51, 0x0a, 0x5b, 0x70, 0xcb, 0xcf, 0x53, 0xc3,
-	0xd4, 0x26, 0x1d, 0x8a, 0xa5, 0x65, 0x98, 0x67, 0x81, 0x1a, 0x96, 0xc1, 0x28, 0x27, 0xb4, 0x39,
-	0xb6, 0xae, 0x19, 0x85, 0xdf, 0x10, 0xbc, 0x58, 0xa7, 0xe6, 0x7d, 0xdb, 0xf0, 0xac, 0xea, 0x6e,
-	0xd8, 0x49, 0x45, 0x0e, 0x06, 0x99, 0xf1, 0x05, 0x91, 0x6a, 0x90, 0xe2, 0x62, 0x1a, 0x87, 0x2c,
-	0x0e, 0xcd, 0xc4, 0x23, 0x1f, 0xc3, 0x0b, 0xdc, 0x92, 0x13, 0xa4, 0x3e, 0xc1, 0x39, 0x58, 0x0d,
-	0xa4, 0xee, 0xe9, 0x2e, 0xfc, 0x84, 0xe0, 0xa6, 0x1f, 0xb1, 0xcd, 0xa8, 0x5e, 0xa2, 0xb4, 0x77,
-	0x60, 0xa1, 0x83, 0x8f, 0x1b, 0xdc, 0x5d, 0x7c, 0x8c, 0xbb, 0xf9, 0x0e, 0x3e, 0x66, 0x0c, 0x7c,
-	0x32, 0x56, 0xe1, 0xe5, 0x00, 0x92, 0x42, 0xc4, 0x23, 0xc4, 0xf2, 0xea, 0x93, 0xc9, 0xb3, 0x7f,
-	0x99, 0x3a, 0xa2, 0x16, 0x59, 0x1e, 0xb2, 0xc1, 0x64, 0x04, 0xdf, 0x67, 0x08, 0xd2, 0xfe, 0x3a,
-	0xdc, 0x23, 0x07, 0x56, 0xab, 0x77, 0x45, 0x6c, 0xa5, 0x4f, 0xe1, 0x86, 0x81, 0x5b, 0x16, 0xb5,
-	0x48, 0xa7, 0x61, 0xb3, 0xc8, 0x99, 0x44, 0x1e, 0x15, 0x93, 0xe5, 0xb4, 0xc2, 0xdf, 0x07, 0xc5,
-	0x7b, 0x1f, 0x94, 0xed, 0x4e, 0x6f, 0x47, 0xfa, 0xe3, 0xf1, 0x46, 0xea, 0xae, 0x6b, 0xc0, 0x99,
-	0x6a, 0x29, 0xc3, 0xb7, 0xae, 0xa4, 0xbe, 0xfb, 0x31, 0x17, 0x1b, 0x38, 0x04, 0x0d, 0x56, 0x82,
-	0x14, 0x8a, 0xfb, 0x56, 0x86, 0x39, 0x9d, 0x2b, 0x1a, 0xab, 0xd5, 0x03, 0x16, 0x7e, 0x47, 0xb0,
-	0xec, 0x3f, 0x59, 0xee, 0x74, 0xba, 0x8a, 0x1d, 0x60, 0x30, 0x13, 0x91, 0xc1, 0x65, 0x94, 0xf2,
-	0xab, 0xf0, 0x4a, 0xa8, 0x06, 0x51, 0x20, 0xff, 0x21, 0x28, 0x04, 0xa1, 0xfc, 0x49, 0xb8, 0x12,
-	0xc9, 0x01, 0xb5, 0x12, 0xbf, 0xe4, 0x5a, 0x59, 0x87, 0xd2, 0x78, 0xb1, 0xe2, 0x6c, 0x7e, 0x41,
-	0xac, 0xb4, 0x86, 0xe0, 0x53, 0x5f, 0xf9, 0x69, 0x4e, 0x25, 0xea, 0x5b, 0xf0, 0x3a, 0xac, 0x8d,
-	0xe2, 0x2a, 0x44, 0x3d, 0x47, 0xb0, 0x24, 0xee, 0xcb, 0x5e, 0x97, 0xd8, 0x84, 0xea, 0x07, 0xd3,
-	0x5c, 0x12, 0x69, 0x05, 0x16, 0x6c, 0x66, 0xef, 0xb5, 0xd2, 0x05, 0xed, 0xfc, 0xc3, 0xc8, 0x57,
-	0xa1, 0x08, 0x89, 0x36, 0x35, 0x69, 0x26, 0xc1, 0x1a, 0x4f, 0x60, 0x7a, 0x35, 0x86, 0x90, 0x14,
-	0x48, 0xe0, 0x13, 0xdc, 0xca, 0x5c, 0xcb, 0xa3, 0x62, 0xaa, 0x2c, 0x07, 0xb7, 0xa8, 0xea, 0x09,
-	0x6e, 0x69, 0x0c, 0x57, 0x91, 0xbc, 0x84, 0x9f, 0x33, 0x29, 0xdc, 0x61, 0x77, 0xd9, 0x2f, 0x58,
-	0xbc, 0x0e, 0x39, 0x48, 0xda, 0xee, 0xb7, 0xf3, 0x86, 0x0c, 0xde, 0xa7, 0x9a, 0x51, 0x78, 0xc0,
-	0xba, 0xd6, 0x17, 0x96, 0xb3, 0x6f, 0x74, 0xf5, 0x63, 0x71, 0x60, 0xe3, 0xec, 0xa6, 0xc9, 0xb5,
-	0xdb, 0x7c, 0x2e, 0xc6, 0x12, 0xa9, 0xfb, 0x17, 0xc1, 0x5c, 0x9d, 0x9a, 0x9f, 0x13, 0x67, 0x3c,
-	0xef, 0x7e, 0x6d, 0x1e, 0x11, 0x07, 0x77, 0xc7, 0x46, 0xe7, 0x30, 0xe9, 0x36, 0xcc, 0xb6, 0xf6,
-	0x89, 0xd5, 0xc2, 0x2c, 0x5b, 0xa9, 0xb0, 0x71, 0x60, 0x97, 0x61, 0x34, 0x17, 0xeb, 0xcb, 0x72,
-	0xe2, 0x42, 0x96, 0x27, 0xcd, 0x1d, 0xaf, 0x66, 0xc6, 0xa6, 0xb0, 0x04, 0x37, 0x5c, 0xa5, 0x42,
-	0xbd, 0xc5, 0xc4, 0xf7, 0xf1, 0xe3, 0xc5, 0xbf, 0x0d, 0xb3, 0xd4, 0x32, 0x3b, 0x11, 0xd4, 0xbb,
-	0xb8, 0x4a, 0xb2, 0x1f, 0xdc, 0x5d, 0xb8, 0xd1, 0x19, 0x35, 0x37, 0x7a, 0xa9, 0x04, 0x09, 0x16,
-	0x3a, 0x0d, 0x8b, 0xd5, 0x2f, 0xab, 0xbb, 0x8d, 0xfb, 0x9f, 0xdc, 0xdb, 0xab, 0xee, 0xd6, 0x3e,
-	0xac, 0x55, 0xef, 0x2e, 0xc6, 0xa4, 0xeb, 0x30, 0xcf, 0xbe, 0x7e, 0xa6, 0x7d, 0xb5, 0x88, 0xca,
-	0xcf, 0x01, 0xe2, 0x75, 0x6a, 0x4a, 0x3a, 0x24, 0x07, 0x07, 0xd5, 0xb5, 0x90, 0x01, 0xcb, 0xd7,
-	0xbc, 0xe4, 0xf5, 0x28, 0x28, 0x51, 0xbe, 0x47, 0x20, 0x05, 0x4c, 0x8b, 0x6f, 0x85, 0xfa, 0x18,
-	0x06, 0xcb, 0x5b, 0x13, 0x80, 0x45, 0x5c, 0x1b, 0x16, 0x87, 0x06, 0xb9, 0x37, 0xa3, 0x38, 0x62,
-	0x50, 0x79, 0x33, 0x32, 0x54, 0x44, 0xec, 0xc1, 0xcd, 0xa0, 0xa9, 0x6b, 0x3d, 0x1a, 0x7b, 0x8e,
-	0x96, 0x6f, 0x4f, 0x82, 0x16, 0xa1, 0x29, 0x2c, 0x0d, 0x0f, 0x50, 0xa5, 0x28, 0x79, 0xe2, 0x58,
-	0xb9, 0x1c, 0x1d, 0x2b, 0x82, 0x3e, 0x44, 0x70, 0x2b, 0x64, 0xfe, 0x50, 0xa3, 0xa8, 0x18, 0x30,
-	0x90, 0xdf, 0x9d, 0xd0, 0x40, 0x90, 0xf8, 0x01, 0x41, 0x6e, 0xdc, 0x68, 0xf0, 0x5e, 0x74, 0xe7,
-	0x7e, 0x4b, 0xf9, 0x83, 0x69, 0x2d, 0x05, 0xbf, 0x47, 0x08, 0x96, 0xc3, 0xdb, 0x73, 0x39, 0xba,
-	0x7f, 0x51, 0x21, 0x95, 0xc9, 0x6d, 0x04, 0x9b, 0x07, 0x90, 0xba, 0xd0, 0x56, 0xdf, 0x18, 0x93,
-	0x78, 0x0f, 0x28, 0xab, 0x11, 0x81, 0x83, 0x17, 0x70, 0xa8, 0x27, 0x85, 0x5f, 0xc0, 0x8b, 0xd0,
-	0x11, 0x17, 0x30, 0xac, 0xfb, 0x48, 0x1f, 0x43, 0x82, 0x75, 0x9e, 0xd5, 0x50, 0xd3, 0xfe, 0xb6,
-	0xfc, 0xda, 0xc8, 0xed, 0x41, 0x6f, 0xec, 0x3d, 0x0d, 0xf7, 0xd6, 0xdf, 0x1e, 0xe1, 0x6d, 0xf0,
-	0x75, 0xde, 0x79, 0xff, 0xc9, 0x69, 0x16, 0x3d, 0x3d, 0xcd, 0xa2, 0x67, 0xa7, 0x59, 0xf4, 0xfd,
-	0x59, 0x36, 0xf6, 0xf4, 0x2c, 0x1b, 0xfb, 0xeb, 0x2c, 0x1b, 0xfb, 0x7a, 0xcd, 0xb4, 0x9c, 0xfd,
-	0xc3, 0xa6, 0xd2, 0x22, 0x6d, 0xf7, 0xf7, 0x09, 0xf7, 0xcf, 0x06, 0x35, 0xbe, 0x55, 0x4f, 0xf8,
-	0x6f, 0x10, 0xcd, 0x59, 0x36, 0x7a, 0x6c, 0xfd, 0x1f, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x1d, 0x8e,
-	0x60, 0x1b, 0x11, 0x00, 0x00,
+	// 1133 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xc4, 0x6e, 0x7e, 0x3c, 0x27, 0x6e, 0xb2, 0x4d, 0x53, 0x7b, 0xbf, 0x89, 0xed, 0xaf,
+	0x9b, 0x82, 0x49, 0x93, 0x5d, 0xec, 0x14, 0x01, 0x56, 0x85, 0x48, 0x52, 0x83, 0x2c, 0xd5, 0x10,
+	0xb6, 0x0d, 0xbf, 0x0e, 0x58, 0x6b, 0xef, 0xb0, 0xd9, 0x12, 0x7b, 0x57, 0x9e, 0x8d, 0x13, 0x5f,
+	0x7b, 0x42, 0xea, 0x85, 0x7f, 0x00, 0x09, 0x09, 0x89, 0x03, 0x27, 0x0e, 0xbd, 0x70, 0xe5, 0x54,
+	0x71, 0xa1, 0xe2, 0xc4, 0x09, 0x55, 0xc9, 0x01, 0x24, 0x0e, 0xfc, 0x0b, 0xc8, 0x33, 0xbb, 0x13,
+	0x6f, 0xbc, 0x1b, 0xaf, 0xad, 0xa8, 0xa7, 0x64, 0xf6, 0x7d, 0xde, 0xbc, 0xcf, 0x67, 0xde, 0x9b,
+	0x79, 0x4f, 0x86, 0x95, 0x86, 0x49, 0x9a, 0x26, 0x91, 0xf5, 0xb6, 0x79, 0x68, 0xc9, 0x9d, 0x42,
+	0x1d, 0xdb, 0x6a, 0x41, 0xb6, 0x8f, 0x25, 0xab, 0x6d, 0xda, 0xa6, 0xb0, 0xc8, 0xcc, 0x12, 0x35,
+	0x4b, 0x8e, 0x59, 0x5c, 0xd4, 0x4d, 0xdd, 0xa4, 0x00, 0xb9, 0xf7, 0x1f, 0xc3, 0x8a, 0x29, 0x86,
+	0xad, 0x31, 0x83, 0xe3, 0xe8, 0x98, 0x74, 0xd3, 0xd4, 0x0f, 0xb0, 0x4c, 0x57, 0xf5, 0xc3, 0x2f,
+	0x65, 0xb5, 0xd5, 0x75, 0x4c, 0x59, 0x7f, 0x02, 0x5d, 0x0b, 0xbb, 0xce, 0x37, 0x1c, 0x44, 0x93,
+	0xe8, 0x72, 0xa7, 0xd0, 0xfb, 0xc3, 0x0c, 0xb9, 0x1f, 0x10, 0x24, 0xaa, 0x44, 0xdf, 0x69, 0x63,
+	0xd5, 0xc6, 0xef, 0xf7, 0xfc, 0x05, 0x09, 0xae, 0xa8, 0x5a, 0xd3, 0x68, 0x25, 0x51, 0x16, 0xe5,
+	0x67, 0xb6, 0x93, 0xbf, 0x3f, 0xdd, 0x70, 0x25, 0x6c, 0x69, 0x5a, 0x1b, 0x13, 0xf2, 0xc0, 0x6e,
+	0x1b, 0x2d, 0x5d, 0x61, 0x30, 0xe1, 0x2e, 0x4c, 0x35, 0x71, 0xb3, 0x8e, 0xdb, 0x24, 0x39, 0x91,
+	0x8d, 0xe6, 0xe3, 0xc5, 0x65, 0xc9, 0x4f, 0xb1, 0x54, 0xa5, 0xa0, 0xed, 0xd8, 0xb3, 0x3f, 0x33,
+	0x11, 0xc5, 0x75, 0x11, 0x44, 0x98, 0x6e, 0x62, 0x5b, 0xd5, 0x54, 0x5b, 0x4d, 0x46, 0xb3, 0x28,
+	0x3f, 0xab, 0xf0, 0x75, 0x09, 0x1e, 0xff, 0xf5, 0xd3, 0x1a, 0x8b, 0x92, 0xdb, 0x84, 0x25, 0x2f,
+	0x4f, 0x05, 0x13, 0xcb, 0x6c, 0x11, 0x2c, 0xa4, 0x60, 0x9a, 0x06, 0xaa, 0x19, 0x1a, 0xa5, 0x1c,
+	0x53, 0xa6, 0xe8, 0xba, 0xa2, 0xe5, 0x7e, 0x46, 0x70, 0xbd, 0x4a, 0xf4, 0x3d, 0x4b, 0x73, 0xbd,
+	0xaa, 0x4e, 0xd8, 0x51, 0x45, 0xf6, 0x07, 0x99, 0xf0, 0x04, 0x11, 0x2a, 0x90, 0x60, 0x62, 0x6a,
+	0x87, 0x34, 0x0e, 0x49, 0x46, 0x43, 0x1f, 0xc3, 0x1c, 0xf3, 0x64, 0x04, 0x89, 0x47, 0x70, 0x06,
+	0x56, 0x7c, 0xa9, 0xbb, 0xba, 0x73, 0xdf, 0x23, 0xb8, 0xe6, 0x45, 0x6c, 0x51, 0xaa, 0x97, 0x28,
+	0xed, 0x0d, 0x98, 0x69, 0xe1, 0xa3, 0x1a, 0xdb, 0x2e, 0x3a, 0x64, 0xbb, 0xe9, 0x16, 0x3e, 0xa2,
+	0x0c, 0x3c, 0x32, 0x56, 0xe0, 0x7f, 0x3e, 0x24, 0xb9, 0x88, 0x27, 0x08, 0x96, 0xbc, 0xf6, 0xaa,
+	0x93, 0xfd, 0xcb, 0xd4, 0x11, 0xb6, 0xc8, 0xb2, 0x90, 0xf6, 0x27, 0xc3, 0xf9, 0xbe, 0x40, 0xb0,
+	0xe8, 0xad, 0xc3, 0x5d, 0xf3, 0xc0, 0x68, 0x74, 0x5f, 0x12, 0x5b, 0xe1, 0x23, 0xb8, 0xaa, 0xe1,
+	0x86, 0x41, 0x0c, 0xb3, 0x55, 0xb3, 0x68, 0xe4, 0x64, 0x2c, 0x8b, 0xf2, 0xf1, 0xe2, 0xa2, 0xc4,
+	0xde, 0x07, 0xc9, 0x7d, 0x1f, 0xa4, 0xad, 0x56, 0x77, 0x5b, 0xf8, 0xf5, 0xe9, 0x46, 0xe2, 0x9e,
+	0xe3, 0xc0, 0x98, 0x2a, 0x09, 0xcd, 0xb3, 0x2e, 0x25, 0xbe, 0xfe, 0x2e, 0x13, 0xe9, 0x3b, 0x04,
+	0x05, 0x96, 0xfd, 0x14, 0xf2, 0xfb, 0x56, 0x84, 0x29, 0x95, 0x29, 0x1a, 0xaa, 0xd5, 0x05, 0xe6,
+	0x7e, 0x41, 0x90, 0xf2, 0x9e, 0x2c, 0xdb, 0x74, 0xbc, 0x8a, 0xed, 0x63, 0x30, 0x11, 0x92, 0xc1,
+	0x65, 0x94, 0xf2, 0x4d, 0xf8, 0x7f, 0xa0, 0x06, 0x5e, 0x20, 0xff, 0x20, 0xc8, 0xf9, 0xa1, 0xbc,
+	0x49, 0x78, 0x29, 0x92, 0x7d, 0x6a, 0x25, 0x7a, 0xc9, 0xb5, 0xb2, 0x0e, 0x6b, 0xc3, 0xc5, 0xf2,
+	0xb3, 0xf9, 0x11, 0xc1, 0xb2, 0x1f, 0x7c, 0xec, 0x2b, 0x3f, 0xce, 0xa9, 0x84, 0x7d, 0x0b, 0x5e,
+	0x81, 0xd5, 0x8b, 0xb8, 0x72, 0x51, 0xff, 0x22, 0x58, 0xe0, 0xf7, 0x65, 0xb7, 0x6d, 0x5a, 0x26,
+	0x51, 0x0f, 0xc6, 0xb9, 0x24, 0xc2, 0x32, 0xcc, 0x58, 0xd4, 0xdf, 0x6d, 0xa5, 0x33, 0xca, 0xd9,
+	0x87, 0x0b, 0x5f, 0x85, 0x3c, 0xc4, 0x9a, 0x44, 0x27, 0xc9, 0x58, 0x36, 0x1a, 0x94, 0x5e, 0x85,
+	0x22, 0x04, 0x09, 0x62, 0xf8, 0x18, 0x37, 0x92, 0x57, 0xb2, 0x28, 0x9f, 0x28, 0x8a, 0xfe, 0x2d,
+	0xaa, 0x7c, 0x8c, 0x1b, 0x0a, 0xc5, 0x95, 0x04, 0x37, 0xe1, 0x67, 0x4c, 0x72, 0x77, 0x21, 0x35,
+	0x20, 0x98, 0xbf, 0x0e, 0x19, 0x88, 0x5b, 0xce, 0xb7, 0xb3, 0x86, 0x0c, 0xee, 0xa7, 0x8a, 0x96,
+	0x7b, 0x44, 0xbb, 0xd6, 0x27, 0x86, 0xbd, 0xaf, 0xb5, 0xd5, 0x23, 0x7e, 0x60, 0xc3, 0xfc, 0xc6,
+	0xc9, 0xb5, 0xd3, 0x7c, 0xce, 0xc7, 0xe2, 0xa9, 0xfb, 0x1b, 0xc1, 0x54, 0x95, 0xe8, 0x1f, 0x9b,
+	0xf6, 0x70, 0xde, 0xbd, 0xda, 0xec, 0x98, 0x36, 0x6e, 0x0f, 0x8d, 0xce, 0x60, 0xc2, 0x1d, 0x98,
+	0x6c, 0xec, 0x9b, 0x46, 0x03, 0xd3, 0x6c, 0x25, 0x82, 0xc6, 0x81, 0x1d, 0x8a, 0x51, 0x1c, 0xac,
+	0x27, 0xcb, 0xb1, 0x73, 0x59, 0x1e, 0x35, 0x77, 0xac, 0x9a, 0x29, 0x9b, 0xdc, 0x02, 0x5c, 0x75,
+	0x94, 0x72, 0xf5, 0x06, 0x15, 0xdf, 0xc3, 0x0f, 0x17, 0xff, 0x3a, 0x4c, 0x12, 0x43, 0x6f, 0x85,
+	0x50, 0xef, 0xe0, 0x4a, 0xf1, 0x5e, 0x70, 0x67, 0xe1, 0x44, 0xa7, 0xd4, 0xdc, 0xe8, 0x07, 0x30,
+	0x57, 0x25, 0xfa, 0x7d, 0xac, 0x76, 0x9c, 0xb1, 0x73, 0x9c, 0x1b, 0x13, 0xdc, 0x44, 0x4b, 0xb3,
+	0xbd, 0xf8, 0xbc, 0x10, 0x6e, 0xc0, 0x75, 0x4f, 0x34, 0x97, 0xc6, 0xda, 0xdb, 0x10, 0xa3, 0x27,
+	0xb0, 0x08, 0xf3, 0xe5, 0x4f, 0xcb, 0x3b, 0xb5, 0xbd, 0x0f, 0x1e, 0xec, 0x96, 0x77, 0x2a, 0xef,
+	0x55, 0xca, 0xf7, 0xe6, 0x23, 0xc2, 0x2c, 0x4c, 0xd3, 0xaf, 0x0f, 0x95, 0xcf, 0xe6, 0x91, 0x30,
+	0x07, 0x33, 0x74, 0xb5, 0xb5, 0xf7, 0xf0, 0xc3, 0xf9, 0x89, 0xe2, 0x6f, 0x71, 0x88, 0x56, 0x89,
+	0x2e, 0xa8, 0x10, 0xef, 0x1f, 0x9f, 0x57, 0x03, 0xc6, 0x3e, 0x4f, 0x4b, 0x15, 0xd7, 0xc3, 0xa0,
+	0xf8, 0xa5, 0xea, 0x80, 0xe0, 0x33, 0xc3, 0xde, 0x0e, 0xdc, 0x63, 0x10, 0x2c, 0x6e, 0x8e, 0x00,
+	0xe6, 0x71, 0x2d, 0x98, 0x1f, 0x18, 0x2f, 0x5f, 0x0b, 0xb3, 0x11, 0x85, 0x8a, 0x85, 0xd0, 0x50,
+	0x1e, 0xb1, 0x0b, 0xd7, 0xfc, 0x66, 0xc1, 0xf5, 0x70, 0xec, 0x19, 0x5a, 0xbc, 0x33, 0x0a, 0x9a,
+	0x87, 0x26, 0xb0, 0x30, 0x38, 0xd6, 0xad, 0x85, 0xc9, 0x13, 0xc3, 0x8a, 0xc5, 0xf0, 0x58, 0x1e,
+	0xf4, 0x31, 0x82, 0xa5, 0x80, 0xa9, 0x48, 0x0e, 0xa3, 0xa2, 0xcf, 0x41, 0x7c, 0x73, 0x44, 0x07,
+	0x4e, 0xe2, 0x5b, 0x04, 0x99, 0x61, 0x03, 0xcb, 0x5b, 0xe1, 0x37, 0xf7, 0x7a, 0x8a, 0xef, 0x8e,
+	0xeb, 0xc9, 0xf9, 0x3d, 0x41, 0x90, 0x0a, 0x1e, 0x1a, 0x8a, 0xe1, 0xf7, 0xe7, 0x15, 0x52, 0x1a,
+	0xdd, 0x87, 0xb3, 0x79, 0x04, 0x89, 0x73, 0xcd, 0xfe, 0xd5, 0x21, 0x89, 0x77, 0x81, 0xa2, 0x1c,
+	0x12, 0xd8, 0x7f, 0x01, 0x07, 0x3a, 0x65, 0xf0, 0x05, 0x3c, 0x0f, 0x15, 0x0b, 0xa1, 0xa1, 0x3c,
+	0xe2, 0x7d, 0x88, 0xd1, 0x7e, 0xb8, 0x12, 0xe8, 0xda, 0x33, 0x8b, 0xb7, 0x2e, 0x34, 0xf7, 0xef,
+	0x46, 0x9f, 0xd7, 0xe0, 0xdd, 0x7a, 0x66, 0xf1, 0xd6, 0x85, 0x66, 0xbe, 0xdb, 0x17, 0x00, 0x7d,
+	0x0d, 0xe3, 0x66, 0xa0, 0xd3, 0x19, 0x48, 0xbc, 0x1d, 0x02, 0xe4, 0xee, 0xbf, 0xfd, 0xce, 0xb3,
+	0x93, 0x34, 0x7a, 0x7e, 0x92, 0x46, 0x2f, 0x4e, 0xd2, 0xe8, 0x9b, 0xd3, 0x74, 0xe4, 0xf9, 0x69,
+	0x3a, 0xf2, 0xc7, 0x69, 0x3a, 0xf2, 0xf9, 0xaa, 0x6e, 0xd8, 0xfb, 0x87, 0x75, 0xa9, 0x61, 0x36,
+	0x9d, 0x5f, 0x65, 0x9c, 0x3f, 0x1b, 0x44, 0xfb, 0x4a, 0x3e, 0x66, 0xbf, 0xbc, 0xd4, 0x27, 0xe9,
+	0xc0, 0xb5, 0xf9, 0xdf, 0x00, 0x90, 0xf3, 0xf8, 0xd1, 0x11, 0x12, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1392,6 +1494,8 @@ type MsgClient interface {
 	Vote(ctx context.Context, in *MsgVote, opts ...grpc.CallOption) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOption) (*MsgExecResponse, error)
+	// LeaveGroup allows a group member to leave the group.
+	LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error)
 }
 
 type msgClient struct {
@@ -1510,6 +1614,15 @@ func (c *msgClient) Exec(ctx context.Context, in *MsgExec, opts ...grpc.CallOpti
 	return out, nil
 }
 
+func (c *msgClient) LeaveGroup(ctx context.Context, in *MsgLeaveGroup, opts ...grpc.CallOption) (*MsgLeaveGroupResponse, error) {
+	out := new(MsgLeaveGroupResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.group.v1beta1.Msg/LeaveGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CreateGroup creates a new group with an admin account address, a list of members and some optional metadata.
@@ -1536,6 +1649,8 @@ type MsgServer interface {
 	Vote(context.Context, *MsgVote) (*MsgVoteResponse, error)
 	// Exec executes a proposal.
 	Exec(context.Context, *MsgExec) (*MsgExecResponse, error)
+	// LeaveGroup allows a group member to leave the group.
+	LeaveGroup(context.Context, *MsgLeaveGroup) (*MsgLeaveGroupResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1578,6 +1693,9 @@ func (*UnimplementedMsgServer) Vote(ctx context.Context, req *MsgVote) (*MsgVote
 func (*UnimplementedMsgServer) Exec(ctx context.Context, req *MsgExec) (*MsgExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Exec not implemented")
 }
+func (*UnimplementedMsgServer) LeaveGroup(ctx context.Context, req *MsgLeaveGroup) (*MsgLeaveGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveGroup not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1799,6 +1917,24 @@ func _Msg_Exec_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_LeaveGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLeaveGroup)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LeaveGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.group.v1beta1.Msg/LeaveGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LeaveGroup(ctx, req.(*MsgLeaveGroup))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "cosmos.group.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1851,6 +1987,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Exec",
 			Handler:    _Msg_Exec_Handler,
 		},
+		{
+			MethodName: "LeaveGroup",
+			Handler:    _Msg_LeaveGroup_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cosmos/group/v1beta1/tx.proto",
@@ -2711,6 +2851,64 @@ func (m *MsgExecResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgLeaveGroup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGroup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGroup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GroupId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GroupId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLeaveGroupResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLeaveGroupResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLeaveGroupResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3102,6 +3300,31 @@ func (m *MsgExecResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgLeaveGroup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GroupId != 0 {
+		n += 1 + sovTx(uint64(m.GroupId))
+	}
+	return n
+}
+
+func (m *MsgLeaveGroupResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -5522,6 +5745,157 @@ func (m *MsgExecResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgLeaveGroup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveGroup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveGroup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
+			}
+			m.GroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLeaveGroupResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLeaveGroupResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
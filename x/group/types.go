@@ -27,6 +27,10 @@ type DecisionPolicy interface {
 
 	ValidateBasic() error
 	GetTimeout() time.Duration
+	GetMinExecutionPeriod() time.Duration
+	// Allow returns the result of the tally of the proposal after the given voting duration. Before the timeout,
+	// the result is final only if the members who haven't voted yet can't change it anymore. Once the timeout is
+	// reached, the voting period has ended and the result is final.
 	Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error)
 	Validate(g GroupInfo) error
 }
@@ -36,7 +40,7 @@ var _ DecisionPolicy = &ThresholdDecisionPolicy{}
 
 // NewThresholdDecisionPolicy creates a threshold DecisionPolicy
 func NewThresholdDecisionPolicy(threshold string, timeout time.Duration) DecisionPolicy {
-	return &ThresholdDecisionPolicy{threshold, timeout}
+	return &ThresholdDecisionPolicy{Threshold: threshold, Timeout: timeout}
 }
 
 func (p ThresholdDecisionPolicy) ValidateBasic() error {
@@ -49,6 +53,9 @@ func (p ThresholdDecisionPolicy) ValidateBasic() error {
 	if timeout <= time.Nanosecond {
 		return sdkerrors.Wrap(errors.ErrInvalid, "timeout")
 	}
+	if p.MinExecutionPeriod < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min execution period")
+	}
 	return nil
 }
 
@@ -59,9 +66,6 @@ func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDur
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	if timeout <= votingDuration {
-		return DecisionPolicyResult{Allow: false, Final: true}, nil
-	}
 
 	threshold, err := math.NewPositiveDecFromString(p.Threshold)
 	if err != nil {
@@ -74,16 +78,15 @@ func (p ThresholdDecisionPolicy) Allow(tally Tally, totalPower string, votingDur
 	if yesCount.Cmp(threshold) >= 0 {
 		return DecisionPolicyResult{Allow: true, Final: true}, nil
 	}
+	if timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
 
 	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
-	totalCounts, err := tally.TotalCounts()
-	if err != nil {
-		return DecisionPolicyResult{}, err
-	}
-	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	undecided, err := tally.undecided(totalPowerDec)
 	if err != nil {
 		return DecisionPolicyResult{}, err
 	}
@@ -113,6 +116,254 @@ func (p *ThresholdDecisionPolicy) Validate(g GroupInfo) error {
 	return nil
 }
 
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &PercentageDecisionPolicy{}
+
+// NewPercentageDecisionPolicy creates a percentage DecisionPolicy
+func NewPercentageDecisionPolicy(percentage string, timeout time.Duration) DecisionPolicy {
+	return &PercentageDecisionPolicy{Percentage: percentage, Timeout: timeout}
+}
+
+func (p PercentageDecisionPolicy) ValidateBasic() error {
+	if err := validatePercentage(p.Percentage, false); err != nil {
+		return sdkerrors.Wrap(err, "percentage")
+	}
+
+	if p.Timeout <= time.Nanosecond {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timeout")
+	}
+	if p.MinExecutionPeriod < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min execution period")
+	}
+	return nil
+}
+
+// Allow allows a proposal to pass when the tally of yes votes equals or exceeds the percentage of the total group
+// weight. Before the timeout, the proposal is rejected as soon as the yes votes can't reach the percentage anymore,
+// even if all undecided members vote yes. Once the timeout is reached, the result is final.
+func (p PercentageDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	percentage, err := math.NewPositiveDecFromString(p.Percentage)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	threshold, err := percentage.Mul(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if yesCount.Cmp(threshold) >= 0 {
+		return DecisionPolicyResult{Allow: true, Final: true}, nil
+	}
+	if p.Timeout <= votingDuration {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	undecided, err := tally.undecided(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	sum, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if sum.Cmp(threshold) < 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	return DecisionPolicyResult{Allow: false, Final: false}, nil
+}
+
+// Validate always succeeds, the percentage being relative to the total group weight
+func (p *PercentageDecisionPolicy) Validate(g GroupInfo) error {
+	return nil
+}
+
+// Implements DecisionPolicy Interface
+var _ DecisionPolicy = &QuorumDecisionPolicy{}
+
+// NewQuorumDecisionPolicy creates a quorum DecisionPolicy
+func NewQuorumDecisionPolicy(quorum, threshold, vetoThreshold string, timeout time.Duration) DecisionPolicy {
+	return &QuorumDecisionPolicy{Quorum: quorum, Threshold: threshold, VetoThreshold: vetoThreshold, Timeout: timeout}
+}
+
+func (p QuorumDecisionPolicy) ValidateBasic() error {
+	if err := validatePercentage(p.Quorum, false); err != nil {
+		return sdkerrors.Wrap(err, "quorum")
+	}
+	if err := validatePercentage(p.Threshold, true); err != nil {
+		return sdkerrors.Wrap(err, "threshold")
+	}
+	if err := validatePercentage(p.VetoThreshold, true); err != nil {
+		return sdkerrors.Wrap(err, "veto threshold")
+	}
+
+	if p.Timeout <= time.Nanosecond {
+		return sdkerrors.Wrap(errors.ErrInvalid, "timeout")
+	}
+	if p.MinExecutionPeriod < 0 {
+		return sdkerrors.Wrap(errors.ErrInvalid, "min execution period")
+	}
+	return nil
+}
+
+// Allow tallies the votes like x/gov. Once the timeout is reached, the members who haven't voted are left out of the
+// tally: the proposal passes when the votes (including abstain) reach the quorum of the total group weight, the veto
+// votes don't exceed the veto threshold of the votes, and the yes votes exceed the threshold of the non-abstain votes.
+// Before the timeout, the result is final only when the undecided weight can't change it: the proposal is rejected as
+// soon as the veto votes exceed the veto threshold of the total group weight or the yes votes can't exceed the
+// threshold of the total group weight less the abstain votes, and passes once the quorum is reached, the veto votes
+// plus the undecided weight don't exceed the veto threshold of the total group weight, and the yes votes exceed the
+// threshold of the total group weight less the abstain votes.
+func (p QuorumDecisionPolicy) Allow(tally Tally, totalPower string, votingDuration time.Duration) (DecisionPolicyResult, error) {
+	quorum, err := math.NewPositiveDecFromString(p.Quorum)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	threshold, err := math.NewNonNegativeDecFromString(p.Threshold)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	vetoThreshold, err := math.NewNonNegativeDecFromString(p.VetoThreshold)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	totalPowerDec, err := math.NewNonNegativeDecFromString(totalPower)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	yesCount, err := tally.GetYesCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	abstainCount, err := tally.GetAbstainCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	vetoCount, err := tally.GetVetoCount()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	totalCounts, err := tally.TotalCounts()
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	minVotes, err := quorum.Mul(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	// Once the voting period has ended, the members who haven't voted are left out of the tally.
+	if p.Timeout <= votingDuration {
+		if totalCounts.Cmp(minVotes) < 0 {
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		}
+		maxVetoCount, err := vetoThreshold.Mul(totalCounts)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		if vetoCount.Cmp(maxVetoCount) > 0 {
+			return DecisionPolicyResult{Allow: false, Final: true}, nil
+		}
+		nonAbstain, err := math.SubNonNegative(totalCounts, abstainCount)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		minYesCount, err := threshold.Mul(nonAbstain)
+		if err != nil {
+			return DecisionPolicyResult{}, err
+		}
+		return DecisionPolicyResult{Allow: yesCount.Cmp(minYesCount) > 0, Final: true}, nil
+	}
+
+	undecided, err := math.SubNonNegative(totalPowerDec, totalCounts)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+
+	// The proposal is vetoed whatever the undecided members vote when the veto votes exceed the veto threshold of
+	// the total group weight, and it can't pass when the yes votes can't exceed the threshold of the total group
+	// weight less the abstain votes, even if all undecided members vote yes.
+	maxVetoCount, err := vetoThreshold.Mul(totalPowerDec)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if vetoCount.Cmp(maxVetoCount) > 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+	nonAbstain, err := math.SubNonNegative(totalPowerDec, abstainCount)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	minYesCount, err := threshold.Mul(nonAbstain)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	maxYesCount, err := yesCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if maxYesCount.Cmp(minYesCount) <= 0 {
+		return DecisionPolicyResult{Allow: false, Final: true}, nil
+	}
+
+	// The proposal passes whatever the undecided members vote when the quorum is reached, the veto votes can't
+	// exceed the veto threshold even if all undecided members veto, and the yes votes exceed the threshold even if
+	// all undecided members vote no.
+	if totalCounts.Cmp(minVotes) < 0 {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+	vetoAndUndecided, err := vetoCount.Add(undecided)
+	if err != nil {
+		return DecisionPolicyResult{}, err
+	}
+	if vetoAndUndecided.Cmp(maxVetoCount) > 0 {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+	if yesCount.Cmp(minYesCount) <= 0 {
+		return DecisionPolicyResult{Allow: false, Final: false}, nil
+	}
+	return DecisionPolicyResult{Allow: true, Final: true}, nil
+}
+
+// Validate always succeeds, the quorum and thresholds being relative to the total group weight
+func (p *QuorumDecisionPolicy) Validate(g GroupInfo) error {
+	return nil
+}
+
+// validatePercentage returns an error if the percentage isn't a decimal in (0, 1], or in [0, 1) when allowZero
+// is true.
+func validatePercentage(percentage string, allowZero bool) error {
+	var (
+		dec math.Dec
+		err error
+	)
+	if allowZero {
+		dec, err = math.NewNonNegativeDecFromString(percentage)
+	} else {
+		dec, err = math.NewPositiveDecFromString(percentage)
+	}
+	if err != nil {
+		return err
+	}
+
+	one := math.NewDecFromInt64(1)
+	if allowZero && dec.Cmp(one) >= 0 {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "%s should be lower than 1", percentage)
+	}
+	if dec.Cmp(one) > 0 {
+		return sdkerrors.Wrapf(errors.ErrInvalid, "%s should not be greater than 1", percentage)
+	}
+	return nil
+}
+
 var _ orm.Validateable = GroupPolicyInfo{}
 
 // NewGroupPolicyInfo creates a new GroupPolicyInfo instance
@@ -461,6 +712,15 @@ func (t Tally) TotalCounts() (math.Dec, error) {
 	return totalCounts, nil
 }
 
+// undecided returns the weight of the members who haven't voted yet.
+func (t Tally) undecided(totalPower math.Dec) (math.Dec, error) {
+	totalCounts, err := t.TotalCounts()
+	if err != nil {
+		return math.Dec{}, err
+	}
+	return math.SubNonNegative(totalPower, totalCounts)
+}
+
 // ChoiceFromString returns a Choice from a string. It returns an error
 // if the string is invalid.
 func ChoiceFromString(str string) (Choice, error) {
@@ -109,7 +109,7 @@ func (x Proposal_Status) String() string {
 }
 
 func (Proposal_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6, 0}
+	return fileDescriptor_e091dfce5c49c8b6, []int{8, 0}
 }
 
 // Result defines types of proposal results.
@@ -145,7 +145,7 @@ func (x Proposal_Result) String() string {
 }
 
 func (Proposal_Result) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6, 1}
+	return fileDescriptor_e091dfce5c49c8b6, []int{8, 1}
 }
 
 // ExecutorResult defines types of proposal executor results.
@@ -181,7 +181,7 @@ func (x Proposal_ExecutorResult) String() string {
 }
 
 func (Proposal_ExecutorResult) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6, 2}
+	return fileDescriptor_e091dfce5c49c8b6, []int{8, 2}
 }
 
 // Member represents a group member with an account address,
@@ -311,6 +311,10 @@ type ThresholdDecisionPolicy struct {
 	// timeout is the duration from submission of a proposal to the end of voting period
 	// Within this times votes and exec messages can be submitted.
 	Timeout time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// min_execution_period is the minimum duration from submission of a proposal before it can be executed, either
+	// with a MsgExec or automatically at the end of the voting period. It must be lower than the timeout plus the
+	// maximum execution period of the group module.
+	MinExecutionPeriod time.Duration `protobuf:"bytes,3,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
 }
 
 func (m *ThresholdDecisionPolicy) Reset()         { *m = ThresholdDecisionPolicy{} }
@@ -360,6 +364,169 @@ func (m *ThresholdDecisionPolicy) GetTimeout() time.Duration {
 	return 0
 }
 
+func (m *ThresholdDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	if m != nil {
+		return m.MinExecutionPeriod
+	}
+	return 0
+}
+
+// PercentageDecisionPolicy implements the DecisionPolicy interface
+type PercentageDecisionPolicy struct {
+	// percentage is the minimum percentage of the total group weight that must vote yes for a proposal to succeed,
+	// as a decimal in (0, 1].
+	Percentage string `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout time.Duration `protobuf:"bytes,2,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// min_execution_period is the minimum duration from submission of a proposal before it can be executed, either
+	// with a MsgExec or automatically at the end of the voting period. It must be lower than the timeout plus the
+	// maximum execution period of the group module.
+	MinExecutionPeriod time.Duration `protobuf:"bytes,3,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+}
+
+func (m *PercentageDecisionPolicy) Reset()         { *m = PercentageDecisionPolicy{} }
+func (m *PercentageDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*PercentageDecisionPolicy) ProtoMessage()    {}
+func (*PercentageDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e091dfce5c49c8b6, []int{3}
+}
+func (m *PercentageDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PercentageDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PercentageDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PercentageDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PercentageDecisionPolicy.Merge(m, src)
+}
+func (m *PercentageDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PercentageDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PercentageDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PercentageDecisionPolicy proto.InternalMessageInfo
+
+func (m *PercentageDecisionPolicy) GetPercentage() string {
+	if m != nil {
+		return m.Percentage
+	}
+	return ""
+}
+
+func (m *PercentageDecisionPolicy) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *PercentageDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	if m != nil {
+		return m.MinExecutionPeriod
+	}
+	return 0
+}
+
+// QuorumDecisionPolicy implements the DecisionPolicy interface, tallying the votes like x/gov.
+type QuorumDecisionPolicy struct {
+	// quorum is the minimum percentage of the total group weight that must vote (including abstain) for a proposal
+	// to succeed, as a decimal in (0, 1].
+	Quorum string `protobuf:"bytes,1,opt,name=quorum,proto3" json:"quorum,omitempty"`
+	// threshold is the minimum percentage of the weight of the yes votes over the weight of the non-abstain votes
+	// that must be exceeded for a proposal to succeed, as a decimal in [0, 1).
+	Threshold string `protobuf:"bytes,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// veto_threshold is the percentage of the weight of the veto votes over the weight of all the votes above which
+	// a proposal is vetoed, as a decimal in [0, 1).
+	VetoThreshold string `protobuf:"bytes,3,opt,name=veto_threshold,json=vetoThreshold,proto3" json:"veto_threshold,omitempty"`
+	// timeout is the duration from submission of a proposal to the end of voting period
+	// Within this times votes and exec messages can be submitted.
+	Timeout time.Duration `protobuf:"bytes,4,opt,name=timeout,proto3,stdduration" json:"timeout"`
+	// min_execution_period is the minimum duration from submission of a proposal before it can be executed, either
+	// with a MsgExec or automatically at the end of the voting period. It must be lower than the timeout plus the
+	// maximum execution period of the group module.
+	MinExecutionPeriod time.Duration `protobuf:"bytes,5,opt,name=min_execution_period,json=minExecutionPeriod,proto3,stdduration" json:"min_execution_period"`
+}
+
+func (m *QuorumDecisionPolicy) Reset()         { *m = QuorumDecisionPolicy{} }
+func (m *QuorumDecisionPolicy) String() string { return proto.CompactTextString(m) }
+func (*QuorumDecisionPolicy) ProtoMessage()    {}
+func (*QuorumDecisionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e091dfce5c49c8b6, []int{4}
+}
+func (m *QuorumDecisionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuorumDecisionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuorumDecisionPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuorumDecisionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuorumDecisionPolicy.Merge(m, src)
+}
+func (m *QuorumDecisionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuorumDecisionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuorumDecisionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuorumDecisionPolicy proto.InternalMessageInfo
+
+func (m *QuorumDecisionPolicy) GetQuorum() string {
+	if m != nil {
+		return m.Quorum
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetThreshold() string {
+	if m != nil {
+		return m.Threshold
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetVetoThreshold() string {
+	if m != nil {
+		return m.VetoThreshold
+	}
+	return ""
+}
+
+func (m *QuorumDecisionPolicy) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *QuorumDecisionPolicy) GetMinExecutionPeriod() time.Duration {
+	if m != nil {
+		return m.MinExecutionPeriod
+	}
+	return 0
+}
+
 // GroupInfo represents the high-level on-chain information for a group.
 type GroupInfo struct {
 	// group_id is the unique ID of the group.
@@ -383,7 +550,7 @@ func (m *GroupInfo) Reset()         { *m = GroupInfo{} }
 func (m *GroupInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupInfo) ProtoMessage()    {}
 func (*GroupInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{3}
+	return fileDescriptor_e091dfce5c49c8b6, []int{5}
 }
 func (m *GroupInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,7 +633,7 @@ func (m *GroupMember) Reset()         { *m = GroupMember{} }
 func (m *GroupMember) String() string { return proto.CompactTextString(m) }
 func (*GroupMember) ProtoMessage()    {}
 func (*GroupMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{4}
+	return fileDescriptor_e091dfce5c49c8b6, []int{6}
 }
 func (m *GroupMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,7 +699,7 @@ func (m *GroupPolicyInfo) Reset()         { *m = GroupPolicyInfo{} }
 func (m *GroupPolicyInfo) String() string { return proto.CompactTextString(m) }
 func (*GroupPolicyInfo) ProtoMessage()    {}
 func (*GroupPolicyInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{5}
+	return fileDescriptor_e091dfce5c49c8b6, []int{7}
 }
 func (m *GroupPolicyInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -597,13 +764,20 @@ type Proposal struct {
 	ExecutorResult Proposal_ExecutorResult `protobuf:"varint,12,opt,name=executor_result,json=executorResult,proto3,enum=cosmos.group.v1beta1.Proposal_ExecutorResult" json:"executor_result,omitempty"`
 	// msgs is a list of Msgs that will be executed if the proposal passes.
 	Msgs []*types.Any `protobuf:"bytes,13,rep,name=msgs,proto3" json:"msgs,omitempty"`
+	// auto_exec defines whether the proposal is executed automatically at the end of its voting period if it is
+	// accepted, it is set with the EXEC_AUTO exec mode of MsgCreateProposal or MsgVote.
+	AutoExec bool `protobuf:"varint,14,opt,name=auto_exec,json=autoExec,proto3" json:"auto_exec,omitempty"`
+	// process_time is the time at which the group EndBlocker processes the accepted proposal again after the end of its
+	// voting period: the time at which it is executed automatically if its min execution period hasn't elapsed yet, or
+	// else the time at which it is pruned. It is unset before the end of the voting period.
+	ProcessTime time.Time `protobuf:"bytes,15,opt,name=process_time,json=processTime,proto3,stdtime" json:"process_time"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{6}
+	return fileDescriptor_e091dfce5c49c8b6, []int{8}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +822,7 @@ func (m *Tally) Reset()         { *m = Tally{} }
 func (m *Tally) String() string { return proto.CompactTextString(m) }
 func (*Tally) ProtoMessage()    {}
 func (*Tally) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{7}
+	return fileDescriptor_e091dfce5c49c8b6, []int{9}
 }
 func (m *Tally) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +869,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e091dfce5c49c8b6, []int{8}
+	return fileDescriptor_e091dfce5c49c8b6, []int{10}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -767,6 +941,8 @@ func init() {
 	proto.RegisterType((*Member)(nil), "cosmos.group.v1beta1.Member")
 	proto.RegisterType((*Members)(nil), "cosmos.group.v1beta1.Members")
 	proto.RegisterType((*ThresholdDecisionPolicy)(nil), "cosmos.group.v1beta1.ThresholdDecisionPolicy")
+	proto.RegisterType((*PercentageDecisionPolicy)(nil), "cosmos.group.v1beta1.PercentageDecisionPolicy")
+	proto.RegisterType((*QuorumDecisionPolicy)(nil), "cosmos.group.v1beta1.QuorumDecisionPolicy")
 	proto.RegisterType((*GroupInfo)(nil), "cosmos.group.v1beta1.GroupInfo")
 	proto.RegisterType((*GroupMember)(nil), "cosmos.group.v1beta1.GroupMember")
 	proto.RegisterType((*GroupPolicyInfo)(nil), "cosmos.group.v1beta1.GroupPolicyInfo")
@@ -778,92 +954,100 @@ func init() {
 func init() { proto.RegisterFile("cosmos/group/v1beta1/types.proto", fileDescriptor_e091dfce5c49c8b6) }
 
 var fileDescriptor_e091dfce5c49c8b6 = []byte{
-	// 1345 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0x3a, 0x8e, 0xff, 0xbc, 0x4e, 0x1c, 0x6b, 0x94, 0x1f, 0x38, 0x0e, 0x38, 0x8b, 0xf9,
-	0x55, 0x8a, 0x5a, 0xc5, 0x6e, 0xd2, 0x3f, 0x07, 0x54, 0x68, 0xd7, 0x9b, 0x0d, 0xb8, 0x0a, 0x76,
-	0xba, 0xbb, 0x4e, 0x5a, 0x0e, 0xb5, 0xd6, 0xbb, 0x83, 0xb3, 0xad, 0xbd, 0x63, 0xed, 0x8e, 0x03,
-	0xee, 0xb5, 0x17, 0xea, 0x13, 0xc7, 0xf6, 0x60, 0x09, 0xa9, 0x9f, 0xa0, 0x12, 0x1f, 0x02, 0xf5,
-	0x84, 0x7a, 0xaa, 0x38, 0xb4, 0x15, 0x5c, 0x38, 0x57, 0xfd, 0x00, 0xd5, 0xce, 0xcc, 0x12, 0x0c,
-	0xc6, 0x10, 0xd4, 0x53, 0x76, 0x66, 0x9e, 0xe7, 0x9d, 0xf7, 0x7d, 0x9e, 0x37, 0x33, 0x63, 0x90,
-	0x6d, 0x12, 0xf4, 0x48, 0x50, 0xe9, 0xf8, 0x64, 0xd0, 0xaf, 0x1c, 0x6f, 0xb5, 0x31, 0xb5, 0xb6,
-	0x2a, 0x74, 0xd8, 0xc7, 0x41, 0xb9, 0xef, 0x13, 0x4a, 0xd0, 0x0a, 0x47, 0x94, 0x19, 0xa2, 0x2c,
-	0x10, 0x85, 0x95, 0x0e, 0xe9, 0x10, 0x06, 0xa8, 0x84, 0x5f, 0x1c, 0x5b, 0x28, 0x76, 0x08, 0xe9,
-	0x74, 0x71, 0x85, 0x8d, 0xda, 0x83, 0x9b, 0x15, 0x67, 0xe0, 0x5b, 0xd4, 0x25, 0x9e, 0x58, 0x5f,
-	0x7f, 0x71, 0x9d, 0xba, 0x3d, 0x1c, 0x50, 0xab, 0xd7, 0x17, 0x80, 0x55, 0xbe, 0x59, 0x8b, 0x47,
-	0x16, 0x3b, 0x8b, 0xa5, 0x17, 0xb9, 0x96, 0x37, 0xe4, 0x4b, 0xa5, 0x5f, 0x24, 0x48, 0x5c, 0xc7,
-	0xbd, 0x36, 0xf6, 0xd1, 0x36, 0x24, 0x2d, 0xc7, 0xf1, 0x71, 0x10, 0xe4, 0x25, 0x59, 0xda, 0x48,
-	0x57, 0xf3, 0xbf, 0xdd, 0xdf, 0x8c, 0x4a, 0x50, 0xf8, 0x8a, 0x41, 0x7d, 0xd7, 0xeb, 0xe8, 0x11,
-	0x10, 0x9d, 0x81, 0xc4, 0x2d, 0xec, 0x76, 0x8e, 0x68, 0x3e, 0x16, 0x52, 0x74, 0x31, 0x42, 0x05,
-	0x48, 0xf5, 0x30, 0xb5, 0x1c, 0x8b, 0x5a, 0xf9, 0x79, 0x59, 0xda, 0x58, 0xd4, 0x9f, 0x8d, 0xd1,
-	0xa7, 0x90, 0xb2, 0x1c, 0x07, 0x3b, 0x2d, 0x8b, 0xe6, 0xe3, 0xb2, 0xb4, 0x91, 0xd9, 0x2e, 0x94,
-	0x79, 0x82, 0xe5, 0x28, 0xc1, 0xb2, 0x19, 0x15, 0x57, 0x4d, 0x3d, 0xf8, 0x63, 0x7d, 0xee, 0xee,
-	0x9f, 0xeb, 0x12, 0xdb, 0x14, 0x3b, 0x0a, 0x2d, 0x5d, 0x85, 0x24, 0x4f, 0x39, 0x40, 0x9f, 0x40,
-	0xb2, 0xc7, 0x3f, 0xf3, 0x92, 0x3c, 0xbf, 0x91, 0xd9, 0x3e, 0x57, 0x9e, 0xa6, 0x79, 0x99, 0xe3,
-	0xab, 0xf1, 0x30, 0x98, 0x1e, 0x51, 0x4a, 0x23, 0x09, 0xce, 0x9a, 0x47, 0x3e, 0x0e, 0x8e, 0x48,
-	0xd7, 0xd9, 0xc1, 0xb6, 0x1b, 0xb8, 0xc4, 0xdb, 0x27, 0x5d, 0xd7, 0x1e, 0xa2, 0x73, 0x90, 0xa6,
-	0xd1, 0x12, 0xd7, 0x43, 0x3f, 0x99, 0x40, 0x97, 0x21, 0x19, 0xea, 0x4f, 0x06, 0xbc, 0xf0, 0xcc,
-	0xf6, 0xea, 0x4b, 0x25, 0xec, 0x08, 0xff, 0x78, 0x05, 0x3f, 0xb2, 0x0a, 0x04, 0xe7, 0x12, 0xfa,
-	0xf5, 0xfe, 0x66, 0x76, 0x72, 0xc3, 0xd2, 0x3f, 0x12, 0xa4, 0xaf, 0x86, 0x49, 0xd7, 0xbc, 0x9b,
-	0x04, 0xad, 0x42, 0x8a, 0x55, 0xd0, 0x72, 0xf9, 0xee, 0x71, 0x3d, 0xc9, 0xc6, 0x35, 0x07, 0x95,
-	0x61, 0xc1, 0x72, 0x7a, 0xae, 0xc7, 0x25, 0x9f, 0xe1, 0x12, 0x87, 0xcd, 0xf4, 0x22, 0x0f, 0xc9,
-	0x63, 0xec, 0x87, 0x59, 0x30, 0x2b, 0xe2, 0x7a, 0x34, 0x44, 0x17, 0x60, 0x91, 0x12, 0x6a, 0x75,
-	0x5b, 0xc2, 0xdf, 0x05, 0x26, 0x41, 0x86, 0xcd, 0x1d, 0x72, 0x93, 0x55, 0x00, 0xdb, 0xc7, 0x16,
-	0xe5, 0x56, 0x26, 0x4e, 0x61, 0x65, 0x5a, 0xf0, 0x14, 0x5a, 0xfa, 0x1a, 0x32, 0xac, 0x6a, 0xd1,
-	0x84, 0x33, 0xea, 0xfe, 0x10, 0x12, 0xdc, 0x38, 0x21, 0xf9, 0x4c, 0xab, 0x75, 0x81, 0x2d, 0x3d,
-	0x8d, 0xc1, 0x32, 0xdb, 0x80, 0xcb, 0xcc, 0xc4, 0x7d, 0x9b, 0x4e, 0x7f, 0x3e, 0xb1, 0xd8, 0x2b,
-	0x0c, 0x99, 0x3f, 0xbd, 0x21, 0xf1, 0x57, 0x1b, 0xb2, 0x30, 0x69, 0xc8, 0x17, 0xb0, 0xec, 0x88,
-	0x8e, 0x69, 0xf5, 0x59, 0x2d, 0x42, 0xf2, 0x95, 0x97, 0x24, 0x57, 0xbc, 0x61, 0x75, 0x4a, 0x8b,
-	0xe9, 0x59, 0x67, 0xb2, 0xc7, 0x27, 0x0d, 0x4c, 0xbe, 0x95, 0x81, 0x97, 0x52, 0x77, 0xee, 0xad,
-	0xcf, 0x3d, 0xbd, 0xb7, 0x2e, 0x95, 0x1e, 0x65, 0x20, 0xb5, 0xef, 0x93, 0x3e, 0x09, 0xac, 0x2e,
-	0x5a, 0x87, 0x4c, 0x5f, 0x7c, 0x9f, 0x78, 0x09, 0xd1, 0x54, 0xcd, 0x79, 0xde, 0x84, 0xd8, 0x9b,
-	0x9a, 0x30, 0xab, 0x95, 0x3f, 0x86, 0x34, 0x8f, 0x1e, 0x1e, 0x06, 0x71, 0x79, 0x7e, 0x66, 0xc4,
-	0x13, 0x28, 0xba, 0x0a, 0x8b, 0xc1, 0xa0, 0xdd, 0x73, 0xa9, 0x90, 0x61, 0xe1, 0x14, 0x32, 0x64,
-	0x9e, 0x31, 0x15, 0x8a, 0x2e, 0xc2, 0x12, 0xef, 0x90, 0xc8, 0xc0, 0x04, 0xab, 0x79, 0x91, 0x4d,
-	0x1e, 0x08, 0x17, 0xdf, 0x87, 0x15, 0x0e, 0xe2, 0x16, 0x3e, 0xc3, 0x26, 0x19, 0x16, 0x75, 0x4e,
-	0x3a, 0x35, 0x62, 0x5c, 0x86, 0x44, 0x40, 0x2d, 0x3a, 0x08, 0xf2, 0x29, 0x59, 0xda, 0xc8, 0x6e,
-	0xbf, 0x33, 0xbd, 0xed, 0x23, 0xe1, 0xcb, 0x06, 0x03, 0xeb, 0x82, 0x14, 0xd2, 0x7d, 0x1c, 0x0c,
-	0xba, 0x34, 0x9f, 0x7e, 0x23, 0xba, 0xce, 0xc0, 0xba, 0x20, 0xa1, 0xcf, 0x00, 0x8e, 0x09, 0xc5,
-	0xad, 0x30, 0x1a, 0xce, 0x03, 0xd3, 0x66, 0x6d, 0x7a, 0x08, 0xd3, 0xea, 0x76, 0x87, 0xe2, 0x88,
-	0x4d, 0x87, 0xa4, 0x30, 0x13, 0x8c, 0xae, 0x9c, 0x1c, 0x95, 0x99, 0xd3, 0x9c, 0xf6, 0x82, 0x84,
-	0x0e, 0x60, 0x19, 0xdf, 0xc6, 0xf6, 0x80, 0x12, 0xbf, 0x25, 0x2a, 0x59, 0x64, 0x95, 0x6c, 0xbe,
-	0xa6, 0x12, 0x4d, 0xb0, 0x44, 0x45, 0x59, 0x3c, 0x31, 0x46, 0x1b, 0x10, 0xef, 0x05, 0x9d, 0x20,
-	0xbf, 0xc4, 0xee, 0x8d, 0xa9, 0xff, 0x44, 0x3a, 0x43, 0x94, 0xbe, 0x8f, 0x41, 0x82, 0xab, 0x8a,
-	0xb6, 0x00, 0x19, 0xa6, 0x62, 0x36, 0x8d, 0x56, 0xb3, 0x6e, 0xec, 0x6b, 0x6a, 0x6d, 0xb7, 0xa6,
-	0xed, 0xe4, 0xe6, 0x0a, 0xab, 0xa3, 0xb1, 0xfc, 0xbf, 0x68, 0x67, 0x8e, 0xad, 0x79, 0xc7, 0x56,
-	0xd7, 0x75, 0xd0, 0x16, 0xe4, 0x04, 0xc5, 0x68, 0x56, 0xaf, 0xd7, 0x4c, 0x53, 0xdb, 0xc9, 0x49,
-	0x85, 0xb5, 0xd1, 0x58, 0x3e, 0x3b, 0x49, 0x30, 0xa2, 0x5e, 0x42, 0xef, 0xc1, 0x92, 0xa0, 0xa8,
-	0x7b, 0x0d, 0x43, 0xdb, 0xc9, 0xc5, 0x0a, 0xf9, 0xd1, 0x58, 0x5e, 0x99, 0xc4, 0xab, 0x5d, 0x12,
-	0x60, 0x07, 0x6d, 0x42, 0x56, 0x80, 0x95, 0x6a, 0x43, 0x0f, 0xa3, 0xcf, 0x4f, 0x4b, 0x47, 0x69,
-	0x13, 0x3f, 0x8c, 0x7d, 0x92, 0xce, 0x61, 0xcd, 0xbc, 0xb6, 0xa3, 0x2b, 0x87, 0xf5, 0x5c, 0x7c,
-	0x5a, 0x3a, 0x87, 0x2e, 0x3d, 0x72, 0x7c, 0xeb, 0x96, 0x57, 0x88, 0xdf, 0xf9, 0xb9, 0x38, 0x57,
-	0x7a, 0x24, 0x41, 0x42, 0x48, 0xb7, 0x05, 0x48, 0xd7, 0x8c, 0xe6, 0x9e, 0x39, 0x4b, 0x05, 0x8e,
-	0x8d, 0x54, 0xf8, 0xe8, 0x39, 0xca, 0x6e, 0xad, 0xae, 0xec, 0xd5, 0x6e, 0x30, 0x1d, 0xce, 0x8f,
-	0xc6, 0xf2, 0xea, 0x24, 0xa5, 0xe9, 0xdd, 0x74, 0x3d, 0xab, 0xeb, 0x7e, 0x87, 0x1d, 0x54, 0x81,
-	0x65, 0x41, 0x53, 0x54, 0x55, 0xdb, 0x37, 0x99, 0x16, 0x85, 0xd1, 0x58, 0x3e, 0x33, 0xc9, 0x51,
-	0x6c, 0x1b, 0xf7, 0xe9, 0x04, 0x41, 0xd7, 0x3e, 0xd7, 0x54, 0x2e, 0xc7, 0x14, 0x82, 0x8e, 0xbf,
-	0xc1, 0x36, 0xc5, 0x8e, 0x28, 0xee, 0xa7, 0x18, 0x64, 0x27, 0xfb, 0x05, 0x55, 0x61, 0x4d, 0xfb,
-	0x52, 0x53, 0x9b, 0x66, 0x43, 0x6f, 0x4d, 0xad, 0xf6, 0xc2, 0x68, 0x2c, 0x9f, 0x8f, 0xa2, 0x4e,
-	0x92, 0xa3, 0xaa, 0x2f, 0xc3, 0xd9, 0x17, 0x63, 0xd4, 0x1b, 0x66, 0x4b, 0x6f, 0xd6, 0x73, 0x52,
-	0x41, 0x1e, 0x8d, 0xe5, 0x73, 0xd3, 0xf9, 0x75, 0x42, 0xf5, 0x81, 0x87, 0xae, 0xbc, 0x4c, 0x37,
-	0x9a, 0xaa, 0xaa, 0x19, 0x46, 0x2e, 0x36, 0x6b, 0x7b, 0x63, 0x60, 0xdb, 0xe1, 0x71, 0x39, 0x85,
-	0xbf, 0xab, 0xd4, 0xf6, 0x9a, 0xba, 0x96, 0x9b, 0x9f, 0xc5, 0xdf, 0xb5, 0xdc, 0xee, 0xc0, 0xc7,
-	0x5c, 0x9b, 0x4b, 0xf1, 0xf0, 0x80, 0x2f, 0xfd, 0x20, 0xc1, 0x02, 0xfb, 0x0f, 0x47, 0x6b, 0x90,
-	0x1e, 0xe2, 0xa0, 0x65, 0x93, 0x81, 0x47, 0xc5, 0xcb, 0x28, 0x35, 0xc4, 0x81, 0x1a, 0x8e, 0xc3,
-	0x6b, 0xd2, 0x23, 0x62, 0x8d, 0x3f, 0x09, 0x93, 0x1e, 0xe1, 0x4b, 0x17, 0x61, 0xc9, 0x6a, 0x07,
-	0xd4, 0x72, 0x3d, 0xb1, 0xce, 0xae, 0x4b, 0x7d, 0x51, 0x4c, 0x72, 0xd0, 0x79, 0x80, 0x63, 0x4c,
-	0xa3, 0x08, 0x71, 0xfe, 0xee, 0x0a, 0x67, 0xd8, 0xb2, 0xc8, 0xe5, 0x6f, 0x09, 0xe2, 0x07, 0x84,
-	0xe2, 0xd7, 0x5f, 0x32, 0x65, 0x58, 0x08, 0x4f, 0x22, 0xff, 0xf5, 0x6f, 0x25, 0x06, 0x0b, 0xdf,
-	0x18, 0xf6, 0x11, 0x71, 0x6d, 0xcc, 0x92, 0xcb, 0xbe, 0xea, 0x8d, 0xa1, 0x32, 0x8c, 0x2e, 0xb0,
-	0x33, 0x2f, 0xf4, 0xff, 0xea, 0x7a, 0x79, 0xd7, 0x81, 0x04, 0xdf, 0x16, 0x9d, 0x01, 0xa4, 0x5e,
-	0x6b, 0xd4, 0x54, 0x6d, 0xb2, 0x21, 0xd1, 0x12, 0xa4, 0xc5, 0x7c, 0xbd, 0x91, 0x93, 0x50, 0x16,
-	0x40, 0x0c, 0xbf, 0xd2, 0x8c, 0x5c, 0x0c, 0x21, 0xc8, 0x8a, 0xb1, 0x52, 0x35, 0x4c, 0xa5, 0x56,
-	0xcf, 0xcd, 0xa3, 0x65, 0xc8, 0x88, 0xb9, 0x03, 0xcd, 0x6c, 0xe4, 0xe2, 0xd5, 0x2b, 0x0f, 0x1e,
-	0x17, 0xa5, 0x87, 0x8f, 0x8b, 0xd2, 0x5f, 0x8f, 0x8b, 0xd2, 0xdd, 0x27, 0xc5, 0xb9, 0x87, 0x4f,
-	0x8a, 0x73, 0xbf, 0x3f, 0x29, 0xce, 0xdd, 0xf8, 0x7f, 0xc7, 0xa5, 0x47, 0x83, 0x76, 0xd9, 0x26,
-	0x3d, 0xf1, 0xeb, 0x42, 0xfc, 0xd9, 0x0c, 0x9c, 0x6f, 0x2b, 0xb7, 0xf9, 0xcf, 0xa0, 0x76, 0x82,
-	0x15, 0xf4, 0xc1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x6d, 0xca, 0xed, 0x16, 0x1d, 0x0d, 0x00,
-	0x00,
+	// 1488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0x4f, 0x6f, 0xdb, 0x46,
+	0x16, 0x37, 0x25, 0x59, 0x7f, 0x9e, 0x6c, 0x59, 0x18, 0x78, 0x1d, 0x5a, 0x4e, 0x64, 0x46, 0xd9,
+	0x00, 0xc6, 0x2e, 0x2c, 0xad, 0xbd, 0xbb, 0x3d, 0x04, 0x4d, 0x5a, 0x49, 0xa6, 0x13, 0x15, 0x8e,
+	0xec, 0x50, 0x92, 0xdd, 0xe6, 0x50, 0x82, 0x22, 0x27, 0x32, 0x5b, 0x89, 0xa3, 0x92, 0x43, 0x27,
+	0xee, 0xb5, 0x97, 0xd4, 0xa7, 0x1c, 0xdb, 0x83, 0x80, 0x00, 0xfd, 0x04, 0x05, 0xf2, 0x01, 0x7a,
+	0x0c, 0x7a, 0x0a, 0x7a, 0x2a, 0x7a, 0xe8, 0x9f, 0xa4, 0x87, 0x9c, 0x8b, 0x7e, 0x80, 0x82, 0x33,
+	0x43, 0xdb, 0x72, 0x64, 0x39, 0x0e, 0x72, 0xe9, 0xc9, 0x9a, 0xf7, 0x7e, 0xbf, 0x99, 0xf7, 0x7b,
+	0xef, 0xf1, 0xcd, 0x18, 0x14, 0x93, 0x78, 0x3d, 0xe2, 0x95, 0x3a, 0x2e, 0xf1, 0xfb, 0xa5, 0xbd,
+	0x95, 0x36, 0xa6, 0xc6, 0x4a, 0x89, 0xee, 0xf7, 0xb1, 0x57, 0xec, 0xbb, 0x84, 0x12, 0x34, 0xcb,
+	0x11, 0x45, 0x86, 0x28, 0x0a, 0x44, 0x6e, 0xb6, 0x43, 0x3a, 0x84, 0x01, 0x4a, 0xc1, 0x2f, 0x8e,
+	0xcd, 0xe5, 0x3b, 0x84, 0x74, 0xba, 0xb8, 0xc4, 0x56, 0x6d, 0xff, 0x5e, 0xc9, 0xf2, 0x5d, 0x83,
+	0xda, 0xc4, 0x11, 0xfe, 0xc5, 0x93, 0x7e, 0x6a, 0xf7, 0xb0, 0x47, 0x8d, 0x5e, 0x5f, 0x00, 0xe6,
+	0xf9, 0x61, 0x3a, 0xdf, 0x59, 0x9c, 0x2c, 0x5c, 0x27, 0xb9, 0x86, 0xb3, 0xcf, 0x5d, 0x85, 0x6f,
+	0x25, 0x88, 0xdf, 0xc6, 0xbd, 0x36, 0x76, 0xd1, 0x2a, 0x24, 0x0c, 0xcb, 0x72, 0xb1, 0xe7, 0xc9,
+	0x92, 0x22, 0x2d, 0xa5, 0x2a, 0xf2, 0x0f, 0x4f, 0x96, 0x43, 0x09, 0x65, 0xee, 0x69, 0x50, 0xd7,
+	0x76, 0x3a, 0x5a, 0x08, 0x44, 0x73, 0x10, 0xbf, 0x8f, 0xed, 0xce, 0x2e, 0x95, 0x23, 0x01, 0x45,
+	0x13, 0x2b, 0x94, 0x83, 0x64, 0x0f, 0x53, 0xc3, 0x32, 0xa8, 0x21, 0x47, 0x15, 0x69, 0x69, 0x4a,
+	0x3b, 0x5c, 0xa3, 0xf7, 0x20, 0x69, 0x58, 0x16, 0xb6, 0x74, 0x83, 0xca, 0x31, 0x45, 0x5a, 0x4a,
+	0xaf, 0xe6, 0x8a, 0x3c, 0xc0, 0x62, 0x18, 0x60, 0xb1, 0x19, 0x8a, 0xab, 0x24, 0x9f, 0xfe, 0xbc,
+	0x38, 0xf1, 0xe8, 0x97, 0x45, 0x89, 0x1d, 0x8a, 0xad, 0x32, 0x2d, 0xdc, 0x84, 0x04, 0x0f, 0xd9,
+	0x43, 0xef, 0x42, 0xa2, 0xc7, 0x7f, 0xca, 0x92, 0x12, 0x5d, 0x4a, 0xaf, 0x5e, 0x2c, 0x8e, 0xca,
+	0x79, 0x91, 0xe3, 0x2b, 0xb1, 0x60, 0x33, 0x2d, 0xa4, 0x14, 0x7e, 0x93, 0xe0, 0x42, 0x73, 0xd7,
+	0xc5, 0xde, 0x2e, 0xe9, 0x5a, 0x6b, 0xd8, 0xb4, 0x3d, 0x9b, 0x38, 0x5b, 0xa4, 0x6b, 0x9b, 0xfb,
+	0xe8, 0x22, 0xa4, 0x68, 0xe8, 0xe2, 0xf9, 0xd0, 0x8e, 0x0c, 0xe8, 0x3a, 0x24, 0x82, 0xfc, 0x13,
+	0x9f, 0x0b, 0x4f, 0xaf, 0xce, 0xbf, 0x22, 0x61, 0x4d, 0xd4, 0x8f, 0x2b, 0xf8, 0x8a, 0x29, 0x10,
+	0x1c, 0xd4, 0x82, 0xd9, 0x9e, 0xed, 0xe8, 0xf8, 0x01, 0x36, 0xfd, 0x00, 0xa3, 0xf7, 0xb1, 0x6b,
+	0x13, 0x4b, 0x8e, 0xbe, 0xfe, 0x5e, 0xa8, 0x67, 0x3b, 0x6a, 0xc8, 0xdf, 0x62, 0xf4, 0x6b, 0xe8,
+	0xfb, 0x27, 0xcb, 0x99, 0x61, 0x1d, 0x85, 0xdf, 0x25, 0x90, 0xb7, 0xb0, 0x6b, 0x62, 0x87, 0x1a,
+	0x1d, 0x7c, 0x42, 0x64, 0x1e, 0xa0, 0x7f, 0xe8, 0x13, 0x2a, 0x8f, 0x59, 0xfe, 0x46, 0x32, 0x07,
+	0x11, 0x98, 0xbd, 0xe3, 0x13, 0xd7, 0xef, 0x9d, 0x90, 0x38, 0x07, 0xf1, 0xcf, 0x98, 0x5d, 0xc8,
+	0x13, 0xab, 0xe1, 0xfa, 0x46, 0x4e, 0xd6, 0xf7, 0x2a, 0x64, 0xf6, 0x30, 0x25, 0xfa, 0x11, 0x24,
+	0xca, 0x20, 0xd3, 0x81, 0xb5, 0x39, 0xaa, 0x0d, 0x62, 0x6f, 0x31, 0x3f, 0x93, 0x6f, 0x3f, 0x3f,
+	0x7f, 0x4a, 0x90, 0xba, 0x19, 0x7c, 0x12, 0x35, 0xe7, 0x1e, 0x41, 0xf3, 0x90, 0x64, 0xdf, 0x87,
+	0x6e, 0xf3, 0xde, 0x8e, 0x69, 0x09, 0xb6, 0xae, 0x59, 0xa8, 0x08, 0x93, 0x86, 0xd5, 0xb3, 0x1d,
+	0x39, 0x72, 0xc6, 0x0c, 0xe0, 0xb0, 0xb1, 0x5f, 0xba, 0x0c, 0x89, 0x3d, 0xec, 0x06, 0x51, 0xb0,
+	0xf4, 0xc4, 0xb4, 0x70, 0x89, 0x2e, 0xc3, 0x14, 0x25, 0xd4, 0xe8, 0xea, 0x62, 0x7a, 0x4c, 0xb2,
+	0xec, 0xa6, 0x99, 0x6d, 0x87, 0x99, 0x50, 0x15, 0xc0, 0x74, 0xb1, 0x41, 0xf9, 0xa0, 0x88, 0x9f,
+	0x63, 0x50, 0xa4, 0x04, 0xaf, 0x4c, 0x0b, 0x1f, 0x43, 0x9a, 0xa9, 0x16, 0x23, 0x6e, 0x8c, 0xee,
+	0xff, 0x41, 0x9c, 0x8f, 0x05, 0xd1, 0xe9, 0x63, 0x07, 0x89, 0x26, 0xb0, 0x85, 0x97, 0x11, 0x98,
+	0x61, 0x07, 0xf0, 0x34, 0xb3, 0xe4, 0xbe, 0xc9, 0x1c, 0x3d, 0x1e, 0x58, 0xe4, 0x94, 0x82, 0x44,
+	0xcf, 0x5f, 0x90, 0xd8, 0xe9, 0x05, 0x99, 0x1c, 0x2e, 0xc8, 0x1d, 0x98, 0xb1, 0x44, 0xc7, 0xe8,
+	0x7d, 0xa6, 0x45, 0xa4, 0x7c, 0xf6, 0x95, 0x94, 0x97, 0x9d, 0xfd, 0xca, 0x88, 0x16, 0xd3, 0x32,
+	0xd6, 0xf0, 0x97, 0x37, 0x5c, 0xc0, 0xc4, 0x1b, 0x15, 0xf0, 0x5a, 0xf2, 0xe1, 0xe3, 0xc5, 0x89,
+	0x97, 0x8f, 0x17, 0xa5, 0xc2, 0x77, 0x53, 0x90, 0xdc, 0x72, 0x49, 0x9f, 0x78, 0x46, 0x17, 0x2d,
+	0x42, 0xba, 0x2f, 0x7e, 0x1f, 0xd5, 0x12, 0x42, 0x53, 0xcd, 0x3a, 0x5e, 0x84, 0xc8, 0xeb, 0x16,
+	0x61, 0x5c, 0x2b, 0xbf, 0x03, 0x29, 0xbe, 0x7b, 0x70, 0xd5, 0xc4, 0x94, 0xe8, 0xd8, 0x1d, 0x8f,
+	0xa0, 0xe8, 0x26, 0x4c, 0x79, 0x7e, 0xbb, 0x67, 0x53, 0x91, 0x86, 0xc9, 0x73, 0xa4, 0x21, 0x7d,
+	0xc8, 0x2c, 0x53, 0x74, 0x05, 0xa6, 0x79, 0x87, 0x84, 0x05, 0x8c, 0x33, 0xcd, 0x53, 0xcc, 0xb8,
+	0x2d, 0xaa, 0xf8, 0x1f, 0x98, 0xe5, 0x20, 0x5e, 0xc2, 0x43, 0x6c, 0x82, 0x61, 0x51, 0xe7, 0xa8,
+	0x53, 0x43, 0xc6, 0x75, 0x88, 0x7b, 0xd4, 0xa0, 0xbe, 0x27, 0x27, 0x15, 0x69, 0x29, 0xb3, 0x7a,
+	0x75, 0x74, 0xdb, 0x87, 0x89, 0x2f, 0x36, 0x18, 0x58, 0x13, 0xa4, 0x80, 0xee, 0x62, 0xcf, 0xef,
+	0x52, 0x39, 0xf5, 0x5a, 0x74, 0x8d, 0x81, 0x35, 0x41, 0x42, 0xef, 0x03, 0xec, 0x11, 0x8a, 0xf5,
+	0x60, 0x37, 0x2c, 0x03, 0xcb, 0xcd, 0xc2, 0xe8, 0x2d, 0x9a, 0x46, 0xb7, 0xbb, 0x2f, 0x2e, 0xf0,
+	0x54, 0x40, 0x0a, 0x22, 0xc1, 0xe8, 0xc6, 0xd1, 0x04, 0x4e, 0x9f, 0xe7, 0x2d, 0x11, 0x8e, 0xe0,
+	0x6d, 0x98, 0xe1, 0xe3, 0x97, 0xb8, 0xba, 0x50, 0x32, 0xc5, 0x94, 0x2c, 0x9f, 0xa1, 0x44, 0x15,
+	0x2c, 0xa1, 0x28, 0x83, 0x87, 0xd6, 0x68, 0x09, 0x62, 0x3d, 0xaf, 0xe3, 0xc9, 0xd3, 0x4a, 0xf4,
+	0xb4, 0x8f, 0x48, 0x63, 0x08, 0xb4, 0x00, 0x29, 0xc3, 0xa7, 0x84, 0xdd, 0x02, 0x72, 0x46, 0x91,
+	0x96, 0x92, 0x5a, 0x32, 0x30, 0x04, 0x07, 0x04, 0xed, 0xd3, 0x77, 0x89, 0x89, 0x3d, 0x4f, 0x0f,
+	0x22, 0x96, 0x67, 0xce, 0xd3, 0x3e, 0x82, 0x19, 0xf8, 0x0a, 0x5f, 0x44, 0x20, 0xce, 0x6b, 0x87,
+	0x56, 0x00, 0x35, 0x9a, 0xe5, 0x66, 0xab, 0xa1, 0xb7, 0xea, 0x8d, 0x2d, 0xb5, 0x5a, 0x5b, 0xaf,
+	0xa9, 0x6b, 0xd9, 0x89, 0xdc, 0xfc, 0xc1, 0x40, 0xf9, 0x47, 0xa8, 0x8f, 0x63, 0x6b, 0xce, 0x9e,
+	0xd1, 0xb5, 0x2d, 0xb4, 0x02, 0x59, 0x41, 0x69, 0xb4, 0x2a, 0xb7, 0x6b, 0xcd, 0xa6, 0xba, 0x96,
+	0x95, 0x72, 0x0b, 0x07, 0x03, 0xe5, 0xc2, 0x30, 0xa1, 0x11, 0x76, 0x2c, 0xfa, 0x37, 0x4c, 0x0b,
+	0x4a, 0x75, 0x63, 0xb3, 0xa1, 0xae, 0x65, 0x23, 0x39, 0xf9, 0x60, 0xa0, 0xcc, 0x0e, 0xe3, 0xab,
+	0x5d, 0xe2, 0x61, 0x0b, 0x2d, 0x43, 0x46, 0x80, 0xcb, 0x95, 0x4d, 0x2d, 0xd8, 0x3d, 0x3a, 0x2a,
+	0x9c, 0x72, 0x9b, 0xb8, 0x14, 0x1f, 0x0f, 0x67, 0xa7, 0xd6, 0xbc, 0xb5, 0xa6, 0x95, 0x77, 0xea,
+	0xd9, 0xd8, 0xa8, 0x70, 0x76, 0x6c, 0xba, 0x6b, 0xb9, 0xc6, 0x7d, 0x27, 0x17, 0x7b, 0xf8, 0x4d,
+	0x7e, 0xa2, 0xf0, 0x93, 0x04, 0x71, 0x51, 0xa0, 0x15, 0x40, 0x9a, 0xda, 0x68, 0x6d, 0x34, 0xc7,
+	0x65, 0x81, 0x63, 0xc3, 0x2c, 0xfc, 0xff, 0x18, 0x65, 0xbd, 0x56, 0x2f, 0x6f, 0xd4, 0xee, 0xb2,
+	0x3c, 0x5c, 0x3a, 0x18, 0x28, 0xf3, 0xc3, 0x94, 0x96, 0x73, 0xcf, 0x76, 0x8c, 0xae, 0xfd, 0x39,
+	0xb6, 0x50, 0x09, 0x66, 0x04, 0xad, 0x5c, 0xad, 0xaa, 0x5b, 0x4d, 0x96, 0x8b, 0xdc, 0xc1, 0x40,
+	0x99, 0x1b, 0xe6, 0x94, 0x4d, 0x13, 0xf7, 0xe9, 0x10, 0x41, 0x53, 0x3f, 0x50, 0xab, 0x3c, 0x1d,
+	0x23, 0x08, 0x1a, 0xfe, 0x04, 0x9b, 0x14, 0x5b, 0x42, 0xdc, 0xd7, 0x11, 0xc8, 0x0c, 0x77, 0x25,
+	0xaa, 0xc0, 0x82, 0xfa, 0xa1, 0x5a, 0x6d, 0x35, 0x37, 0x35, 0x7d, 0xa4, 0xda, 0xcb, 0x07, 0x03,
+	0xe5, 0x52, 0xb8, 0xeb, 0x30, 0x39, 0x54, 0x7d, 0x1d, 0x2e, 0x9c, 0xdc, 0xa3, 0xbe, 0xd9, 0xd4,
+	0xb5, 0x56, 0x3d, 0x2b, 0xe5, 0x94, 0x83, 0x81, 0x72, 0x71, 0x34, 0xbf, 0x4e, 0xa8, 0xe6, 0x3b,
+	0xe8, 0xc6, 0xab, 0xf4, 0x46, 0xab, 0x5a, 0x55, 0x1b, 0x8d, 0x6c, 0x64, 0xdc, 0xf1, 0x0d, 0xdf,
+	0x0c, 0x9a, 0x77, 0x14, 0x7f, 0xbd, 0x5c, 0xdb, 0x68, 0x69, 0x6a, 0x36, 0x3a, 0x8e, 0xbf, 0x6e,
+	0xd8, 0x5d, 0xdf, 0xc5, 0x3c, 0x37, 0xd7, 0x62, 0xc1, 0x35, 0x52, 0xf8, 0x52, 0x82, 0x49, 0x36,
+	0x47, 0x82, 0x8f, 0x6e, 0x1f, 0x7b, 0xba, 0x49, 0x7c, 0x87, 0x8a, 0x87, 0x61, 0x72, 0x1f, 0x7b,
+	0xd5, 0x60, 0x1d, 0x5c, 0xc6, 0x0e, 0x11, 0x3e, 0xfe, 0x32, 0x4c, 0x38, 0x84, 0xbb, 0xae, 0xc0,
+	0xb4, 0xd1, 0xf6, 0xa8, 0x61, 0x3b, 0xc2, 0xcf, 0x9f, 0x85, 0x53, 0xc2, 0xc8, 0x41, 0x97, 0x00,
+	0xd8, 0xe3, 0x91, 0x23, 0x62, 0xfc, 0x6d, 0x19, 0x58, 0x98, 0x5b, 0xc4, 0xf2, 0x87, 0x04, 0xb1,
+	0x6d, 0x42, 0xf1, 0xd9, 0x57, 0x59, 0x11, 0x26, 0x83, 0x79, 0xe7, 0x9e, 0xfd, 0x22, 0x63, 0xb0,
+	0xe0, 0x25, 0x63, 0xee, 0x12, 0xdb, 0xc4, 0x2c, 0xb8, 0xcc, 0x69, 0x2f, 0x99, 0x2a, 0xc3, 0x68,
+	0x02, 0x3b, 0xf6, 0xd9, 0xf0, 0xb6, 0x2e, 0xb1, 0x7f, 0x59, 0x10, 0xe7, 0xc7, 0xa2, 0x39, 0x40,
+	0xd5, 0x5b, 0x9b, 0xb5, 0xaa, 0x3a, 0xdc, 0x90, 0x68, 0x1a, 0x52, 0xc2, 0x5e, 0xdf, 0xcc, 0x4a,
+	0x28, 0x03, 0x20, 0x96, 0x1f, 0xa9, 0x8d, 0x6c, 0x04, 0x21, 0xc8, 0x88, 0x75, 0xb9, 0xd2, 0x68,
+	0x96, 0x6b, 0xf5, 0x6c, 0x14, 0xcd, 0x40, 0x5a, 0xd8, 0xb6, 0xd5, 0xe6, 0x66, 0x36, 0x56, 0xb9,
+	0xf1, 0xf4, 0x79, 0x5e, 0x7a, 0xf6, 0x3c, 0x2f, 0xfd, 0xfa, 0x3c, 0x2f, 0x3d, 0x7a, 0x91, 0x9f,
+	0x78, 0xf6, 0x22, 0x3f, 0xf1, 0xe3, 0x8b, 0xfc, 0xc4, 0xdd, 0x7f, 0x76, 0x6c, 0xba, 0xeb, 0xb7,
+	0x8b, 0x26, 0xe9, 0x89, 0xff, 0x90, 0xc5, 0x9f, 0x65, 0xcf, 0xfa, 0xb4, 0xf4, 0x80, 0xff, 0x2b,
+	0xdf, 0x8e, 0x33, 0x41, 0xff, 0xfd, 0x6b, 0x00, 0xcf, 0xce, 0xc2, 0x91, 0xe1, 0x0f, 0x00, 0x00,
 }
 
 func (this *GroupPolicyInfo) Equal(that interface{}) bool {
@@ -1017,19 +1201,133 @@ func (m *ThresholdDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintTypes(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Threshold) > 0 {
+		i -= len(m.Threshold)
+		copy(dAtA[i:], m.Threshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PercentageDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PercentageDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PercentageDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x12
+	if len(m.Percentage) > 0 {
+		i -= len(m.Percentage)
+		copy(dAtA[i:], m.Percentage)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Percentage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuorumDecisionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuorumDecisionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuorumDecisionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinExecutionPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.VetoThreshold) > 0 {
+		i -= len(m.VetoThreshold)
+		copy(dAtA[i:], m.VetoThreshold)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.VetoThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Threshold) > 0 {
 		i -= len(m.Threshold)
 		copy(dAtA[i:], m.Threshold)
 		i = encodeVarintTypes(dAtA, i, uint64(len(m.Threshold)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Quorum) > 0 {
+		i -= len(m.Quorum)
+		copy(dAtA[i:], m.Quorum)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Quorum)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
@@ -1055,12 +1353,12 @@ func (m *GroupInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err3 != nil {
-		return 0, err3
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n3
-	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i -= n8
+	i = encodeVarintTypes(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x32
 	if len(m.TotalWeight) > 0 {
@@ -1157,12 +1455,12 @@ func (m *GroupPolicyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
-	if err5 != nil {
-		return 0, err5
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CreatedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CreatedAt):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n5
-	i = encodeVarintTypes(dAtA, i, uint64(n5))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
 	if m.DecisionPolicy != nil {
@@ -1231,6 +1529,24 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ProcessTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ProcessTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x7a
+	if m.AutoExec {
+		i--
+		if m.AutoExec {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.Msgs) > 0 {
 		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1250,12 +1566,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x60
 	}
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timeout):])
-	if err7 != nil {
-		return 0, err7
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timeout, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timeout):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n7
-	i = encodeVarintTypes(dAtA, i, uint64(n7))
+	i -= n13
+	i = encodeVarintTypes(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x5a
 	{
@@ -1288,12 +1604,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
-	if err9 != nil {
-		return 0, err9
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
+	if err15 != nil {
+		return 0, err15
 	}
-	i -= n9
-	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i -= n15
+	i = encodeVarintTypes(dAtA, i, uint64(n15))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Proposers) > 0 {
@@ -1398,12 +1714,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
-	if err10 != nil {
-		return 0, err10
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.SubmittedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.SubmittedAt):])
+	if err16 != nil {
+		return 0, err16
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n16
+	i = encodeVarintTypes(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Metadata) > 0 {
@@ -1494,6 +1810,50 @@ func (m *ThresholdDecisionPolicy) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
 	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *PercentageDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Percentage)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *QuorumDecisionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Quorum)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Threshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.VetoThreshold)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinExecutionPeriod)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -1625,6 +1985,11 @@ func (m *Proposal) Size() (n int) {
 			n += 1 + l + sovTypes(uint64(l))
 		}
 	}
+	if m.AutoExec {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ProcessTime)
+	n += 1 + l + sovTypes(uint64(l))
 	return n
 }
 
@@ -2043,9 +2408,402 @@ func (m *ThresholdDecisionPolicy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PercentageDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PercentageDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Percentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuorumDecisionPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuorumDecisionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quorum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetoThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VetoThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinExecutionPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinExecutionPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -3017,6 +3775,59 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoExec", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoExec = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ProcessTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -0,0 +1,171 @@
+package group_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+func TestPercentageDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewPercentageDecisionPolicy("0.5", time.Second*10)
+
+	testCases := []struct {
+		name           string
+		tally          group.Tally
+		totalPower     string
+		votingDuration time.Duration
+		exp            group.DecisionPolicyResult
+	}{
+		{
+			"yes votes reach the percentage",
+			group.Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			"4",
+			time.Second,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"yes votes can still reach the percentage",
+			group.Tally{YesCount: "1", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			"4",
+			time.Second,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"yes votes can't reach the percentage",
+			group.Tally{YesCount: "0", NoCount: "3", AbstainCount: "0", VetoCount: "0"},
+			"4",
+			time.Second,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"percentage of a decimal total weight",
+			group.Tally{YesCount: "0.75", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			"1.5",
+			time.Second,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"final tally accepted after timeout",
+			group.Tally{YesCount: "2", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			"4",
+			time.Second * 10,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"final tally rejected after timeout",
+			group.Tally{YesCount: "1", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			"4",
+			time.Second * 10,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := policy.Allow(tc.tally, tc.totalPower, tc.votingDuration)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
+
+func TestQuorumDecisionPolicyAllow(t *testing.T) {
+	policy := group.NewQuorumDecisionPolicy("0.4", "0.5", "0.334", time.Second*10)
+
+	testCases := []struct {
+		name           string
+		tally          group.Tally
+		totalPower     string
+		votingDuration time.Duration
+		exp            group.DecisionPolicyResult
+	}{
+		{
+			"quorum not reached",
+			group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			"10",
+			time.Second,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"undecided members could veto",
+			group.Tally{YesCount: "4", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			"10",
+			time.Second,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"undecided members could vote no",
+			group.Tally{YesCount: "3", NoCount: "1", AbstainCount: "3", VetoCount: "0"},
+			"10",
+			time.Second,
+			group.DecisionPolicyResult{Allow: false, Final: false},
+		},
+		{
+			"passed whatever the undecided members vote",
+			group.Tally{YesCount: "7", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			"10",
+			time.Second,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"passed with abstain votes",
+			group.Tally{YesCount: "3", NoCount: "1", AbstainCount: "6", VetoCount: "0"},
+			"10",
+			time.Second,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"vetoed",
+			group.Tally{YesCount: "6", NoCount: "0", AbstainCount: "0", VetoCount: "4"},
+			"10",
+			time.Second,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"threshold can't be exceeded",
+			group.Tally{YesCount: "2", NoCount: "5", AbstainCount: "0", VetoCount: "0"},
+			"10",
+			time.Second,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"all abstain",
+			group.Tally{YesCount: "0", NoCount: "0", AbstainCount: "10", VetoCount: "0"},
+			"10",
+			time.Second,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"final tally leaves out the members who haven't voted",
+			group.Tally{YesCount: "4", NoCount: "1", AbstainCount: "0", VetoCount: "0"},
+			"10",
+			time.Second * 10,
+			group.DecisionPolicyResult{Allow: true, Final: true},
+		},
+		{
+			"final tally without quorum",
+			group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "0"},
+			"10",
+			time.Second * 10,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+		{
+			"final tally vetoed",
+			group.Tally{YesCount: "3", NoCount: "0", AbstainCount: "0", VetoCount: "2"},
+			"10",
+			time.Second * 10,
+			group.DecisionPolicyResult{Allow: false, Final: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := policy.Allow(tc.tally, tc.totalPower, tc.votingDuration)
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, res)
+		})
+	}
+}
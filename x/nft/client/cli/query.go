@@ -39,6 +39,9 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryOwner(),
 		GetCmdQueryBalance(),
 		GetCmdQuerySupply(),
+		GetCmdQueryApproved(),
+		GetCmdQueryIsApprovedForAll(),
+		GetCmdQueryRoyalty(),
 	)
 	return nftQueryCmd
 }
@@ -274,3 +277,90 @@ func GetCmdQuerySupply() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryApproved implements the query approved command.
+func GetCmdQueryApproved() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approved [class-id] [nft-id]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the address approved to send an NFT.",
+		Example: fmt.Sprintf(`$ %s query %s approved <class-id> <nft-id>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.Approved(cmd.Context(), &nft.QueryApprovedRequest{
+				ClassId: args[0],
+				Id:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIsApprovedForAll implements the query approved-for-all command.
+func GetCmdQueryIsApprovedForAll() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "approved-for-all [owner] [operator]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query whether an operator is approved to send all the NFTs of the owner.",
+		Example: fmt.Sprintf(`$ %s query %s approved-for-all <owner> <operator>`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.IsApprovedForAll(cmd.Context(), &nft.QueryIsApprovedForAllRequest{
+				Owner:    args[0],
+				Operator: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRoyalty implements the query royalty command.
+func GetCmdQueryRoyalty() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "royalty [class-id] [sale-price]",
+		Args:    cobra.ExactArgs(2),
+		Short:   "query the royalty receiver and amount owed on a sale of an NFT of the class.",
+		Example: fmt.Sprintf(`$ %s query %s royalty <class-id> 1000stake`, version.AppName, nft.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			salePrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			queryClient := nft.NewQueryClient(clientCtx)
+			res, err := queryClient.Royalty(cmd.Context(), &nft.QueryRoyaltyRequest{
+				ClassId:   args[0],
+				SalePrice: salePrice,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/testutil/network"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/client/testutil"
+	"github.com/cosmos/cosmos-sdk/x/nft/client/cli"
 
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
@@ -33,6 +34,7 @@ DDzEQAPXBQflzNW6wbne9IfT651zCSm+j1MWaGk=
 	testClassURI         = "class uri"
 	testID               = "kitty1"
 	testURI              = "kitty uri"
+	testMinter           = "cosmos1den8ghmrd3shxu6ld45kuar9wf047h6lnqgw4a"
 )
 
 var (
@@ -42,6 +44,8 @@ var (
 		Symbol:      testClassSymbol,
 		Description: testClassDescription,
 		Uri:         testClassURI,
+		Issuer:      Owner,
+		Minters:     []string{testMinter},
 	}
 
 	ExpNFT = nft.NFT{
@@ -142,6 +146,35 @@ func (s *IntegrationTestSuite) TestCLITxSend() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestCLITxMintAndBurn() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	txArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, OwnerName),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(s.cfg.BondDenom, sdk.NewInt(10))).String()),
+	}
+	const mintedID = "kitty2"
+
+	out, err := ExecMint(val, append([]string{testClassID, mintedID, Owner, fmt.Sprintf("--%s=%s", cli.FlagURI, testURI)}, txArgs...))
+	s.Require().NoError(err)
+	var txResp sdk.TxResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	out, err = ExecBurn(val, append([]string{testClassID, mintedID}, txArgs...))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().Equal(uint32(0), txResp.Code, out.String())
+
+	// burning the nft again fails as it does not exist anymore
+	out, err = ExecBurn(val, append([]string{testClassID, mintedID}, txArgs...))
+	s.Require().NoError(err)
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	s.Require().NotEqual(uint32(0), txResp.Code, out.String())
+}
+
 func (s *IntegrationTestSuite) initAccount() {
 	val := s.network.Validators[0]
 	ctx := val.ClientCtx
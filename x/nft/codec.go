@@ -9,6 +9,13 @@ import (
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSend{},
+		&MsgCreateClass{},
+		&MsgMintNFT{},
+		&MsgBurnNFT{},
+		&MsgUpdateNFT{},
+		&MsgApprove{},
+		&MsgRevoke{},
+		&MsgApproveAll{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
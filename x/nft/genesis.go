@@ -10,6 +10,21 @@ func ValidateGenesis(data GenesisState) error {
 		if err := ValidateClassID(class.Id); err != nil {
 			return err
 		}
+		if class.Issuer != "" {
+			if _, err := sdk.AccAddressFromBech32(class.Issuer); err != nil {
+				return err
+			}
+		}
+		for _, minter := range class.Minters {
+			if _, err := sdk.AccAddressFromBech32(minter); err != nil {
+				return err
+			}
+		}
+		if class.Royalty != nil {
+			if err := ValidateRoyalty(*class.Royalty); err != nil {
+				return err
+			}
+		}
 	}
 	for _, entry := range data.Entries {
 		for _, nft := range entry.Nfts {
@@ -21,6 +36,25 @@ func ValidateGenesis(data GenesisState) error {
 			}
 		}
 	}
+	for _, approval := range data.Approvals {
+		if err := ValidateClassID(approval.ClassId); err != nil {
+			return err
+		}
+		if err := ValidateNFTID(approval.Id); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(approval.Approved); err != nil {
+			return err
+		}
+	}
+	for _, operator := range data.Operators {
+		if _, err := sdk.AccAddressFromBech32(operator.Owner); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(operator.Operator); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
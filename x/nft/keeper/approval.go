@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+// SetApproved defines a method for approving an address to send a nft on behalf of its owner.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) SetApproved(ctx sdk.Context, classID, nftID string, approved sdk.AccAddress) error {
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(approvalStoreKey(classID, nftID), approved.Bytes())
+	return nil
+}
+
+// RevokeApproval defines a method for revoking the approval of a nft.
+// Note: When the upper module uses this method, it needs to authenticate nft
+func (k Keeper) RevokeApproval(ctx sdk.Context, classID, nftID string) error {
+	if !k.HasNFT(ctx, classID, nftID) {
+		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	k.deleteApproval(ctx, classID, nftID)
+	return nil
+}
+
+// GetApproved returns the address approved to send the specified nft, nil if none
+func (k Keeper) GetApproved(ctx sdk.Context, classID, nftID string) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(approvalStoreKey(classID, nftID))
+	if len(bz) == 0 {
+		return nil
+	}
+	return sdk.AccAddress(bz)
+}
+
+// SetOperator approves or revokes the operator to send all the nfts of the owner
+func (k Keeper) SetOperator(ctx sdk.Context, owner, operator sdk.AccAddress, approved bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := operatorStoreKey(owner, operator)
+	if approved {
+		store.Set(key, Placeholder)
+		return
+	}
+	store.Delete(key)
+}
+
+// HasOperator determines whether the operator is approved to send all the nfts of the owner
+func (k Keeper) HasOperator(ctx sdk.Context, owner, operator sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(operatorStoreKey(owner, operator))
+}
+
+// IsOwnerOrApproved determines whether the spender is the owner of the specified nft,
+// the address approved to send it or an operator of its owner
+func (k Keeper) IsOwnerOrApproved(ctx sdk.Context, classID, nftID string, spender sdk.AccAddress) bool {
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Empty() {
+		return false
+	}
+	return owner.Equals(spender) ||
+		spender.Equals(k.GetApproved(ctx, classID, nftID)) ||
+		k.HasOperator(ctx, owner, spender)
+}
+
+// GetApprovals returns all the nft approvals
+func (k Keeper) GetApprovals(ctx sdk.Context) (approvals []*nft.Approval) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), ApprovalKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		classID, nftID := parseApprovalStoreKey(iterator.Key())
+		approvals = append(approvals, &nft.Approval{
+			ClassId:  classID,
+			Id:       nftID,
+			Approved: sdk.AccAddress(iterator.Value()).String(),
+		})
+	}
+	return
+}
+
+// GetOperatorApprovals returns all the operator approvals
+func (k Keeper) GetOperatorApprovals(ctx sdk.Context) (operators []*nft.OperatorApproval) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), OperatorKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		owner, operator := parseOperatorStoreKey(iterator.Key())
+		operators = append(operators, &nft.OperatorApproval{
+			Owner:    owner.String(),
+			Operator: operator.String(),
+		})
+	}
+	return
+}
+
+func (k Keeper) deleteApproval(ctx sdk.Context, classID, nftID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(approvalStoreKey(classID, nftID))
+}
@@ -65,3 +65,23 @@ func (k Keeper) HasClass(ctx sdk.Context, classID string) bool {
 	store := ctx.KVStore(k.storeKey)
 	return store.Has(classStoreKey(classID))
 }
+
+// RoyaltyInfo returns the royalty receiver and amount owed on a sale of a nft of the specified class at
+// the sale price, same as royaltyInfo in ERC2981. The receiver is nil if the class has no royalty.
+func (k Keeper) RoyaltyInfo(ctx sdk.Context, classID string, salePrice sdk.Coin) (sdk.AccAddress, sdk.Coin, error) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
+		return nil, sdk.Coin{}, sdkerrors.Wrap(nft.ErrClassNotExists, classID)
+	}
+
+	if class.Royalty == nil {
+		return nil, sdk.NewCoin(salePrice.Denom, sdk.ZeroInt()), nil
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(class.Royalty.Receiver)
+	if err != nil {
+		return nil, sdk.Coin{}, err
+	}
+	amount := class.Royalty.Rate.MulInt(salePrice.Amount).TruncateInt()
+	return receiver, sdk.NewCoin(salePrice.Denom, amount), nil
+}
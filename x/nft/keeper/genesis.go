@@ -27,6 +27,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *nft.GenesisState) {
 			}
 		}
 	}
+	for _, approval := range data.Approvals {
+		approved, err := sdk.AccAddressFromBech32(approval.Approved)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetApproved(ctx, approval.ClassId, approval.Id, approved); err != nil {
+			panic(err)
+		}
+	}
+	for _, operator := range data.Operators {
+		owner, err := sdk.AccAddressFromBech32(operator.Owner)
+		if err != nil {
+			panic(err)
+		}
+
+		operatorAddr, err := sdk.AccAddressFromBech32(operator.Operator)
+		if err != nil {
+			panic(err)
+		}
+		k.SetOperator(ctx, owner, operatorAddr, true)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context.
@@ -59,7 +81,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *nft.GenesisState {
 		})
 	}
 	return &nft.GenesisState{
-		Classes: classes,
-		Entries: entries,
+		Classes:   classes,
+		Entries:   entries,
+		Approvals: k.GetApprovals(ctx),
+		Operators: k.GetOperatorApprovals(ctx),
 	}
 }
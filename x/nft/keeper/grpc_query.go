@@ -200,3 +200,76 @@ func (k Keeper) Classes(goCtx context.Context, r *nft.QueryClassesRequest) (*nft
 		Pagination: pageRes,
 	}, nil
 }
+
+// Approved return the address approved to send an NFT, same as getApproved in ERC721
+func (k Keeper) Approved(goCtx context.Context, r *nft.QueryApprovedRequest) (*nft.QueryApprovedResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	if err := nft.ValidateNFTID(r.Id); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.HasNFT(ctx, r.ClassId, r.Id) {
+		return nil, nft.ErrNFTNotExists.Wrapf("not found nft: class: %s, id: %s", r.ClassId, r.Id)
+	}
+
+	var approved string
+	if addr := k.GetApproved(ctx, r.ClassId, r.Id); addr != nil {
+		approved = addr.String()
+	}
+	return &nft.QueryApprovedResponse{Approved: approved}, nil
+}
+
+// IsApprovedForAll return whether an operator is approved to send all the NFTs of the owner, same as isApprovedForAll in ERC721
+func (k Keeper) IsApprovedForAll(goCtx context.Context, r *nft.QueryIsApprovedForAllRequest) (*nft.QueryIsApprovedForAllResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(r.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := sdk.AccAddressFromBech32(r.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &nft.QueryIsApprovedForAllResponse{Approved: k.HasOperator(ctx, owner, operator)}, nil
+}
+
+// Royalty return the royalty receiver and amount owed on a sale of an NFT of the given class, same as royaltyInfo in ERC2981
+func (k Keeper) Royalty(goCtx context.Context, r *nft.QueryRoyaltyRequest) (*nft.QueryRoyaltyResponse, error) {
+	if r == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("empty request")
+	}
+
+	if err := nft.ValidateClassID(r.ClassId); err != nil {
+		return nil, err
+	}
+
+	if err := r.SalePrice.Validate(); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("invalid sale price: %s", err)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	receiver, amount, err := k.RoyaltyInfo(ctx, r.ClassId, r.SalePrice)
+	if err != nil {
+		return nil, err
+	}
+
+	var receiverStr string
+	if receiver != nil {
+		receiverStr = receiver.String()
+	}
+	return &nft.QueryRoyaltyResponse{Receiver: receiverStr, RoyaltyAmount: amount}, nil
+}
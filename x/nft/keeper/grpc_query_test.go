@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -593,3 +594,36 @@ func (s *TestSuite) TestClasses() {
 		})
 	}
 }
+
+func (s *TestSuite) TestApprovedAndRoyalty() {
+	require := s.Require()
+	require.NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{
+		Id:      testClassID,
+		Royalty: &nft.Royalty{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(1, 1)},
+	}))
+	require.NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	require.NoError(s.app.NFTKeeper.SetApproved(s.ctx, testClassID, testID, s.addrs[2]))
+	s.app.NFTKeeper.SetOperator(s.ctx, s.addrs[0], s.addrs[1], true)
+
+	approved, err := s.queryClient.Approved(gocontext.Background(), &nft.QueryApprovedRequest{ClassId: testClassID, Id: testID})
+	require.NoError(err)
+	require.Equal(s.addrs[2].String(), approved.Approved)
+
+	_, err = s.queryClient.Approved(gocontext.Background(), &nft.QueryApprovedRequest{ClassId: testClassID, Id: "unknown"})
+	require.Error(err)
+
+	forAll, err := s.queryClient.IsApprovedForAll(gocontext.Background(), &nft.QueryIsApprovedForAllRequest{
+		Owner:    s.addrs[0].String(),
+		Operator: s.addrs[1].String(),
+	})
+	require.NoError(err)
+	require.True(forAll.Approved)
+
+	royalty, err := s.queryClient.Royalty(gocontext.Background(), &nft.QueryRoyaltyRequest{
+		ClassId:   testClassID,
+		SalePrice: sdk.NewInt64Coin("stake", 1000),
+	})
+	require.NoError(err)
+	require.Equal(s.addrs[1].String(), royalty.Receiver)
+	require.Equal(sdk.NewInt64Coin("stake", 100), royalty.RoyaltyAmount)
+}
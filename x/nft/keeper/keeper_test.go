@@ -298,6 +298,28 @@ func (s *TestSuite) TestTransfer() {
 	s.Require().EqualValues([]nft.NFT{expNFT}, actNFTs)
 }
 
+func (s *TestSuite) TestRoyaltyInfo() {
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{
+		Id:      "royalty",
+		Royalty: &nft.Royalty{Receiver: s.addrs[1].String(), Rate: sdk.NewDecWithPrec(25, 3)},
+	}))
+	salePrice := sdk.NewInt64Coin("stake", 1000)
+
+	receiver, amount, err := s.app.NFTKeeper.RoyaltyInfo(s.ctx, testClassID, salePrice)
+	s.Require().NoError(err)
+	s.Require().Nil(receiver)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 0), amount)
+
+	receiver, amount, err = s.app.NFTKeeper.RoyaltyInfo(s.ctx, "royalty", salePrice)
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], receiver)
+	s.Require().Equal(sdk.NewInt64Coin("stake", 25), amount)
+
+	_, _, err = s.app.NFTKeeper.RoyaltyInfo(s.ctx, "unknown", salePrice)
+	s.Require().ErrorIs(err, nft.ErrClassNotExists)
+}
+
 func (s *TestSuite) TestExportGenesis() {
 	class := nft.Class{
 		Id:          testClassID,
@@ -317,6 +339,8 @@ func (s *TestSuite) TestExportGenesis() {
 	}
 	err = s.app.NFTKeeper.Mint(s.ctx, expNFT, s.addrs[0])
 	s.Require().NoError(err)
+	s.Require().NoError(s.app.NFTKeeper.SetApproved(s.ctx, testClassID, testID, s.addrs[1]))
+	s.app.NFTKeeper.SetOperator(s.ctx, s.addrs[0], s.addrs[2], true)
 
 	expGenesis := &nft.GenesisState{
 		Classes: []*nft.Class{&class},
@@ -324,6 +348,8 @@ func (s *TestSuite) TestExportGenesis() {
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		Approvals: []*nft.Approval{{ClassId: testClassID, Id: testID, Approved: s.addrs[1].String()}},
+		Operators: []*nft.OperatorApproval{{Owner: s.addrs[0].String(), Operator: s.addrs[2].String()}},
 	}
 	genesis := s.app.NFTKeeper.ExportGenesis(s.ctx)
 	s.Require().Equal(expGenesis, genesis)
@@ -349,6 +375,8 @@ func (s *TestSuite) TestInitGenesis() {
 			Owner: s.addrs[0].String(),
 			Nfts:  []*nft.NFT{&expNFT},
 		}},
+		Approvals: []*nft.Approval{{ClassId: testClassID, Id: testID, Approved: s.addrs[1].String()}},
+		Operators: []*nft.OperatorApproval{{Owner: s.addrs[0].String(), Operator: s.addrs[2].String()}},
 	}
 	s.app.NFTKeeper.InitGenesis(s.ctx, expGenesis)
 
@@ -360,4 +388,7 @@ func (s *TestSuite) TestInitGenesis() {
 	actNFT, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
 	s.Require().True(has)
 	s.Require().EqualValues(expNFT, actNFT)
+
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetApproved(s.ctx, testClassID, testID))
+	s.Require().True(s.app.NFTKeeper.HasOperator(s.ctx, s.addrs[0], s.addrs[2]))
 }
@@ -15,6 +15,8 @@ var (
 	NFTOfClassByOwnerKey = []byte{0x03}
 	OwnerKey             = []byte{0x04}
 	ClassTotalSupply     = []byte{0x05}
+	ApprovalKey          = []byte{0x06}
+	OperatorKey          = []byte{0x07}
 
 	Delimiter   = []byte{0x00}
 	Placeholder = []byte{0x01}
@@ -104,3 +106,51 @@ func ownerStoreKey(classID, nftID string) []byte {
 	copy(key[len(OwnerKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
 	return key
 }
+
+// approvalStoreKey returns the byte representation of the nft approval key
+// Items are stored with the following key: values
+// 0x06<classID><Delimiter(1 Byte)><nftID>
+func approvalStoreKey(classID, nftID string) []byte {
+	classIDBz := conv.UnsafeStrToBytes(classID)
+	nftIDBz := conv.UnsafeStrToBytes(nftID)
+
+	var key = make([]byte, len(ApprovalKey)+len(classIDBz)+len(Delimiter)+len(nftIDBz))
+	copy(key, ApprovalKey)
+	copy(key[len(ApprovalKey):], classIDBz)
+	copy(key[len(ApprovalKey)+len(classIDBz):], Delimiter)
+	copy(key[len(ApprovalKey)+len(classIDBz)+len(Delimiter):], nftIDBz)
+	return key
+}
+
+// parseApprovalStoreKey parses the classID and nftID of a key built by approvalStoreKey,
+// the 0x06 prefix being removed.
+func parseApprovalStoreKey(key []byte) (classID, nftID string) {
+	ret := bytes.SplitN(key, Delimiter, 2)
+	if len(ret) != 2 {
+		panic("invalid approvalStoreKey")
+	}
+	return string(ret[0]), string(ret[1])
+}
+
+// operatorStoreKey returns the byte representation of the operator approval key
+// Items are stored with the following key: values
+// 0x07<owner(length prefixed)><operator(length prefixed)>
+func operatorStoreKey(owner, operator sdk.AccAddress) []byte {
+	owner = address.MustLengthPrefix(owner)
+	operator = address.MustLengthPrefix(operator)
+
+	var key = make([]byte, len(OperatorKey)+len(owner)+len(operator))
+	copy(key, OperatorKey)
+	copy(key[len(OperatorKey):], owner)
+	copy(key[len(OperatorKey)+len(owner):], operator)
+	return key
+}
+
+// parseOperatorStoreKey parses the owner and operator of a key built by operatorStoreKey,
+// the 0x07 prefix being removed.
+func parseOperatorStoreKey(key []byte) (owner, operator sdk.AccAddress) {
+	ownerLen := int(key[0])
+	owner = sdk.AccAddress(key[1 : 1+ownerLen])
+	operator = sdk.AccAddress(key[2+ownerLen:])
+	return
+}
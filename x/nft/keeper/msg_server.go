@@ -18,9 +18,8 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 		return nil, err
 	}
 
-	owner := k.GetOwner(ctx, msg.ClassId, msg.Id)
-	if !owner.Equals(sender) {
-		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", sender, msg.Id)
+	if !k.IsOwnerOrApproved(ctx, msg.ClassId, msg.Id, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the owner of nft %s nor approved", sender, msg.Id)
 	}
 
 	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
@@ -40,3 +39,229 @@ func (k Keeper) Send(goCtx context.Context, msg *nft.MsgSend) (*nft.MsgSendRespo
 	})
 	return &nft.MsgSendResponse{}, nil
 }
+
+// CreateClass implement CreateClass method of the types.MsgServer.
+func (k Keeper) CreateClass(goCtx context.Context, msg *nft.MsgCreateClass) (*nft.MsgCreateClassResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class := nft.Class{
+		Id:              msg.Id,
+		Name:            msg.Name,
+		Symbol:          msg.Symbol,
+		Description:     msg.Description,
+		Uri:             msg.Uri,
+		UriHash:         msg.UriHash,
+		Issuer:          msg.Issuer,
+		Minters:         msg.Minters,
+		NonTransferable: msg.NonTransferable,
+		MetadataFrozen:  msg.MetadataFrozen,
+		Royalty:         msg.Royalty,
+	}
+	if err := k.SaveClass(ctx, class); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventCreateClass{
+		ClassId: msg.Id,
+		Issuer:  msg.Issuer,
+	})
+	return &nft.MsgCreateClassResponse{}, nil
+}
+
+// MintNFT implement MintNFT method of the types.MsgServer.
+func (k Keeper) MintNFT(goCtx context.Context, msg *nft.MsgMintNFT) (*nft.MsgMintNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if !isMinter(class, msg.Minter) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to mint nft of class %s", msg.Minter, msg.ClassId)
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, err
+	}
+
+	token := nft.NFT{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Uri:     msg.Uri,
+		UriHash: msg.UriHash,
+	}
+	if err := k.Mint(ctx, token, receiver); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventMint{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   msg.Receiver,
+	})
+	return &nft.MsgMintNFTResponse{}, nil
+}
+
+// BurnNFT implement BurnNFT method of the types.MsgServer.
+func (k Keeper) BurnNFT(goCtx context.Context, msg *nft.MsgBurnNFT) (*nft.MsgBurnNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.GetOwner(ctx, msg.ClassId, msg.Id).Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the owner of nft %s", owner, msg.Id)
+	}
+
+	if err := k.Burn(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventBurn{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   msg.Owner,
+	})
+	return &nft.MsgBurnNFTResponse{}, nil
+}
+
+// UpdateNFT implement UpdateNFT method of the types.MsgServer.
+func (k Keeper) UpdateNFT(goCtx context.Context, msg *nft.MsgUpdateNFT) (*nft.MsgUpdateNFTResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	class, has := k.GetClass(ctx, msg.ClassId)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrClassNotExists, msg.ClassId)
+	}
+
+	if class.Issuer == "" || class.Issuer != msg.Issuer {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the issuer of class %s", msg.Issuer, msg.ClassId)
+	}
+
+	token, has := k.GetNFT(ctx, msg.ClassId, msg.Id)
+	if !has {
+		return nil, sdkerrors.Wrap(nft.ErrNFTNotExists, msg.Id)
+	}
+
+	token.Uri = msg.Uri
+	token.UriHash = msg.UriHash
+	if err := k.Update(ctx, token); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventUpdate{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Issuer:  msg.Issuer,
+	})
+	return &nft.MsgUpdateNFTResponse{}, nil
+}
+
+// Approve implement Approve method of the types.MsgServer.
+func (k Keeper) Approve(goCtx context.Context, msg *nft.MsgApprove) (*nft.MsgApproveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := k.authorizeApproval(ctx, msg.ClassId, msg.Id, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	approved, err := sdk.AccAddressFromBech32(msg.Approved)
+	if err != nil {
+		return nil, err
+	}
+
+	if approved.Equals(owner) {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot approve the owner of nft")
+	}
+
+	if err := k.SetApproved(ctx, msg.ClassId, msg.Id, approved); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventApprove{
+		ClassId:  msg.ClassId,
+		Id:       msg.Id,
+		Owner:    owner.String(),
+		Approved: msg.Approved,
+	})
+	return &nft.MsgApproveResponse{}, nil
+}
+
+// Revoke implement Revoke method of the types.MsgServer.
+func (k Keeper) Revoke(goCtx context.Context, msg *nft.MsgRevoke) (*nft.MsgRevokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := k.authorizeApproval(ctx, msg.ClassId, msg.Id, msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RevokeApproval(ctx, msg.ClassId, msg.Id); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventRevoke{
+		ClassId: msg.ClassId,
+		Id:      msg.Id,
+		Owner:   owner.String(),
+	})
+	return &nft.MsgRevokeResponse{}, nil
+}
+
+// ApproveAll implement ApproveAll method of the types.MsgServer.
+func (k Keeper) ApproveAll(goCtx context.Context, msg *nft.MsgApproveAll) (*nft.MsgApproveAllResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	operator, err := sdk.AccAddressFromBech32(msg.Operator)
+	if err != nil {
+		return nil, err
+	}
+
+	k.SetOperator(ctx, owner, operator, msg.Approved)
+
+	ctx.EventManager().EmitTypedEvent(&nft.EventApproveAll{
+		Owner:    msg.Owner,
+		Operator: msg.Operator,
+		Approved: msg.Approved,
+	})
+	return &nft.MsgApproveAllResponse{}, nil
+}
+
+// authorizeApproval checks that the sender is the owner of the nft or an operator of its owner,
+// and returns the owner.
+func (k Keeper) authorizeApproval(ctx sdk.Context, classID, nftID, sender string) (sdk.AccAddress, error) {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := k.GetOwner(ctx, classID, nftID)
+	if owner.Empty() {
+		return nil, sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
+	}
+
+	if !owner.Equals(senderAddr) && !k.HasOperator(ctx, owner, senderAddr) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the owner of nft %s nor an operator of its owner", sender, nftID)
+	}
+	return owner, nil
+}
+
+// isMinter returns whether the given address may mint nfts of the class.
+// Classes without an issuer are managed by modules only.
+func isMinter(class nft.Class, addr string) bool {
+	if class.Issuer == "" {
+		return false
+	}
+	if class.Issuer == addr {
+		return true
+	}
+	for _, minter := range class.Minters {
+		if minter == addr {
+			return true
+		}
+	}
+	return false
+}
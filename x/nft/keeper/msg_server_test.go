@@ -0,0 +1,251 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+)
+
+func (s *TestSuite) TestCreateClass() {
+	issuer := s.addrs[0].String()
+	msg := &nft.MsgCreateClass{
+		Issuer:          issuer,
+		Id:              testClassID,
+		Name:            testClassName,
+		Symbol:          testClassSymbol,
+		Minters:         []string{s.addrs[1].String()},
+		NonTransferable: true,
+	}
+	_, err := s.app.NFTKeeper.CreateClass(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+
+	class, has := s.app.NFTKeeper.GetClass(s.ctx, testClassID)
+	s.Require().True(has)
+	s.Require().Equal(issuer, class.Issuer)
+	s.Require().Equal([]string{s.addrs[1].String()}, class.Minters)
+	s.Require().True(class.NonTransferable)
+	s.Require().False(class.MetadataFrozen)
+
+	_, err = s.app.NFTKeeper.CreateClass(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, nft.ErrClassExists)
+}
+
+func (s *TestSuite) TestMintNFT() {
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{
+		Id:      testClassID,
+		Issuer:  s.addrs[0].String(),
+		Minters: []string{s.addrs[1].String()},
+	}))
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{
+		Id: "module-class",
+	}))
+
+	testCases := []struct {
+		name   string
+		minter sdk.AccAddress
+		class  string
+		id     string
+		expErr error
+	}{
+		{"issuer mints", s.addrs[0], testClassID, "kitty1", nil},
+		{"minter mints", s.addrs[1], testClassID, "kitty2", nil},
+		{"unauthorized minter", s.addrs[2], testClassID, "kitty3", sdkerrors.ErrUnauthorized},
+		{"class without issuer", s.addrs[0], "module-class", "kitty4", sdkerrors.ErrUnauthorized},
+		{"class does not exist", s.addrs[0], "unknown", "kitty5", nft.ErrClassNotExists},
+		{"nft already exists", s.addrs[0], testClassID, "kitty1", nft.ErrNFTExists},
+	}
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			_, err := s.app.NFTKeeper.MintNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgMintNFT{
+				Minter:   tc.minter.String(),
+				ClassId:  tc.class,
+				Id:       tc.id,
+				Uri:      testURI,
+				Receiver: s.addrs[2].String(),
+			})
+			if tc.expErr != nil {
+				s.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(s.addrs[2], s.app.NFTKeeper.GetOwner(s.ctx, tc.class, tc.id))
+		})
+	}
+	s.Require().EqualValues(2, s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestBurnNFT() {
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+
+	_, err := s.app.NFTKeeper.BurnNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgBurnNFT{
+		Owner:   s.addrs[1].String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.app.NFTKeeper.BurnNFT(sdk.WrapSDKContext(s.ctx), &nft.MsgBurnNFT{
+		Owner:   s.addrs[0].String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.app.NFTKeeper.HasNFT(s.ctx, testClassID, testID))
+	s.Require().EqualValues(0, s.app.NFTKeeper.GetTotalSupply(s.ctx, testClassID))
+}
+
+func (s *TestSuite) TestUpdateNFT() {
+	issuer := s.addrs[0].String()
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, Issuer: issuer}))
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: "frozen", Issuer: issuer, MetadataFrozen: true}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[1]))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: "frozen", Id: testID}, s.addrs[1]))
+
+	msg := &nft.MsgUpdateNFT{
+		Issuer:  s.addrs[1].String(),
+		ClassId: testClassID,
+		Id:      testID,
+		Uri:     testURI,
+		UriHash: testURIHash,
+	}
+	_, err := s.app.NFTKeeper.UpdateNFT(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	msg.Issuer = issuer
+	_, err = s.app.NFTKeeper.UpdateNFT(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().NoError(err)
+	token, has := s.app.NFTKeeper.GetNFT(s.ctx, testClassID, testID)
+	s.Require().True(has)
+	s.Require().Equal(testURI, token.Uri)
+	s.Require().Equal(testURIHash, token.UriHash)
+
+	msg.ClassId = "frozen"
+	_, err = s.app.NFTKeeper.UpdateNFT(sdk.WrapSDKContext(s.ctx), msg)
+	s.Require().ErrorIs(err, nft.ErrMetadataFrozen)
+}
+
+func (s *TestSuite) TestSendNonTransferable() {
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID, NonTransferable: true}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+
+	_, err := s.app.NFTKeeper.Send(sdk.WrapSDKContext(s.ctx), &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.addrs[0].String(),
+		Receiver: s.addrs[1].String(),
+	})
+	s.Require().ErrorIs(err, nft.ErrNonTransferable)
+	s.Require().Equal(s.addrs[0], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestApproveAndSend() {
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	send := &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.addrs[1].String(),
+		Receiver: s.addrs[2].String(),
+	}
+	_, err := s.app.NFTKeeper.Send(goCtx, send)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// only the owner or its operators can approve
+	_, err = s.app.NFTKeeper.Approve(goCtx, &nft.MsgApprove{
+		Sender:   s.addrs[1].String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Approved: s.addrs[1].String(),
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.app.NFTKeeper.Approve(goCtx, &nft.MsgApprove{
+		Sender:   s.addrs[0].String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Approved: s.addrs[1].String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[1], s.app.NFTKeeper.GetApproved(s.ctx, testClassID, testID))
+
+	_, err = s.app.NFTKeeper.Send(goCtx, send)
+	s.Require().NoError(err)
+	s.Require().Equal(s.addrs[2], s.app.NFTKeeper.GetOwner(s.ctx, testClassID, testID))
+
+	// the approval is cleared by the transfer
+	s.Require().Nil(s.app.NFTKeeper.GetApproved(s.ctx, testClassID, testID))
+	_, err = s.app.NFTKeeper.Send(goCtx, send)
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (s *TestSuite) TestRevoke() {
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	s.Require().NoError(s.app.NFTKeeper.SetApproved(s.ctx, testClassID, testID, s.addrs[1]))
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.app.NFTKeeper.Revoke(goCtx, &nft.MsgRevoke{
+		Sender:  s.addrs[1].String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.app.NFTKeeper.Revoke(goCtx, &nft.MsgRevoke{
+		Sender:  s.addrs[0].String(),
+		ClassId: testClassID,
+		Id:      testID,
+	})
+	s.Require().NoError(err)
+	s.Require().Nil(s.app.NFTKeeper.GetApproved(s.ctx, testClassID, testID))
+}
+
+func (s *TestSuite) TestApproveAll() {
+	s.Require().NoError(s.app.NFTKeeper.SaveClass(s.ctx, nft.Class{Id: testClassID}))
+	s.Require().NoError(s.app.NFTKeeper.Mint(s.ctx, nft.NFT{ClassId: testClassID, Id: testID}, s.addrs[0]))
+	goCtx := sdk.WrapSDKContext(s.ctx)
+
+	_, err := s.app.NFTKeeper.ApproveAll(goCtx, &nft.MsgApproveAll{
+		Owner:    s.addrs[0].String(),
+		Operator: s.addrs[1].String(),
+		Approved: true,
+	})
+	s.Require().NoError(err)
+	s.Require().True(s.app.NFTKeeper.HasOperator(s.ctx, s.addrs[0], s.addrs[1]))
+
+	// an operator can approve the nfts of the owner
+	_, err = s.app.NFTKeeper.Approve(goCtx, &nft.MsgApprove{
+		Sender:   s.addrs[1].String(),
+		ClassId:  testClassID,
+		Id:       testID,
+		Approved: s.addrs[2].String(),
+	})
+	s.Require().NoError(err)
+
+	_, err = s.app.NFTKeeper.ApproveAll(goCtx, &nft.MsgApproveAll{
+		Owner:    s.addrs[0].String(),
+		Operator: s.addrs[1].String(),
+		Approved: false,
+	})
+	s.Require().NoError(err)
+	s.Require().False(s.app.NFTKeeper.HasOperator(s.ctx, s.addrs[0], s.addrs[1]))
+
+	_, err = s.app.NFTKeeper.Send(goCtx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.addrs[1].String(),
+		Receiver: s.addrs[1].String(),
+	})
+	s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = s.app.NFTKeeper.Send(goCtx, &nft.MsgSend{
+		ClassId:  testClassID,
+		Id:       testID,
+		Sender:   s.addrs[2].String(),
+		Receiver: s.addrs[2].String(),
+	})
+	s.Require().NoError(err)
+}
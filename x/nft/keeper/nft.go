@@ -39,6 +39,7 @@ func (k Keeper) Burn(ctx sdk.Context, classID string, nftID string) error {
 	nftStore.Delete([]byte(nftID))
 
 	k.deleteOwner(ctx, classID, nftID, owner)
+	k.deleteApproval(ctx, classID, nftID)
 	k.decrTotalSupply(ctx, classID)
 	return nil
 }
@@ -46,10 +47,15 @@ func (k Keeper) Burn(ctx sdk.Context, classID string, nftID string) error {
 // Update defines a method for updating an exist nft
 // Note: When the upper module uses this method, it needs to authenticate nft
 func (k Keeper) Update(ctx sdk.Context, token nft.NFT) error {
-	if !k.HasClass(ctx, token.ClassId) {
+	class, has := k.GetClass(ctx, token.ClassId)
+	if !has {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, token.ClassId)
 	}
 
+	if class.MetadataFrozen {
+		return sdkerrors.Wrap(nft.ErrMetadataFrozen, token.Id)
+	}
+
 	if !k.HasNFT(ctx, token.ClassId, token.Id) {
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, token.Id)
 	}
@@ -63,16 +69,22 @@ func (k Keeper) Transfer(ctx sdk.Context,
 	classID string,
 	nftID string,
 	receiver sdk.AccAddress) error {
-	if !k.HasClass(ctx, classID) {
+	class, has := k.GetClass(ctx, classID)
+	if !has {
 		return sdkerrors.Wrap(nft.ErrClassNotExists, classID)
 	}
 
+	if class.NonTransferable {
+		return sdkerrors.Wrap(nft.ErrNonTransferable, nftID)
+	}
+
 	if !k.HasNFT(ctx, classID, nftID) {
 		return sdkerrors.Wrap(nft.ErrNFTNotExists, nftID)
 	}
 
 	owner := k.GetOwner(ctx, classID, nftID)
 	k.deleteOwner(ctx, classID, nftID, owner)
+	k.deleteApproval(ctx, classID, nftID)
 	k.setOwner(ctx, classID, nftID, receiver)
 	return nil
 }
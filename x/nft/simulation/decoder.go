@@ -38,6 +38,13 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			supplyA = sdk.BigEndianToUint64(kvA.Value)
 			supplyB = sdk.BigEndianToUint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", supplyA, supplyB)
+		case bytes.Equal(kvA.Key[:1], keeper.ApprovalKey):
+			var approvedA, approvedB sdk.AccAddress
+			approvedA = sdk.AccAddress(kvA.Value)
+			approvedB = sdk.AccAddress(kvB.Value)
+			return fmt.Sprintf("%v\n%v", approvedA, approvedB)
+		case bytes.Equal(kvA.Key[:1], keeper.OperatorKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
 		default:
 			panic(fmt.Sprintf("invalid nft key %X", kvA.Key))
 		}
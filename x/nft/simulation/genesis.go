@@ -18,6 +18,7 @@ func genClasses(r *rand.Rand, accounts []simtypes.Account) []*nft.Class {
 			Symbol:      simtypes.RandStringOfLength(r, 10),
 			Description: simtypes.RandStringOfLength(r, 10),
 			Uri:         simtypes.RandStringOfLength(r, 10),
+			Issuer:      accounts[i].Address.String(),
 		}
 	}
 	return classes
@@ -19,16 +19,34 @@ import (
 
 const (
 	// Simulation operation weights constants
-	OpWeightMsgSend = "op_weight_msg_send"
+	OpWeightMsgSend        = "op_weight_msg_send"
+	OpWeightMsgCreateClass = "op_weight_msg_create_class"
+	OpWeightMsgMintNFT     = "op_weight_msg_mint_nft"
+	OpWeightMsgBurnNFT     = "op_weight_msg_burn_nft"
+	OpWeightMsgUpdateNFT   = "op_weight_msg_update_nft"
+	OpWeightMsgApprove     = "op_weight_msg_approve"
+	OpWeightMsgApproveAll  = "op_weight_msg_approve_all"
 )
 
 const (
 	// nft operations weights
-	WeightSend = 100
+	WeightSend        = 100
+	WeightCreateClass = 20
+	WeightMintNFT     = 50
+	WeightBurnNFT     = 20
+	WeightUpdateNFT   = 20
+	WeightApprove     = 20
+	WeightApproveAll  = 10
 )
 
 var (
-	TypeMsgSend = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgSend        = sdk.MsgTypeURL(&nft.MsgSend{})
+	TypeMsgCreateClass = sdk.MsgTypeURL(&nft.MsgCreateClass{})
+	TypeMsgMintNFT     = sdk.MsgTypeURL(&nft.MsgMintNFT{})
+	TypeMsgBurnNFT     = sdk.MsgTypeURL(&nft.MsgBurnNFT{})
+	TypeMsgUpdateNFT   = sdk.MsgTypeURL(&nft.MsgUpdateNFT{})
+	TypeMsgApprove     = sdk.MsgTypeURL(&nft.MsgApprove{})
+	TypeMsgApproveAll  = sdk.MsgTypeURL(&nft.MsgApproveAll{})
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -41,7 +59,13 @@ func WeightedOperations(
 	k keeper.Keeper) simulation.WeightedOperations {
 
 	var (
-		weightMsgSend int
+		weightMsgSend        int
+		weightMsgCreateClass int
+		weightMsgMintNFT     int
+		weightMsgBurnNFT     int
+		weightMsgUpdateNFT   int
+		weightMsgApprove     int
+		weightMsgApproveAll  int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgSend, &weightMsgSend, nil,
@@ -50,10 +74,71 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateClass, &weightMsgCreateClass, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateClass = WeightCreateClass
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgMintNFT, &weightMsgMintNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgMintNFT = WeightMintNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgBurnNFT, &weightMsgBurnNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgBurnNFT = WeightBurnNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateNFT, &weightMsgUpdateNFT, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateNFT = WeightUpdateNFT
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgApprove, &weightMsgApprove, nil,
+		func(_ *rand.Rand) {
+			weightMsgApprove = WeightApprove
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgApproveAll, &weightMsgApproveAll, nil,
+		func(_ *rand.Rand) {
+			weightMsgApproveAll = WeightApproveAll
+		},
+	)
+
+	protoCdc := codec.NewProtoCodec(registry)
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgSend,
-			SimulateMsgSend(codec.NewProtoCodec(registry), ak, bk, k),
+			SimulateMsgSend(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCreateClass,
+			SimulateMsgCreateClass(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgMintNFT,
+			SimulateMsgMintNFT(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgBurnNFT,
+			SimulateMsgBurnNFT(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateNFT,
+			SimulateMsgUpdateNFT(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgApprove,
+			SimulateMsgApprove(protoCdc, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgApproveAll,
+			SimulateMsgApproveAll(protoCdc, ak, bk, k),
 		),
 	}
 }
@@ -91,6 +176,10 @@ func SimulateMsgSend(
 			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, err.Error()), nil, err
 		}
 
+		if class, _ := k.GetClass(ctx, n.ClassId); class.NonTransferable {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgSend, "nft is non-transferable"), nil, nil
+		}
+
 		msg := &nft.MsgSend{
 			ClassId:  n.ClassId,
 			Id:       n.Id,
@@ -122,6 +211,320 @@ func SimulateMsgSend(
 	}
 }
 
+// SimulateMsgCreateClass generates a MsgCreateClass with random values.
+func SimulateMsgCreateClass(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		issuer, _ := simtypes.RandomAcc(r, accs)
+		minter, _ := simtypes.RandomAcc(r, accs)
+
+		classID := simtypes.RandStringOfLength(r, 10)
+		if k.HasClass(ctx, classID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgCreateClass, "class already exists"), nil, nil
+		}
+
+		msg := &nft.MsgCreateClass{
+			Issuer:          issuer.Address.String(),
+			Id:              classID,
+			Name:            simtypes.RandStringOfLength(r, 10),
+			Symbol:          simtypes.RandStringOfLength(r, 10),
+			Description:     simtypes.RandStringOfLength(r, 10),
+			Uri:             simtypes.RandStringOfLength(r, 10),
+			Minters:         []string{minter.Address.String()},
+			NonTransferable: r.Intn(10) == 0,
+			MetadataFrozen:  r.Intn(2) == 0,
+		}
+		if r.Intn(2) == 0 {
+			receiver, _ := simtypes.RandomAcc(r, accs)
+			msg.Royalty = &nft.Royalty{
+				Receiver: receiver.Address.String(),
+				Rate:     simtypes.RandomDecAmount(r, sdk.NewDecWithPrec(2, 1)),
+			}
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgCreateClass,
+			Context:       ctx,
+			SimAccount:    issuer,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgMintNFT generates a MsgMintNFT with random values.
+func SimulateMsgMintNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, minter, found := randIssuedClass(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMintNFT, "no class issued by a simulation account"), nil, nil
+		}
+		if len(class.Minters) > 0 && r.Intn(2) == 0 {
+			addr, err := sdk.AccAddressFromBech32(class.Minters[r.Intn(len(class.Minters))])
+			if err != nil {
+				return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMintNFT, err.Error()), nil, err
+			}
+			if acc, found := simtypes.FindAccount(accs, addr); found {
+				minter = acc
+			}
+		}
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		nftID := simtypes.RandStringOfLength(r, 10)
+		if k.HasNFT(ctx, class.Id, nftID) {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgMintNFT, "nft already exists"), nil, nil
+		}
+
+		msg := &nft.MsgMintNFT{
+			Minter:   minter.Address.String(),
+			ClassId:  class.Id,
+			Id:       nftID,
+			Uri:      simtypes.RandStringOfLength(r, 10),
+			Receiver: receiver.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgMintNFT,
+			Context:       ctx,
+			SimAccount:    minter,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgBurnNFT generates a MsgBurnNFT with random values.
+func SimulateMsgBurnNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		owner, _ := simtypes.RandomAcc(r, accs)
+
+		n, err := randNFT(ctx, r, k, owner.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgBurnNFT, err.Error()), nil, err
+		}
+
+		msg := &nft.MsgBurnNFT{
+			Owner:   owner.Address.String(),
+			ClassId: n.ClassId,
+			Id:      n.Id,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgBurnNFT,
+			Context:       ctx,
+			SimAccount:    owner,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgUpdateNFT generates a MsgUpdateNFT with random values.
+func SimulateMsgUpdateNFT(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		class, issuer, found := randIssuedClass(ctx, r, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "no class issued by a simulation account"), nil, nil
+		}
+		if class.MetadataFrozen {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "class metadata is frozen"), nil, nil
+		}
+
+		nfts := k.GetNFTsOfClass(ctx, class.Id)
+		if len(nfts) == 0 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgUpdateNFT, "class has no nft"), nil, nil
+		}
+		n := nfts[r.Intn(len(nfts))]
+
+		msg := &nft.MsgUpdateNFT{
+			Issuer:  issuer.Address.String(),
+			ClassId: n.ClassId,
+			Id:      n.Id,
+			Uri:     simtypes.RandStringOfLength(r, 10),
+			UriHash: simtypes.RandStringOfLength(r, 10),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgUpdateNFT,
+			Context:       ctx,
+			SimAccount:    issuer,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgApprove generates a MsgApprove with random values.
+func SimulateMsgApprove(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if len(accs) < 2 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgApprove, "not enough accounts"), nil, nil
+		}
+		owner, i := simtypes.RandomAcc(r, accs)
+		approved := randOtherAcc(r, accs, i)
+
+		n, err := randNFT(ctx, r, k, owner.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgApprove, err.Error()), nil, err
+		}
+
+		msg := &nft.MsgApprove{
+			Sender:   owner.Address.String(),
+			ClassId:  n.ClassId,
+			Id:       n.Id,
+			Approved: approved.Address.String(),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgApprove,
+			Context:       ctx,
+			SimAccount:    owner,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgApproveAll generates a MsgApproveAll with random values.
+func SimulateMsgApproveAll(
+	cdc *codec.ProtoCodec,
+	ak nft.AccountKeeper,
+	bk nft.BankKeeper,
+	k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if len(accs) < 2 {
+			return simtypes.NoOpMsg(nft.ModuleName, TypeMsgApproveAll, "not enough accounts"), nil, nil
+		}
+		owner, i := simtypes.RandomAcc(r, accs)
+		operator := randOtherAcc(r, accs, i)
+
+		msg := &nft.MsgApproveAll{
+			Owner:    owner.Address.String(),
+			Operator: operator.Address.String(),
+			Approved: !k.HasOperator(ctx, owner.Address, operator.Address),
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         simappparams.MakeTestEncodingConfig().TxConfig,
+			Cdc:           cdc,
+			Msg:           msg,
+			MsgType:       TypeMsgApproveAll,
+			Context:       ctx,
+			SimAccount:    owner,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    nft.ModuleName,
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// randOtherAcc returns a random account other than the i-th one.
+func randOtherAcc(r *rand.Rand, accs []simtypes.Account, i int) simtypes.Account {
+	j := r.Intn(len(accs) - 1)
+	if j >= i {
+		j++
+	}
+	return accs[j]
+}
+
+// randIssuedClass returns a random class whose issuer is one of the simulation accounts,
+// together with the issuer account.
+func randIssuedClass(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, accs []simtypes.Account) (nft.Class, simtypes.Account, bool) {
+	var (
+		classes []nft.Class
+		issuers []simtypes.Account
+	)
+	for _, class := range k.GetClasses(ctx) {
+		if class.Issuer == "" {
+			continue
+		}
+		addr, err := sdk.AccAddressFromBech32(class.Issuer)
+		if err != nil {
+			continue
+		}
+		issuer, found := simtypes.FindAccount(accs, addr)
+		if !found {
+			continue
+		}
+		classes = append(classes, *class)
+		issuers = append(issuers, issuer)
+	}
+	if len(classes) == 0 {
+		return nft.Class{}, simtypes.Account{}, false
+	}
+	i := r.Intn(len(classes))
+	return classes[i], issuers[i], true
+}
+
 func randNFT(ctx sdk.Context, r *rand.Rand, k keeper.Keeper, minter sdk.AccAddress) (nft.NFT, error) {
 	c, err := randClass(ctx, r, k)
 	if err != nil {
@@ -53,6 +53,12 @@ func (suite *SimTestSuite) TestWeightedOperations() {
 		opMsgName  string
 	}{
 		{simulation.WeightSend, simulation.TypeMsgSend, simulation.TypeMsgSend},
+		{simulation.WeightCreateClass, simulation.TypeMsgCreateClass, simulation.TypeMsgCreateClass},
+		{simulation.WeightMintNFT, simulation.TypeMsgMintNFT, simulation.TypeMsgMintNFT},
+		{simulation.WeightBurnNFT, simulation.TypeMsgBurnNFT, simulation.TypeMsgBurnNFT},
+		{simulation.WeightUpdateNFT, simulation.TypeMsgUpdateNFT, simulation.TypeMsgUpdateNFT},
+		{simulation.WeightApprove, simulation.TypeMsgApprove, simulation.TypeMsgApprove},
+		{simulation.WeightApproveAll, simulation.TypeMsgApproveAll, simulation.TypeMsgApproveAll},
 	}
 
 	for i, w := range weightedOps {
@@ -110,6 +116,40 @@ func (suite *SimTestSuite) TestSimulateMsgSend() {
 
 }
 
+func (suite *SimTestSuite) TestSimulateMsgMintNFT() {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	accounts := suite.getTestingAccounts(r, 2)
+	blockTime := time.Now().UTC()
+	ctx := suite.ctx.WithBlockTime(blockTime)
+
+	suite.Require().NoError(suite.app.NFTKeeper.SaveClass(ctx, nft.Class{
+		Id:     "kitty",
+		Issuer: accounts[0].Address.String(),
+	}))
+
+	// begin a new block
+	suite.app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height:  suite.app.LastBlockHeight() + 1,
+			AppHash: suite.app.LastCommitID().Hash,
+		},
+	})
+
+	// execute operation
+	registry := suite.app.InterfaceRegistry()
+	op := simulation.SimulateMsgMintNFT(codec.NewProtoCodec(registry), suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.NFTKeeper)
+	operationMsg, futureOperations, err := op(r, suite.app.BaseApp, ctx, accounts, "")
+	suite.Require().NoError(err)
+
+	var msg nft.MsgMintNFT
+	suite.app.AppCodec().UnmarshalJSON(operationMsg.Msg, &msg)
+	suite.Require().True(operationMsg.OK)
+	suite.Require().Equal("kitty", msg.ClassId)
+	suite.Require().Equal(accounts[0].Address.String(), msg.Minter)
+	suite.Require().Len(futureOperations, 0)
+}
+
 func TestSimTestSuite(t *testing.T) {
 	suite.Run(t, new(SimTestSuite))
 }
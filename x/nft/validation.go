@@ -4,6 +4,7 @@ import (
 	fmt "fmt"
 	"regexp"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -33,3 +34,14 @@ func ValidateNFTID(id string) error {
 	}
 	return nil
 }
+
+// ValidateRoyalty returns whether the royalty has a valid receiver and a rate between 0 and 1
+func ValidateRoyalty(royalty Royalty) error {
+	if _, err := sdk.AccAddressFromBech32(royalty.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "invalid royalty receiver: %s", royalty.Receiver)
+	}
+	if royalty.Rate.IsNil() || royalty.Rate.IsNegative() || royalty.Rate.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidRoyalty, "royalty rate must be between 0 and 1: %s", royalty.Rate)
+	}
+	return nil
+}
@@ -29,7 +29,10 @@ objects, only non-empty fields will be updated.
 
 IMPORTANT: Currently parameter changes are evaluated but not validated, so it is
 very important that any "value" change is valid (ie. correct type and within bounds)
-for its respective parameter, eg. "MaxValidators" should be an integer and not a decimal.
+for its respective parameter, eg. "EpochLength" should be an integer and not a decimal.
+
+The params of the modules which store their own params, e.g. staking or gov, can't be
+changed with this proposal; use their MsgUpdateParams instead.
 
 Proper vetting of a parameter change proposal should prevent this from happening
 (no deposits should occur during the governance process), but it should be noted
@@ -41,13 +44,13 @@ $ %s tx gov submit-proposal param-change <path/to/proposal.json> --from=<key_or_
 Where proposal.json contains:
 
 {
-  "title": "Staking Param Change",
-  "description": "Update max validators",
+  "title": "Epoching Param Change",
+  "description": "Update the epoch length",
   "changes": [
     {
-      "subspace": "staking",
-      "key": "MaxValidators",
-      "value": 105
+      "subspace": "epoching",
+      "key": "EpochLength",
+      "value": "20"
     }
   ],
   "deposit": "1000stake"
@@ -10,12 +10,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 )
 
-// NewParamChangeProposalHandler creates a new governance Handler for a ParamChangeProposal
-func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
+// NewParamChangeProposalHandler creates a new governance Handler for a ParamChangeProposal.
+// The changes to the migratedSubspaces, whose modules store their own params and
+// update them with a MsgUpdateParams, are rejected, since the modules no longer
+// read them from their subspace.
+func NewParamChangeProposalHandler(k keeper.Keeper, migratedSubspaces ...string) govtypes.Handler {
+	migrated := make(map[string]bool, len(migratedSubspaces))
+	for _, s := range migratedSubspaces {
+		migrated[s] = true
+	}
+
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *proposal.ParameterChangeProposal:
-			return handleParameterChangeProposal(ctx, k, c)
+			return handleParameterChangeProposal(ctx, k, migrated, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized param proposal content type: %T", c)
@@ -23,8 +31,12 @@ func NewParamChangeProposalHandler(k keeper.Keeper) govtypes.Handler {
 	}
 }
 
-func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, p *proposal.ParameterChangeProposal) error {
+func handleParameterChangeProposal(ctx sdk.Context, k keeper.Keeper, migrated map[string]bool, p *proposal.ParameterChangeProposal) error {
 	for _, c := range p.Changes {
+		if migrated[c.Subspace] {
+			return sdkerrors.Wrapf(proposal.ErrMigratedSubspace, "%s params must be updated with its MsgUpdateParams", c.Subspace)
+		}
+
 		ss, ok := k.GetSubspace(c.Subspace)
 		if !ok {
 			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
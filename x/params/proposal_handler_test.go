@@ -9,10 +9,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/epoching"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	govv1beta2 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta2"
-	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
@@ -28,7 +28,7 @@ type HandlerTestSuite struct {
 func (suite *HandlerTestSuite) SetupTest() {
 	suite.app = simapp.Setup(suite.T(), false)
 	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
-	suite.govHandler = params.NewParamChangeProposalHandler(suite.app.ParamsKeeper)
+	suite.govHandler = suite.app.GovKeeper.LegacyRouter().GetRoute(proposal.RouterKey)
 }
 
 func TestHandlerTestSuite(t *testing.T) {
@@ -44,52 +44,76 @@ func (suite *HandlerTestSuite) TestProposalHandler() {
 		name     string
 		proposal *proposal.ParameterChangeProposal
 		onHandle func()
-		expErr   bool
+		expErr   error
 	}{
 		{
 			"all fields",
-			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
+			testProposal(proposal.NewParamChange(epoching.ModuleName, string(epoching.KeyEpochLength), `"20"`)),
 			func() {
-				maxVals := suite.app.StakingKeeper.MaxValidators(suite.ctx)
-				suite.Require().Equal(uint32(1), maxVals)
+				suite.Require().Equal(uint64(20), suite.app.EpochingKeeper.GetParams(suite.ctx).EpochLength)
 			},
-			false,
+			nil,
 		},
 		{
 			"invalid type",
-			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "-")),
+			testProposal(proposal.NewParamChange(epoching.ModuleName, string(epoching.KeyEpochLength), "-")),
+			func() {},
+			proposal.ErrSettingParameter,
+		},
+		{
+			"unknown subspace",
+			testProposal(proposal.NewParamChange("unknown", "key", "1")),
 			func() {},
-			true,
+			proposal.ErrUnknownSubspace,
 		},
 		{
-			"omit empty fields",
+			"staking params are migrated to the staking module",
+			testProposal(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1")),
+			func() {
+				suite.Require().Equal(stakingtypes.DefaultMaxValidators, suite.app.StakingKeeper.MaxValidators(suite.ctx))
+				suite.Require().False(suite.app.GetSubspace(stakingtypes.ModuleName).Has(suite.ctx, stakingtypes.KeyMaxValidators))
+			},
+			proposal.ErrMigratedSubspace,
+		},
+		{
+			"gov params are migrated to the gov module",
 			testProposal(proposal.ParamChange{
 				Subspace: govtypes.ModuleName,
 				Key:      string(govv1beta2.ParamStoreKeyDepositParams),
-				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}], "max_deposit_period": "172800000000000"}`,
+				Value:    `{"min_deposit": [{"denom": "uatom","amount": "64000000"}]}`,
 			}),
 			func() {
-				depositParams := suite.app.GovKeeper.GetDepositParams(suite.ctx)
-				defaultPeriod := govv1beta2.DefaultPeriod
-				suite.Require().Equal(govv1beta2.DepositParams{
-					MinDeposit:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(64000000))),
-					MaxDepositPeriod: &defaultPeriod,
-				}, depositParams)
+				suite.Require().Equal(govv1beta2.DefaultDepositParams(), suite.app.GovKeeper.GetDepositParams(suite.ctx))
+			},
+			proposal.ErrMigratedSubspace,
+		},
+		{
+			"no change is applied when one of them is rejected",
+			testProposal(
+				proposal.NewParamChange(epoching.ModuleName, string(epoching.KeyEpochLength), `"20"`),
+				proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "1"),
+			),
+			func() {
+				suite.Require().Equal(epoching.DefaultEpochLength, suite.app.EpochingKeeper.GetParams(suite.ctx).EpochLength)
 			},
-			false,
+			proposal.ErrMigratedSubspace,
 		},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
-			err := suite.govHandler(suite.ctx, tc.proposal)
-			if tc.expErr {
-				suite.Require().Error(err)
+			suite.SetupTest()
+			// the handler is run in a cached context by gov
+			ctx, writeCache := suite.ctx.CacheContext()
+			err := suite.govHandler(ctx, tc.proposal)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
 			} else {
 				suite.Require().NoError(err)
-				tc.onHandle()
+				writeCache()
 			}
+			tc.onHandle()
 		})
 	}
 }
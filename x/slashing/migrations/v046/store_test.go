@@ -0,0 +1,36 @@
+package v046_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	v046slashing "github.com/cosmos/cosmos-sdk/x/slashing/migrations/v046"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	slashingKey := sdk.NewKVStoreKey("slashing")
+	tSlashingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(slashingKey, tSlashingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, slashingKey, tSlashingKey, "slashing").WithKeyTable(types.ParamKeyTable())
+
+	// Set the params in the legacy subspace.
+	params := types.DefaultParams()
+	paramstore.SetParamSet(ctx, &params)
+
+	// Run migrations.
+	err := v046slashing.MigrateStore(ctx, slashingKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params were moved into the module store.
+	var res types.Params
+	bz := ctx.KVStore(slashingKey).Get(v046slashing.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}
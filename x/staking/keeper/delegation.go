@@ -3,6 +3,7 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"math"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -950,3 +951,166 @@ func (k Keeper) ValidateUnbondAmount(
 
 	return shares, nil
 }
+
+// TransferUnbonding moves up to wantAmt tokens of the unbonding delegation of
+// fromAddr from the given validator over to toAddr, and returns the amount
+// actually transferred. The transferred entries keep their creation height
+// and completion time, so they remain slashable and mature at the same time.
+// No more entries are transferred once the unbonding delegation of toAddr has
+// reached the maximum number of entries.
+func (k Keeper) TransferUnbonding(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantAmt sdk.Int,
+) sdk.Int {
+	transferred := sdk.ZeroInt()
+
+	ubd, found := k.GetUnbondingDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred
+	}
+
+	for i := 0; i < len(ubd.Entries) && wantAmt.IsPositive(); i++ {
+		entry := ubd.Entries[i]
+
+		toXfer := sdk.MinInt(entry.Balance, wantAmt)
+		if !toXfer.IsPositive() {
+			continue
+		}
+
+		if k.HasMaxUnbondingDelegationEntries(ctx, toAddr, valAddr) {
+			break
+		}
+
+		// split the initial balance in proportion to the transferred balance,
+		// so that slashing both entries is equivalent to slashing the original
+		initialXfer := entry.InitialBalance
+		if toXfer.LT(entry.Balance) {
+			initialXfer = entry.InitialBalance.Mul(toXfer).Quo(entry.Balance)
+		}
+
+		entry.Balance = entry.Balance.Sub(toXfer)
+		entry.InitialBalance = entry.InitialBalance.Sub(initialXfer)
+		if entry.Balance.IsZero() {
+			ubd.RemoveEntry(int64(i))
+			i--
+		} else {
+			ubd.Entries[i] = entry
+		}
+
+		toUbd, found := k.GetUnbondingDelegation(ctx, toAddr, valAddr)
+		if !found {
+			toUbd = types.UnbondingDelegation{
+				DelegatorAddress: toAddr.String(),
+				ValidatorAddress: valAddr.String(),
+			}
+		}
+		toUbd.Entries = append(toUbd.Entries, types.UnbondingDelegationEntry{
+			CreationHeight: entry.CreationHeight,
+			CompletionTime: entry.CompletionTime,
+			InitialBalance: initialXfer,
+			Balance:        toXfer,
+		})
+		k.SetUnbondingDelegation(ctx, toUbd)
+		k.InsertUBDQueue(ctx, toUbd, entry.CompletionTime)
+
+		transferred = transferred.Add(toXfer)
+		wantAmt = wantAmt.Sub(toXfer)
+	}
+
+	// set the unbonding delegation or remove it if there are no more entries
+	if len(ubd.Entries) == 0 {
+		k.RemoveUnbondingDelegation(ctx, ubd)
+	} else {
+		k.SetUnbondingDelegation(ctx, ubd)
+	}
+
+	return transferred
+}
+
+// TransferDelegation moves up to wantShares of the delegation of fromAddr to
+// the given validator over to toAddr, and returns the shares actually
+// transferred. Shares received through redelegations which have not completed
+// yet are not transferred, since they must remain slashable for infractions
+// of the source validator.
+func (k Keeper) TransferDelegation(
+	ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, valAddr sdk.ValAddress, wantShares sdk.Dec,
+) (transferred sdk.Dec, err error) {
+	transferred = sdk.ZeroDec()
+
+	if !wantShares.IsPositive() {
+		return transferred, nil
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return transferred, types.ErrNoValidatorFound
+	}
+
+	delFrom, found := k.GetDelegation(ctx, fromAddr, valAddr)
+	if !found {
+		return transferred, types.ErrNoDelegation
+	}
+
+	receivingShares := sdk.ZeroDec()
+	for _, red := range k.GetRedelegations(ctx, fromAddr, math.MaxUint16) {
+		if red.ValidatorDstAddress != valAddr.String() {
+			continue
+		}
+		for _, entry := range red.Entries {
+			receivingShares = receivingShares.Add(entry.SharesDst)
+		}
+	}
+
+	transferable := delFrom.Shares.Sub(receivingShares)
+	if !transferable.IsPositive() {
+		return transferred, nil
+	}
+	transferred = sdk.MinDec(wantShares, transferable)
+
+	// update the delegation of the sender
+	if err := k.BeforeDelegationSharesModified(ctx, fromAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delFrom.Shares = delFrom.Shares.Sub(transferred)
+
+	// If the delegation is the operator of the validator and the transfer
+	// decreases the validator's self-delegation below their minimum, we jail
+	// the validator.
+	if fromAddr.Equals(validator.GetOperator()) && !validator.Jailed &&
+		validator.TokensFromShares(delFrom.Shares).TruncateInt().LT(validator.MinSelfDelegation) {
+		k.jailValidator(ctx, validator)
+	}
+
+	if delFrom.Shares.IsZero() {
+		err = k.RemoveDelegation(ctx, delFrom)
+	} else {
+		k.SetDelegation(ctx, delFrom)
+		err = k.AfterDelegationModified(ctx, fromAddr, valAddr)
+	}
+
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	// update the delegation of the recipient
+	delTo, found := k.GetDelegation(ctx, toAddr, valAddr)
+	if found {
+		err = k.BeforeDelegationSharesModified(ctx, toAddr, valAddr)
+	} else {
+		delTo = types.NewDelegation(toAddr, valAddr, sdk.ZeroDec())
+		err = k.BeforeDelegationCreated(ctx, toAddr, valAddr)
+	}
+
+	if err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	delTo.Shares = delTo.Shares.Add(transferred)
+	k.SetDelegation(ctx, delTo)
+
+	if err := k.AfterDelegationModified(ctx, toAddr, valAddr); err != nil {
+		return sdk.ZeroDec(), err
+	}
+
+	return transferred, nil
+}
@@ -991,3 +991,93 @@ func TestRedelegateFromUnbondedValidator(t *testing.T) {
 	red, found := app.StakingKeeper.GetRedelegation(ctx, addrDels[0], addrVals[0], addrVals[1])
 	require.False(t, found, "%v", red)
 }
+
+func TestTransferUnbonding(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	delAddrs := simapp.AddTestAddrsIncremental(app, ctx, 2, sdk.NewInt(0))
+	valAddrs := simapp.ConvertAddrsToValAddrs(delAddrs)
+
+	completionTime := ctx.BlockTime().Add(time.Hour)
+	ubd := types.NewUnbondingDelegation(delAddrs[0], valAddrs[0], 10, completionTime, sdk.NewInt(10))
+	ubd.AddEntry(11, completionTime.Add(time.Hour), sdk.NewInt(20))
+	ubd.Entries[1].Balance = sdk.NewInt(10) // slashed by half
+	app.StakingKeeper.SetUnbondingDelegation(ctx, ubd)
+
+	// the first entry is transferred entirely, the second one in part
+	transferred := app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(14))
+	require.Equal(t, sdk.NewInt(14), transferred)
+
+	resUbd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, resUbd.Entries, 1)
+	require.Equal(t, int64(11), resUbd.Entries[0].CreationHeight)
+	require.Equal(t, sdk.NewInt(12), resUbd.Entries[0].InitialBalance)
+	require.Equal(t, sdk.NewInt(6), resUbd.Entries[0].Balance)
+
+	resUbd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[1], valAddrs[0])
+	require.True(t, found)
+	require.Len(t, resUbd.Entries, 2)
+	require.Equal(t, int64(10), resUbd.Entries[0].CreationHeight)
+	require.Equal(t, completionTime, resUbd.Entries[0].CompletionTime)
+	require.Equal(t, sdk.NewInt(10), resUbd.Entries[0].Balance)
+	require.Equal(t, int64(11), resUbd.Entries[1].CreationHeight)
+	require.Equal(t, sdk.NewInt(8), resUbd.Entries[1].InitialBalance)
+	require.Equal(t, sdk.NewInt(4), resUbd.Entries[1].Balance)
+
+	// the transferred entries are queued for the recipient
+	pairs := app.StakingKeeper.GetUBDQueueTimeSlice(ctx, completionTime)
+	require.Equal(t, []types.DVPair{{DelegatorAddress: delAddrs[1].String(), ValidatorAddress: valAddrs[0].String()}}, pairs)
+
+	// transferring more than available moves the remaining balance
+	transferred = app.StakingKeeper.TransferUnbonding(ctx, delAddrs[0], delAddrs[1], valAddrs[0], sdk.NewInt(100))
+	require.Equal(t, sdk.NewInt(6), transferred)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delAddrs[0], valAddrs[0])
+	require.False(t, found)
+}
+
+func TestTransferDelegation(t *testing.T) {
+	_, app, ctx := createTestInput(t)
+
+	addrDels := simapp.AddTestAddrsIncremental(app, ctx, 3, sdk.NewInt(10000))
+	valAddrs := simapp.ConvertAddrsToValAddrs(addrDels)
+
+	validator := teststaking.NewValidator(t, valAddrs[0], PKs[0])
+	validator, _ = validator.AddTokensFromDel(sdk.NewInt(100))
+	keeper.TestingUpdateValidator(app.StakingKeeper, ctx, validator, true)
+
+	app.StakingKeeper.SetDelegation(ctx, types.NewDelegation(addrDels[1], valAddrs[0], sdk.NewDec(100)))
+
+	// a redelegation in progress makes part of the delegation non-transferable
+	rd := types.NewRedelegation(addrDels[1], valAddrs[2], valAddrs[0], 0,
+		ctx.BlockTime().Add(time.Hour), sdk.NewInt(30), sdk.NewDec(30))
+	app.StakingKeeper.SetRedelegation(ctx, rd)
+
+	transferred, err := app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], valAddrs[0], sdk.NewDec(40))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(40), transferred)
+
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], valAddrs[0], sdk.NewDec(40))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(30), transferred)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(30), delegation.Shares)
+
+	delegation, found = app.StakingKeeper.GetDelegation(ctx, addrDels[2], valAddrs[0])
+	require.True(t, found)
+	require.Equal(t, sdk.NewDec(70), delegation.Shares)
+
+	// once the redelegation completes, the rest of the delegation can move
+	app.StakingKeeper.RemoveRedelegation(ctx, rd)
+	transferred, err = app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], valAddrs[0], sdk.NewDec(40))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(30), transferred)
+
+	_, found = app.StakingKeeper.GetDelegation(ctx, addrDels[1], valAddrs[0])
+	require.False(t, found)
+
+	_, err = app.StakingKeeper.TransferDelegation(ctx, addrDels[1], addrDels[2], valAddrs[0], sdk.NewDec(1))
+	require.ErrorIs(t, err, types.ErrNoDelegation)
+}
@@ -27,3 +27,9 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 func (m Migrator) Migrate2to3(ctx sdk.Context) error {
 	return v046.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc, m.keeper.paramstore)
 }
+
+// Migrate3to4 migrates the x/staking params from the x/params subspace to the
+// x/staking module store.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v046.MigrateParams(ctx, m.keeper.storeKey, m.keeper.paramstore, m.keeper.cdc)
+}
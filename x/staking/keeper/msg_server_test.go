@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (suite *KeeperTestSuite) TestCancelUnbondingDelegation() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(10)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+	notBondedPool := app.StakingKeeper.GetNotBondedPool(ctx)
+	bondedPool := app.StakingKeeper.GetBondedPool(ctx)
+
+	delegatorAddr := suite.addrs[0]
+	validatorAddr := suite.vals[0].GetOperator()
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	suite.Require().True(found)
+	suite.Require().True(validator.IsBonded())
+
+	unbondingAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	shares, err := validator.SharesFromTokens(unbondingAmount)
+	suite.Require().NoError(err)
+	_, err = app.StakingKeeper.Undelegate(ctx, delegatorAddr, validatorAddr, shares)
+	suite.Require().NoError(err)
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	suite.Require().True(found)
+	suite.Require().Len(ubd.Entries, 1)
+	entry := ubd.Entries[0]
+
+	testCases := []struct {
+		name   string
+		expErr error
+		req    types.MsgCancelUnbondingDelegation
+	}{
+		{
+			"invalid coin denom",
+			sdkerrors.ErrInvalidRequest,
+			types.MsgCancelUnbondingDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				ValidatorAddress: validatorAddr.String(),
+				Amount:           sdk.NewCoin("dump_coin", sdk.NewInt(4)),
+				CreationHeight:   10,
+			},
+		},
+		{
+			"validator not exists",
+			types.ErrNoValidatorFound,
+			types.MsgCancelUnbondingDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				ValidatorAddress: sdk.ValAddress(suite.addrs[4]).String(),
+				Amount:           sdk.NewCoin(bondDenom, unbondingAmount),
+				CreationHeight:   10,
+			},
+		},
+		{
+			"unbonding delegation not exists",
+			types.ErrNoUnbondingDelegation,
+			types.MsgCancelUnbondingDelegation{
+				DelegatorAddress: suite.addrs[1].String(),
+				ValidatorAddress: validatorAddr.String(),
+				Amount:           sdk.NewCoin(bondDenom, unbondingAmount),
+				CreationHeight:   10,
+			},
+		},
+		{
+			"invalid entry creation height",
+			sdkerrors.ErrNotFound,
+			types.MsgCancelUnbondingDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				ValidatorAddress: validatorAddr.String(),
+				Amount:           sdk.NewCoin(bondDenom, unbondingAmount),
+				CreationHeight:   11,
+			},
+		},
+		{
+			"amount greater than the unbonding entry balance",
+			sdkerrors.ErrInvalidRequest,
+			types.MsgCancelUnbondingDelegation{
+				DelegatorAddress: delegatorAddr.String(),
+				ValidatorAddress: validatorAddr.String(),
+				Amount:           sdk.NewCoin(bondDenom, unbondingAmount.AddRaw(1)),
+				CreationHeight:   10,
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), &tc.req)
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	// an unbonding delegation which already completed can't be canceled
+	msg := types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, sdk.NewCoin(bondDenom, unbondingAmount))
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx.WithBlockTime(entry.CompletionTime.Add(time.Second))), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	// cancel a part of the unbonding delegation
+	oldDelegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	suite.Require().True(found)
+	oldNotBonded := app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
+	oldBonded := app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
+	oldTokens := app.StakingKeeper.Validator(ctx, validatorAddr).GetTokens()
+
+	cancelAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 1)
+	msg.Amount = sdk.NewCoin(bondDenom, cancelAmount)
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	ubd, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	suite.Require().True(found)
+	suite.Require().Len(ubd.Entries, 1)
+	suite.Require().Equal(unbondingAmount.Sub(cancelAmount), ubd.Entries[0].Balance)
+	suite.Require().Equal(unbondingAmount.Sub(cancelAmount), ubd.Entries[0].InitialBalance)
+
+	delegation, found := app.StakingKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	suite.Require().True(found)
+	suite.Require().True(delegation.Shares.GT(oldDelegation.Shares))
+	suite.Require().Equal(oldTokens.Add(cancelAmount), app.StakingKeeper.Validator(ctx, validatorAddr).GetTokens())
+
+	// the tokens are moved back from the not bonded pool to the bonded pool
+	suite.Require().Equal(oldNotBonded.SubAmount(cancelAmount), app.BankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom))
+	suite.Require().Equal(oldBonded.AddAmount(cancelAmount), app.BankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom))
+
+	// cancel the remaining of the unbonding delegation
+	msg.Amount = sdk.NewCoin(bondDenom, unbondingAmount.Sub(cancelAmount))
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	suite.Require().False(found)
+	suite.Require().Equal(oldTokens.Add(unbondingAmount), app.StakingKeeper.Validator(ctx, validatorAddr).GetTokens())
+}
+
+func (suite *KeeperTestSuite) TestCancelUnbondingDelegationAfterSlash() {
+	app, ctx := suite.app, suite.ctx.WithBlockHeight(10)
+	msgServer := keeper.NewMsgServerImpl(app.StakingKeeper)
+	bondDenom := app.StakingKeeper.BondDenom(ctx)
+
+	delegatorAddr := suite.addrs[0]
+	validatorAddr := suite.vals[0].GetOperator()
+	validator, found := app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	suite.Require().True(found)
+	consAddr, err := validator.GetConsAddr()
+	suite.Require().NoError(err)
+
+	unbondingAmount := app.StakingKeeper.TokensFromConsensusPower(ctx, 4)
+	shares, err := validator.SharesFromTokens(unbondingAmount)
+	suite.Require().NoError(err)
+	_, err = app.StakingKeeper.Undelegate(ctx, delegatorAddr, validatorAddr, shares)
+	suite.Require().NoError(err)
+
+	// slash the validator for an infraction committed while the tokens were bonded
+	ctx = ctx.WithBlockHeight(11)
+	app.StakingKeeper.Slash(ctx, consAddr, 10, validator.GetConsensusPower(app.StakingKeeper.PowerReduction(ctx)), sdk.NewDecWithPrec(5, 1))
+
+	ubd, found := app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	suite.Require().True(found)
+	slashedBalance := ubd.Entries[0].Balance
+	suite.Require().Equal(unbondingAmount.QuoRaw(2), slashedBalance)
+
+	// the slashed tokens can't be delegated back
+	msg := types.NewMsgCancelUnbondingDelegation(delegatorAddr, validatorAddr, 10, sdk.NewCoin(bondDenom, unbondingAmount))
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	validator, found = app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	suite.Require().True(found)
+	oldTokens := validator.GetTokens()
+
+	msg.Amount = sdk.NewCoin(bondDenom, slashedBalance)
+	_, err = msgServer.CancelUnbondingDelegation(sdk.WrapSDKContext(ctx), msg)
+	suite.Require().NoError(err)
+
+	_, found = app.StakingKeeper.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+	suite.Require().False(found)
+	validator, found = app.StakingKeeper.GetValidator(ctx, validatorAddr)
+	suite.Require().True(found)
+	suite.Require().Equal(oldTokens.Add(slashedBalance), validator.GetTokens())
+}
@@ -30,3 +30,25 @@ func TestStoreMigration(t *testing.T) {
 	// Make sure the new params are set.
 	require.True(t, paramstore.Has(ctx, types.KeyMinCommissionRate))
 }
+
+func TestMigrateParams(t *testing.T) {
+	encCfg := simapp.MakeTestEncodingConfig()
+	stakingKey := sdk.NewKVStoreKey("staking")
+	tStakingKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(stakingKey, tStakingKey)
+	paramstore := paramtypes.NewSubspace(encCfg.Codec, encCfg.Amino, stakingKey, tStakingKey, "staking").WithKeyTable(types.ParamKeyTable())
+
+	// Set the params in the legacy subspace.
+	params := types.DefaultParams()
+	paramstore.SetParamSet(ctx, &params)
+
+	// Run migrations.
+	err := v046staking.MigrateParams(ctx, stakingKey, paramstore, encCfg.Codec)
+	require.NoError(t, err)
+
+	// Make sure the params were moved into the module store.
+	var res types.Params
+	bz := ctx.KVStore(stakingKey).Get(v046staking.ParamsKey)
+	require.NoError(t, encCfg.Codec.Unmarshal(bz, &res))
+	require.Equal(t, params, res)
+}